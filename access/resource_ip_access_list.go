@@ -2,7 +2,11 @@ package access
 
 import (
 	"context"
+	"fmt"
+	"log"
+	"net"
 
+	"github.com/databricks/databricks-sdk-go"
 	"github.com/databricks/databricks-sdk-go/service/settings"
 	"github.com/databricks/terraform-provider-databricks/common"
 
@@ -10,6 +14,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// maxIpAccessListValues is the total number of IP addresses and CIDR ranges allowed across all lists,
+// where a CIDR range counts as a single value
+const maxIpAccessListValues = 1000
+
 type ipAccessListUpdateRequest struct {
 	Label       string            `json:"label"`
 	ListType    settings.ListType `json:"list_type"`
@@ -17,7 +25,7 @@ type ipAccessListUpdateRequest struct {
 	Enabled     bool              `json:"enabled,omitempty" tf:"default:true"`
 }
 
-// ResourceIPAccessList manages IP access lists
+// ResourceIPAccessList manages IP access lists of a workspace or of the account console, depending on the provider configuration
 func ResourceIPAccessList() common.Resource {
 	s := common.StructToSchema(ipAccessListUpdateRequest{}, func(s map[string]*schema.Schema) map[string]*schema.Schema {
 		// nolint
@@ -30,14 +38,28 @@ func ResourceIPAccessList() common.Resource {
 	})
 	return common.Resource{
 		Schema: s,
-		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
-			w, err := c.WorkspaceClient()
-			if err != nil {
-				return err
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff) error {
+			var addresses []string
+			for _, v := range d.Get("ip_addresses").([]any) {
+				// values that aren't known yet are checked during apply
+				if address, ok := v.(string); ok && address != "" {
+					addresses = append(addresses, address)
+				}
 			}
+			return validateIpAccessListEntries(addresses)
+		},
+		CustomizeDiffWithClient: checkIpAccessListConflicts,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			var iacl settings.CreateIpAccessList
 			common.DataToStructPointer(d, s, &iacl)
-			status, err := w.IpAccessLists.Create(ctx, iacl)
+			var status *settings.CreateIpAccessListResponse
+			err := c.AccountOrWorkspaceRequest(func(a *databricks.AccountClient) (err error) {
+				status, err = a.IpAccessLists.Create(ctx, iacl)
+				return
+			}, func(w *databricks.WorkspaceClient) (err error) {
+				status, err = w.IpAccessLists.Create(ctx, iacl)
+				return
+			})
 			if err != nil {
 				return err
 			}
@@ -45,33 +67,157 @@ func ResourceIPAccessList() common.Resource {
 			return nil
 		},
 		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
-			w, err := c.WorkspaceClient()
+			var list *settings.IpAccessListInfo
+			err := c.AccountOrWorkspaceRequest(func(a *databricks.AccountClient) error {
+				status, err := a.IpAccessLists.GetByIpAccessListId(ctx, d.Id())
+				if err != nil {
+					return err
+				}
+				list = status.IpAccessList
+				return nil
+			}, func(w *databricks.WorkspaceClient) error {
+				status, err := w.IpAccessLists.GetByIpAccessListId(ctx, d.Id())
+				if err != nil {
+					return err
+				}
+				list = status.IpAccessList
+				return nil
+			})
 			if err != nil {
 				return err
 			}
-			status, err := w.IpAccessLists.GetByIpAccessListId(ctx, d.Id())
-			if err != nil {
-				return err
-			}
-			common.StructToData(status.IpAccessList, s, d)
+			common.StructToData(list, s, d)
 			return nil
 		},
 		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
-			w, err := c.WorkspaceClient()
-			if err != nil {
-				return err
-			}
 			var iacl settings.UpdateIpAccessList
 			common.DataToStructPointer(d, s, &iacl)
 			iacl.IpAccessListId = d.Id()
-			return w.IpAccessLists.Update(ctx, iacl)
+			return c.AccountOrWorkspaceRequest(func(a *databricks.AccountClient) error {
+				return a.IpAccessLists.Update(ctx, iacl)
+			}, func(w *databricks.WorkspaceClient) error {
+				return w.IpAccessLists.Update(ctx, iacl)
+			})
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
-			w, err := c.WorkspaceClient()
+			return c.AccountOrWorkspaceRequest(func(a *databricks.AccountClient) error {
+				return a.IpAccessLists.DeleteByIpAccessListId(ctx, d.Id())
+			}, func(w *databricks.WorkspaceClient) error {
+				return w.IpAccessLists.DeleteByIpAccessListId(ctx, d.Id())
+			})
+		},
+	}
+}
+
+func parseIpAccessListEntry(entry string) (*net.IPNet, error) {
+	_, ipNet, err := net.ParseCIDR(entry)
+	if err == nil {
+		return ipNet, nil
+	}
+	ip := net.ParseIP(entry)
+	if ip == nil {
+		return nil, fmt.Errorf("%s is neither IP address nor CIDR range", entry)
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		return &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}, nil
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, nil
+}
+
+// ipNetContains returns true if every address of inner belongs to outer
+func ipNetContains(outer, inner *net.IPNet) bool {
+	outerOnes, outerBits := outer.Mask.Size()
+	innerOnes, innerBits := inner.Mask.Size()
+	return outerBits == innerBits && outerOnes <= innerOnes && outer.Contains(inner.IP)
+}
+
+// validateIpAccessListEntries performs the hermetic checks of a single list: every entry has to be a valid
+// IP address or CIDR range, and the list must fit into the API limit. Overlapping entries are accepted
+// by the API, so they are only reported as a warning
+func validateIpAccessListEntries(addresses []string) error {
+	if len(addresses) > maxIpAccessListValues {
+		return fmt.Errorf("ip_addresses has %d values, but IP access lists can't have more than %d values in total",
+			len(addresses), maxIpAccessListValues)
+	}
+	ipNets := make([]*net.IPNet, len(addresses))
+	for i, address := range addresses {
+		ipNet, err := parseIpAccessListEntry(address)
+		if err != nil {
+			return err
+		}
+		for j := 0; j < i; j++ {
+			if ipNetContains(ipNets[j], ipNet) || ipNetContains(ipNet, ipNets[j]) {
+				log.Printf("[WARN] ip_addresses contains overlapping values: %s and %s", addresses[j], address)
+			}
+		}
+		ipNets[i] = ipNet
+	}
+	return nil
+}
+
+// checkIpAccessListConflicts compares the planned list with the other lists in the workspace or account:
+// the total number of values must not exceed the API limit, and allowed ranges must not be fully covered
+// by blocked ranges, as block lists take precedence and such entries have no effect
+func checkIpAccessListConflicts(ctx context.Context, d *schema.ResourceDiff, c *common.DatabricksClient) error {
+	if !d.HasChanges("ip_addresses", "list_type", "enabled") || !d.NewValueKnown("ip_addresses") {
+		return nil
+	}
+	listID := d.Id()
+	listType := settings.ListType(d.Get("list_type").(string))
+	var addresses []string
+	for _, v := range d.Get("ip_addresses").([]any) {
+		if address, ok := v.(string); ok && address != "" {
+			addresses = append(addresses, address)
+		}
+	}
+	if len(addresses) == 0 {
+		return nil
+	}
+	var lists []settings.IpAccessListInfo
+	err := c.AccountOrWorkspaceRequest(func(a *databricks.AccountClient) (err error) {
+		lists, err = a.IpAccessLists.ListAll(ctx)
+		return
+	}, func(w *databricks.WorkspaceClient) (err error) {
+		lists, err = w.IpAccessLists.ListAll(ctx)
+		return
+	})
+	if err != nil {
+		return err
+	}
+	total := len(addresses)
+	for _, list := range lists {
+		if list.ListId == listID {
+			continue
+		}
+		count := list.AddressCount
+		if count == 0 {
+			count = len(list.IpAddresses)
+		}
+		total += count
+		if !list.Enabled || list.ListType == listType {
+			continue
+		}
+		allowed, blocked := addresses, list.IpAddresses
+		if listType == settings.ListTypeBlock {
+			allowed, blocked = list.IpAddresses, addresses
+		}
+		for _, a := range allowed {
+			allowedNet, err := parseIpAccessListEntry(a)
 			if err != nil {
-				return err
+				continue
 			}
-			return w.IpAccessLists.DeleteByIpAccessListId(ctx, d.Id())
-		},
+			for _, b := range blocked {
+				blockedNet, err := parseIpAccessListEntry(b)
+				if err == nil && ipNetContains(blockedNet, allowedNet) {
+					return fmt.Errorf("%s has no effect in the ALLOW list, because it's covered by %s "+
+						"in the BLOCK list, which takes precedence (conflicts with %s)", a, b, list.Label)
+				}
+			}
+		}
+	}
+	if total > maxIpAccessListValues {
+		return fmt.Errorf("IP access lists would have %d values in total, but no more than %d are allowed",
+			total, maxIpAccessListValues)
 	}
+	return nil
 }
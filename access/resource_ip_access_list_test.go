@@ -24,11 +24,18 @@ var (
 	TestingEnabled          = true
 	TestingIpAddresses      = []string{"1.2.3.4", "1.2.4.0/24"}
 	TestingIpAddressesState = []any{"1.2.3.4", "1.2.4.0/24"}
+
+	emptyIpAccessListsFixture = qa.HTTPFixture{
+		Method:   http.MethodGet,
+		Resource: "/api/2.0/ip-access-lists",
+		Response: settings.ListIpAccessListResponse{},
+	}
 )
 
 func TestIPACLCreate(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
+			emptyIpAccessListsFixture,
 			{
 				Method:   http.MethodPost,
 				Resource: "/api/2.0/ip-access-lists",
@@ -90,6 +97,7 @@ func TestIPACLCreate(t *testing.T) {
 func TestAPIACLCreate_Error(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
+			emptyIpAccessListsFixture,
 			{
 				Method:   http.MethodPost,
 				Resource: "/api/2.0/ip-access-lists",
@@ -113,9 +121,138 @@ func TestAPIACLCreate_Error(t *testing.T) {
 	assert.Equal(t, "", d.Id(), "Id should be empty for error creates")
 }
 
+func TestIPACLCreate_OverlappingValues(t *testing.T) {
+	overlapping := []string{"1.2.4.0/24", "1.2.3.4", "1.2.4.5"}
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			emptyIpAccessListsFixture,
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/ip-access-lists",
+				ExpectedRequest: settings.CreateIpAccessList{
+					Label:       TestingLabel,
+					ListType:    TestingListType,
+					IpAddresses: overlapping,
+				},
+				Response: settings.CreateIpAccessListResponse{
+					IpAccessList: &settings.IpAccessListInfo{
+						ListId: TestingId,
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/ip-access-lists/" + TestingId + "?",
+				Response: settings.FetchIpAccessListResponse{
+					IpAccessList: &settings.IpAccessListInfo{
+						ListId:      TestingId,
+						Label:       TestingLabel,
+						ListType:    TestingListType,
+						IpAddresses: overlapping,
+						Enabled:     TestingEnabled,
+					},
+				},
+			},
+		},
+		Resource: ResourceIPAccessList(),
+		State: map[string]any{
+			"label":        TestingLabel,
+			"list_type":    TestingListTypeString,
+			"ip_addresses": []any{"1.2.4.0/24", "1.2.3.4", "1.2.4.5"},
+		},
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"ip_addresses.#": 3,
+	})
+}
+
+func TestIPACLCreate_TooManyValues(t *testing.T) {
+	_, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/ip-access-lists",
+				Response: settings.ListIpAccessListResponse{
+					IpAccessLists: []settings.IpAccessListInfo{
+						{
+							ListId:       "other",
+							Label:        "office",
+							ListType:     settings.ListTypeAllow,
+							IpAddresses:  []string{"10.0.0.0/8"},
+							AddressCount: 999,
+							Enabled:      true,
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceIPAccessList(),
+		State: map[string]any{
+			"label":        TestingLabel,
+			"list_type":    TestingListTypeString,
+			"ip_addresses": TestingIpAddressesState,
+		},
+		Create: true,
+	}.Apply(t)
+	assert.EqualError(t, err, "IP access lists would have 1001 values in total, but no more than 1000 are allowed")
+}
+
+func TestIPACLCreate_AllowedValueIsBlocked(t *testing.T) {
+	_, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/ip-access-lists",
+				Response: settings.ListIpAccessListResponse{
+					IpAccessLists: []settings.IpAccessListInfo{
+						{
+							ListId:       "disabled",
+							Label:        "old",
+							ListType:     settings.ListTypeBlock,
+							IpAddresses:  []string{"1.2.3.4"},
+							AddressCount: 1,
+						},
+						{
+							ListId:       "other",
+							Label:        "bad actors",
+							ListType:     settings.ListTypeBlock,
+							IpAddresses:  []string{"1.2.0.0/16"},
+							AddressCount: 1,
+							Enabled:      true,
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceIPAccessList(),
+		State: map[string]any{
+			"label":        TestingLabel,
+			"list_type":    "ALLOW",
+			"ip_addresses": TestingIpAddressesState,
+		},
+		Create: true,
+	}.Apply(t)
+	assert.EqualError(t, err, "1.2.3.4 has no effect in the ALLOW list, because it's covered by 1.2.0.0/16 "+
+		"in the BLOCK list, which takes precedence (conflicts with bad actors)")
+}
+
+func TestValidateIpAccessListEntries(t *testing.T) {
+	assert.NoError(t, validateIpAccessListEntries([]string{"1.2.3.4", "1.2.4.0/24", "10.0.0.0/8"}))
+	assert.NoError(t, validateIpAccessListEntries([]string{"1.2.3.4", "1.2.3.4"}))
+	assert.EqualError(t, validateIpAccessListEntries([]string{"1.2.3"}),
+		"1.2.3 is neither IP address nor CIDR range")
+	tooMany := make([]string, 1001)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("10.%d.%d.1", i/256, i%256)
+	}
+	assert.EqualError(t, validateIpAccessListEntries(tooMany),
+		"ip_addresses has 1001 values, but IP access lists can't have more than 1000 values in total")
+}
+
 func TestIPACLUpdate(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
+			emptyIpAccessListsFixture,
 			{
 				Method:   http.MethodGet,
 				Resource: "/api/2.0/ip-access-lists/" + TestingId + "?",
@@ -179,6 +316,7 @@ func TestIPACLUpdate(t *testing.T) {
 func TestIPACLUpdate_Error(t *testing.T) {
 	_, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
+			emptyIpAccessListsFixture,
 			{
 				Method:   http.MethodPatch,
 				Resource: "/api/2.0/ip-access-lists/" + TestingId,
@@ -276,6 +414,34 @@ func TestIPACLRead_Error(t *testing.T) {
 	assert.Equal(t, TestingId, d.Id(), "Id should not be empty for error reads")
 }
 
+func TestIPACLReadAccount(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/accounts/abc/ip-access-lists/" + TestingId + "?",
+				Response: settings.GetIpAccessListResponse{
+					IpAccessList: &settings.IpAccessListInfo{
+						ListId:      TestingId,
+						Label:       TestingLabel,
+						ListType:    TestingListType,
+						IpAddresses: TestingIpAddresses,
+						Enabled:     TestingEnabled,
+					},
+				},
+			},
+		},
+		Resource:  ResourceIPAccessList(),
+		AccountID: "abc",
+		Read:      true,
+		New:       true,
+		ID:        TestingId,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, TestingLabel, d.Get("label"))
+	assert.Equal(t, 2, d.Get("ip_addresses.#"))
+}
+
 func TestIPACLDelete(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -292,6 +458,21 @@ func TestIPACLDelete(t *testing.T) {
 	assert.Equal(t, TestingId, d.Id())
 }
 
+func TestIPACLDeleteAccount(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodDelete,
+				Resource: fmt.Sprintf("/api/2.0/accounts/abc/ip-access-lists/%s?", TestingId),
+			},
+		},
+		Resource:  ResourceIPAccessList(),
+		AccountID: "abc",
+		Delete:    true,
+		ID:        TestingId,
+	}.ApplyNoError(t)
+}
+
 func TestIPACLDelete_Error(t *testing.T) {
 	qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
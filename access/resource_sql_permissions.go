@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 
 	"github.com/databricks/databricks-sdk-go/apierr"
@@ -26,6 +27,7 @@ type SqlPermissions struct {
 	AnonymousFunction    bool                  `json:"anonymous_function,omitempty" tf:"force_new"`
 	ClusterID            string                `json:"cluster_id,omitempty" tf:"computed"`
 	PrivilegeAssignments []PrivilegeAssignment `json:"privilege_assignments,omitempty" tf:"slice_set"`
+	UcEquivalentGrants   []UcEquivalentGrants  `json:"uc_equivalent_grants,omitempty" tf:"computed"`
 
 	exec common.CommandExecutor
 }
@@ -36,6 +38,91 @@ type PrivilegeAssignment struct {
 	Privileges []string `json:"privileges" tf:"slice_set"`
 }
 
+// UcEquivalentGrants describes `databricks_grants` for the same object in the `hive_metastore` catalog
+type UcEquivalentGrants struct {
+	SecurableType string    `json:"securable_type"`
+	Name          string    `json:"name"`
+	Grants        []UcGrant `json:"grant,omitempty"`
+}
+
+// UcGrant is the same as `grant` block of `databricks_grants`
+type UcGrant struct {
+	Principal  string   `json:"principal"`
+	Privileges []string `json:"privileges"`
+}
+
+// legacy privileges that have Unity Catalog equivalents, per type of object. Everything else
+// (READ_METADATA, MODIFY_CLASSPATH, ANY FILE, ANONYMOUS FUNCTION) has no UC equivalent.
+var ucEquivalentPrivileges = map[string]map[string]string{
+	"CATALOG": {
+		"USAGE":                 "USE_CATALOG",
+		"CREATE":                "CREATE_SCHEMA",
+		"SELECT":                "SELECT",
+		"MODIFY":                "MODIFY",
+		"CREATE_NAMED_FUNCTION": "CREATE_FUNCTION",
+		"ALL PRIVILEGES":        "ALL_PRIVILEGES",
+	},
+	"DATABASE": {
+		"USAGE":                 "USE_SCHEMA",
+		"CREATE":                "CREATE_TABLE",
+		"SELECT":                "SELECT",
+		"MODIFY":                "MODIFY",
+		"CREATE_NAMED_FUNCTION": "CREATE_FUNCTION",
+		"ALL PRIVILEGES":        "ALL_PRIVILEGES",
+	},
+	"TABLE": {
+		"SELECT":         "SELECT",
+		"MODIFY":         "MODIFY",
+		"ALL PRIVILEGES": "ALL_PRIVILEGES",
+	},
+	"VIEW": {
+		"SELECT":         "SELECT",
+		"ALL PRIVILEGES": "ALL_PRIVILEGES",
+	},
+}
+
+// ucEquivalentGrants maps table ACLs to grants on the same object in the `hive_metastore` catalog
+func (ta *SqlPermissions) ucEquivalentGrants() []UcEquivalentGrants {
+	objectType, _ := ta.typeAndKey()
+	privileges, ok := ucEquivalentPrivileges[objectType]
+	if !ok {
+		return nil
+	}
+	equivalent := UcEquivalentGrants{}
+	switch objectType {
+	case "CATALOG":
+		equivalent.SecurableType = "catalog"
+		equivalent.Name = "hive_metastore"
+	case "DATABASE":
+		equivalent.SecurableType = "schema"
+		equivalent.Name = fmt.Sprintf("hive_metastore.%s", ta.Database)
+	case "TABLE":
+		equivalent.SecurableType = "table"
+		equivalent.Name = fmt.Sprintf("hive_metastore.%s.%s", ta.actualDatabase(), ta.Table)
+	case "VIEW":
+		// views are managed as tables in databricks_grants
+		equivalent.SecurableType = "table"
+		equivalent.Name = fmt.Sprintf("hive_metastore.%s.%s", ta.actualDatabase(), ta.View)
+	}
+	for _, pa := range ta.PrivilegeAssignments {
+		grant := UcGrant{Principal: pa.Principal}
+		for _, privilege := range pa.Privileges {
+			if ucPrivilege, ok := privileges[strings.ToUpper(privilege)]; ok {
+				grant.Privileges = append(grant.Privileges, ucPrivilege)
+			}
+		}
+		if len(grant.Privileges) == 0 {
+			continue
+		}
+		sort.Strings(grant.Privileges)
+		equivalent.Grants = append(equivalent.Grants, grant)
+	}
+	sort.Slice(equivalent.Grants, func(i, j int) bool {
+		return equivalent.Grants[i].Principal < equivalent.Grants[j].Principal
+	})
+	return []UcEquivalentGrants{equivalent}
+}
+
 func (ta *SqlPermissions) actualDatabase() string {
 	if ta.Database == "" {
 		return "default"
@@ -356,6 +443,10 @@ func ResourceSqlPermissions() common.Resource {
 				// reflect resource is skipping empty privilege_assignments
 				d.Set("privilege_assignments", []any{})
 			}
+			ta.UcEquivalentGrants = ta.ucEquivalentGrants()
+			if len(ta.UcEquivalentGrants) == 0 {
+				d.Set("uc_equivalent_grants", []any{})
+			}
 			common.StructToData(ta, s, d)
 			return nil
 		},
@@ -269,7 +269,57 @@ func TestResourceSqlPermissions_Read(t *testing.T) {
 		Read:     true,
 		New:      true,
 		ID:       "table/default.foo",
-	}.ApplyNoError(t)
+	}.ApplyAndExpectData(t, map[string]any{
+		"uc_equivalent_grants.#":                      1,
+		"uc_equivalent_grants.0.securable_type":       "table",
+		"uc_equivalent_grants.0.name":                 "hive_metastore.default.foo",
+		"uc_equivalent_grants.0.grant.#":              1,
+		"uc_equivalent_grants.0.grant.0.principal":    "users",
+		"uc_equivalent_grants.0.grant.0.privileges.#": 1,
+		"uc_equivalent_grants.0.grant.0.privileges.0": "SELECT",
+	})
+}
+
+func TestSqlPermissionsUcEquivalentGrants(t *testing.T) {
+	ta := SqlPermissions{Database: "sales", PrivilegeAssignments: []PrivilegeAssignment{
+		{Principal: "users", Privileges: []string{"USAGE", "SELECT", "READ_METADATA"}},
+		{Principal: "engineers", Privileges: []string{"CREATE", "MODIFY"}},
+		{Principal: "auditors", Privileges: []string{"READ_METADATA"}},
+	}}
+	assert.Equal(t, []UcEquivalentGrants{
+		{
+			SecurableType: "schema",
+			Name:          "hive_metastore.sales",
+			Grants: []UcGrant{
+				{Principal: "engineers", Privileges: []string{"CREATE_TABLE", "MODIFY"}},
+				{Principal: "users", Privileges: []string{"SELECT", "USE_SCHEMA"}},
+			},
+		},
+	}, ta.ucEquivalentGrants())
+
+	ta = SqlPermissions{Catalog: true, PrivilegeAssignments: []PrivilegeAssignment{
+		{Principal: "users", Privileges: []string{"USAGE", "CREATE_NAMED_FUNCTION"}},
+	}}
+	assert.Equal(t, []UcEquivalentGrants{
+		{
+			SecurableType: "catalog",
+			Name:          "hive_metastore",
+			Grants: []UcGrant{
+				{Principal: "users", Privileges: []string{"CREATE_FUNCTION", "USE_CATALOG"}},
+			},
+		},
+	}, ta.ucEquivalentGrants())
+
+	ta = SqlPermissions{View: "v", PrivilegeAssignments: []PrivilegeAssignment{
+		{Principal: "users", Privileges: []string{"SELECT"}},
+	}}
+	assert.Equal(t, "table", ta.ucEquivalentGrants()[0].SecurableType)
+	assert.Equal(t, "hive_metastore.default.v", ta.ucEquivalentGrants()[0].Name)
+
+	ta = SqlPermissions{AnyFile: true, PrivilegeAssignments: []PrivilegeAssignment{
+		{Principal: "users", Privileges: []string{"SELECT"}},
+	}}
+	assert.Nil(t, ta.ucEquivalentGrants())
 }
 
 func TestResourceSqlPermissions_Read_Error(t *testing.T) {
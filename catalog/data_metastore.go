@@ -10,8 +10,10 @@ import (
 
 func DataSourceMetastore() common.Resource {
 	type AccountMetastoreByID struct {
-		Id        string                 `json:"metastore_id"`
-		Metastore *catalog.MetastoreInfo `json:"metastore_info,omitempty" tf:"computed" `
+		Id                      string                 `json:"metastore_id"`
+		Metastore               *catalog.MetastoreInfo `json:"metastore_info,omitempty" tf:"computed" `
+		AssignedWorkspaceIds    []int64                `json:"assigned_workspace_ids,omitempty" tf:"computed"`
+		AssignedWorkspacesCount int                    `json:"assigned_workspaces_count,omitempty" tf:"computed"`
 	}
 	return common.AccountData(func(ctx context.Context, data *AccountMetastoreByID, acc *databricks.AccountClient) error {
 		metastore, err := acc.Metastores.GetByMetastoreId(ctx, data.Id)
@@ -19,6 +21,12 @@ func DataSourceMetastore() common.Resource {
 			return err
 		}
 		data.Metastore = metastore.MetastoreInfo
+		assignments, err := acc.MetastoreAssignments.ListByMetastoreId(ctx, data.Id)
+		if err != nil {
+			return err
+		}
+		data.AssignedWorkspaceIds = assignments.WorkspaceIds
+		data.AssignedWorkspacesCount = len(assignments.WorkspaceIds)
 		return nil
 	})
 }
@@ -21,6 +21,13 @@ func TestMetastoreDataVerify(t *testing.T) {
 					},
 				},
 			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/testaccount/metastores/abc/workspaces?",
+				Response: catalog.ListAccountMetastoreAssignmentsResponse{
+					WorkspaceIds: []int64{123, 456},
+				},
+			},
 		},
 		Resource:    DataSourceMetastore(),
 		Read:        true,
@@ -34,6 +41,8 @@ func TestMetastoreDataVerify(t *testing.T) {
 		"metastore_info.0.name":         "xyz",
 		"metastore_info.0.owner":        "pqr",
 		"metastore_info.0.metastore_id": "abc",
+		"assigned_workspace_ids":        []any{123, 456},
+		"assigned_workspaces_count":     2,
 	})
 }
 
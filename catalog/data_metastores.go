@@ -3,14 +3,28 @@ package catalog
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/databricks/databricks-sdk-go"
 	"github.com/databricks/terraform-provider-databricks/common"
 )
 
+// MetastoreSummary is a short description of the metastore, that helps to pick metastore for new workspaces
+type MetastoreSummary struct {
+	MetastoreId             string `json:"metastore_id"`
+	Name                    string `json:"name"`
+	Cloud                   string `json:"cloud,omitempty"`
+	Region                  string `json:"region,omitempty"`
+	Owner                   string `json:"owner,omitempty"`
+	CreatedBy               string `json:"created_by,omitempty"`
+	AssignedWorkspacesCount int    `json:"assigned_workspaces_count"`
+}
+
 func DataSourceMetastores() common.Resource {
 	type metastoresData struct {
-		Ids map[string]string `json:"ids,omitempty" tf:"computed"`
+		Region     string             `json:"region,omitempty"`
+		Ids        map[string]string  `json:"ids,omitempty" tf:"computed"`
+		Metastores []MetastoreSummary `json:"metastores,omitempty" tf:"computed"`
 	}
 	return common.AccountData(func(ctx context.Context, data *metastoresData, acc *databricks.AccountClient) error {
 		metastores, err := acc.Metastores.ListAll(ctx)
@@ -18,13 +32,30 @@ func DataSourceMetastores() common.Resource {
 			return err
 		}
 		data.Ids = map[string]string{}
+		data.Metastores = []MetastoreSummary{}
 		for _, v := range metastores {
+			if data.Region != "" && !strings.EqualFold(data.Region, v.Region) {
+				continue
+			}
 			name := v.Name
 			_, duplicateName := data.Ids[name]
 			if duplicateName {
 				return fmt.Errorf("duplicate metastore name detected: %s", name)
 			}
 			data.Ids[name] = v.MetastoreId
+			assignments, err := acc.MetastoreAssignments.ListByMetastoreId(ctx, v.MetastoreId)
+			if err != nil {
+				return err
+			}
+			data.Metastores = append(data.Metastores, MetastoreSummary{
+				MetastoreId:             v.MetastoreId,
+				Name:                    v.Name,
+				Cloud:                   v.Cloud,
+				Region:                  v.Region,
+				Owner:                   v.Owner,
+				CreatedBy:               v.CreatedBy,
+				AssignedWorkspacesCount: len(assignments.WorkspaceIds),
+			})
 		}
 		return nil
 	})
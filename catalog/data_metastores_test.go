@@ -32,14 +32,78 @@ func TestMetastoresDataContainsName(t *testing.T) {
 					},
 				},
 			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/testaccount/metastores/abc/workspaces?",
+				Response: catalog.ListAccountMetastoreAssignmentsResponse{
+					WorkspaceIds: []int64{1, 2},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/testaccount/metastores/ded/workspaces?",
+				Response: catalog.ListAccountMetastoreAssignmentsResponse{},
+			},
+		},
+		Resource:    DataSourceMetastores(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		AccountID:   "testaccount",
+	}.ApplyAndExpectData(t, map[string]any{
+		"ids":                                    map[string]interface{}{"a": "abc", "b": "ded"},
+		"metastores.#":                           2,
+		"metastores.0.owner":                     "John.Doe@example.com",
+		"metastores.0.assigned_workspaces_count": 2,
+		"metastores.1.assigned_workspaces_count": 0,
+	})
+}
+
+func TestMetastoresDataFilterByRegion(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/testaccount/metastores",
+				Response: catalog.ListMetastoresResponse{
+					Metastores: []catalog.MetastoreInfo{
+						{
+							Name:        "a",
+							MetastoreId: "abc",
+							Cloud:       "aws",
+							Region:      "us-east-1",
+							CreatedBy:   "admin@example.com",
+						},
+						{
+							Name:        "b",
+							MetastoreId: "ded",
+							Cloud:       "aws",
+							Region:      "eu-west-1",
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/testaccount/metastores/abc/workspaces?",
+				Response: catalog.ListAccountMetastoreAssignmentsResponse{
+					WorkspaceIds: []int64{1},
+				},
+			},
 		},
 		Resource:    DataSourceMetastores(),
 		Read:        true,
 		NonWritable: true,
 		ID:          "_",
 		AccountID:   "testaccount",
+		HCL:         `region = "US-EAST-1"`,
 	}.ApplyAndExpectData(t, map[string]any{
-		"ids": map[string]interface{}{"a": "abc", "b": "ded"},
+		"ids":                                    map[string]interface{}{"a": "abc"},
+		"metastores.#":                           1,
+		"metastores.0.cloud":                     "aws",
+		"metastores.0.region":                    "us-east-1",
+		"metastores.0.created_by":                "admin@example.com",
+		"metastores.0.assigned_workspaces_count": 1,
 	})
 }
 
@@ -8,10 +8,22 @@ import (
 	"github.com/databricks/terraform-provider-databricks/common"
 )
 
+type schemaDetails struct {
+	FullName        string            `json:"full_name"`
+	Name            string            `json:"name"`
+	Owner           string            `json:"owner,omitempty"`
+	Comment         string            `json:"comment,omitempty"`
+	Properties      map[string]string `json:"properties,omitempty"`
+	StorageRoot     string            `json:"storage_root,omitempty"`
+	StorageLocation string            `json:"storage_location,omitempty"`
+}
+
 func DataSourceSchemas() common.Resource {
 	return common.WorkspaceData(func(ctx context.Context, data *struct {
-		CatalogName string   `json:"catalog_name"`
-		Ids         []string `json:"ids,omitempty" tf:"computed,slice_set"`
+		CatalogName string          `json:"catalog_name"`
+		Detailed    bool            `json:"detailed,omitempty"`
+		Ids         []string        `json:"ids,omitempty" tf:"computed,slice_set"`
+		Schemas     []schemaDetails `json:"schemas,omitempty" tf:"computed"`
 	}, w *databricks.WorkspaceClient) error {
 		schemas, err := w.Schemas.ListAll(ctx, catalog.ListSchemasRequest{CatalogName: data.CatalogName})
 		if err != nil {
@@ -20,6 +32,24 @@ func DataSourceSchemas() common.Resource {
 		for _, v := range schemas {
 			data.Ids = append(data.Ids, v.FullName)
 		}
-		return nil
+		if !data.Detailed {
+			return nil
+		}
+		data.Schemas, err = getDetails(ctx, data.Ids, func(ctx context.Context, name string) (schemaDetails, error) {
+			s, err := w.Schemas.GetByFullName(ctx, name)
+			if err != nil {
+				return schemaDetails{}, err
+			}
+			return schemaDetails{
+				FullName:        s.FullName,
+				Name:            s.Name,
+				Owner:           s.Owner,
+				Comment:         s.Comment,
+				Properties:      s.Properties,
+				StorageRoot:     s.StorageRoot,
+				StorageLocation: s.StorageLocation,
+			}, nil
+		})
+		return err
 	})
 }
@@ -42,3 +42,48 @@ func TestSchemasData_Error(t *testing.T) {
 		ID:          "_",
 	}.ExpectError(t, "i'm a teapot")
 }
+
+func TestSchemasDataDetailed(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/schemas?catalog_name=a",
+				Response: catalog.ListSchemasResponse{
+					Schemas: []catalog.SchemaInfo{
+						{
+							Name:     "c",
+							FullName: "a.c",
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/schemas/a.c?",
+				Response: catalog.SchemaInfo{
+					Name:        "c",
+					FullName:    "a.c",
+					Owner:       "data-engineers",
+					Comment:     "raw data",
+					Properties:  map[string]string{"team": "de"},
+					StorageRoot: "s3://bucket/c",
+				},
+			},
+		},
+		Resource: DataSourceSchemas(),
+		HCL: `
+		catalog_name = "a"
+		detailed = true`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"schemas.#":                 1,
+		"schemas.0.full_name":       "a.c",
+		"schemas.0.owner":           "data-engineers",
+		"schemas.0.comment":         "raw data",
+		"schemas.0.properties.team": "de",
+		"schemas.0.storage_root":    "s3://bucket/c",
+	})
+}
@@ -9,6 +9,7 @@ import (
 func DataSourceShare() common.Resource {
 	type ShareDetail struct {
 		Name      string             `json:"name,omitempty" tf:"computed"`
+		Owner     string             `json:"owner,omitempty" tf:"computed"`
 		Objects   []SharedDataObject `json:"objects,omitempty" tf:"computed,slice_set,alias:object"`
 		CreatedAt int64              `json:"created_at,omitempty" tf:"computed"`
 		CreatedBy string             `json:"created_by,omitempty" tf:"computed"`
@@ -16,10 +17,13 @@ func DataSourceShare() common.Resource {
 	return common.DataResource(ShareDetail{}, func(ctx context.Context, e any, c *common.DatabricksClient) error {
 		data := e.(*ShareDetail)
 		sharesAPI := NewSharesAPI(ctx, c)
-		share, err := sharesAPI.get(data.Name)
+		// the resource hides `cdf_enabled` when history sharing is enabled, but the data source
+		// reports the flags of shared objects as they are
+		share, err := sharesAPI.getWithSharedData(data.Name)
 		if err != nil {
 			return err
 		}
+		data.Owner = share.Owner
 		data.Objects = share.Objects
 		data.CreatedAt = share.CreatedAt
 		data.CreatedBy = share.CreatedBy
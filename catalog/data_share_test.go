@@ -64,6 +64,48 @@ func TestShareData(t *testing.T) {
 		d.Get("object").(*schema.Set).List()[0])
 }
 
+func TestShareData_HistoryAndCDF(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/shares/a?include_shared_data=true",
+				Response: ShareInfo{
+					Name:  "a",
+					Owner: "admins",
+					Objects: []SharedDataObject{
+						{
+							Name:                     "main.sales.orders",
+							DataObjectType:           "TABLE",
+							CDFEnabled:               true,
+							StartVersion:             5,
+							HistoryDataSharingStatus: "ENABLED",
+							Status:                   "ACTIVE",
+							AddedAt:                  1921322,
+							AddedBy:                  "alice",
+						},
+					},
+				},
+			},
+		},
+		Resource:    DataSourceShare(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL: `
+		name = "a"
+		`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "admins", d.Get("owner"))
+	object := d.Get("object").(*schema.Set).List()[0].(map[string]any)
+	assert.Equal(t, true, object["cdf_enabled"])
+	assert.Equal(t, "ENABLED", object["history_data_sharing_status"])
+	assert.Equal(t, 5, object["start_version"])
+	assert.Equal(t, 1921322, object["added_at"])
+	assert.Equal(t, "alice", object["added_by"])
+}
+
 func TestShareData_Error(t *testing.T) {
 	qa.ResourceFixture{
 		Fixtures:    qa.HTTPFailures,
@@ -8,11 +8,24 @@ import (
 	"github.com/databricks/terraform-provider-databricks/common"
 )
 
+type tableDetails struct {
+	FullName         string            `json:"full_name"`
+	Name             string            `json:"name"`
+	TableType        string            `json:"table_type,omitempty"`
+	DataSourceFormat string            `json:"data_source_format,omitempty"`
+	Owner            string            `json:"owner,omitempty"`
+	Comment          string            `json:"comment,omitempty"`
+	Properties       map[string]string `json:"properties,omitempty"`
+	StorageLocation  string            `json:"storage_location,omitempty"`
+}
+
 func DataSourceTables() common.Resource {
 	return common.WorkspaceData(func(ctx context.Context, data *struct {
-		CatalogName string   `json:"catalog_name"`
-		SchemaName  string   `json:"schema_name"`
-		Ids         []string `json:"ids,omitempty" tf:"computed,slice_set"`
+		CatalogName string         `json:"catalog_name"`
+		SchemaName  string         `json:"schema_name"`
+		Detailed    bool           `json:"detailed,omitempty"`
+		Ids         []string       `json:"ids,omitempty" tf:"computed,slice_set"`
+		Tables      []tableDetails `json:"tables,omitempty" tf:"computed"`
 	}, w *databricks.WorkspaceClient) error {
 		tables, err := w.Tables.ListAll(ctx, catalog.ListTablesRequest{CatalogName: data.CatalogName, SchemaName: data.SchemaName})
 		if err != nil {
@@ -23,6 +36,25 @@ func DataSourceTables() common.Resource {
 				data.Ids = append(data.Ids, v.FullName)
 			}
 		}
-		return nil
+		if !data.Detailed {
+			return nil
+		}
+		data.Tables, err = getDetails(ctx, data.Ids, func(ctx context.Context, name string) (tableDetails, error) {
+			t, err := w.Tables.GetByFullName(ctx, name)
+			if err != nil {
+				return tableDetails{}, err
+			}
+			return tableDetails{
+				FullName:         t.FullName,
+				Name:             t.Name,
+				TableType:        string(t.TableType),
+				DataSourceFormat: string(t.DataSourceFormat),
+				Owner:            t.Owner,
+				Comment:          t.Comment,
+				Properties:       t.Properties,
+				StorageLocation:  t.StorageLocation,
+			}, nil
+		})
+		return err
 	})
 }
@@ -3,6 +3,7 @@ package catalog
 import (
 	"testing"
 
+	"github.com/databricks/databricks-sdk-go/apierr"
 	"github.com/databricks/databricks-sdk-go/service/catalog"
 	"github.com/databricks/terraform-provider-databricks/qa"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -117,3 +118,93 @@ func TestTablesData_Error(t *testing.T) {
 		ID:          "_",
 	}.ExpectError(t, "i'm a teapot")
 }
+
+func TestTablesDataDetailed(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/tables?catalog_name=a&schema_name=b",
+				Response: catalog.ListTablesResponse{
+					Tables: []catalog.TableInfo{
+						{
+							FullName:  "a.b.c",
+							Name:      "c",
+							TableType: "EXTERNAL",
+						},
+						{
+							FullName:  "a.b.d",
+							Name:      "d",
+							TableType: "VIEW",
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/tables/a.b.c?",
+				Response: catalog.TableInfo{
+					FullName:         "a.b.c",
+					Name:             "c",
+					TableType:        "EXTERNAL",
+					DataSourceFormat: "DELTA",
+					Owner:            "data-engineers",
+					StorageLocation:  "s3://bucket/c",
+					Properties:       map[string]string{"delta.appendOnly": "true"},
+				},
+			},
+		},
+		Resource: DataSourceTables(),
+		HCL: `
+		catalog_name = "a"
+		schema_name = "b"
+		detailed = true`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"tables.#":                    1,
+		"tables.0.full_name":          "a.b.c",
+		"tables.0.table_type":         "EXTERNAL",
+		"tables.0.data_source_format": "DELTA",
+		"tables.0.owner":              "data-engineers",
+		"tables.0.storage_location":   "s3://bucket/c",
+		"tables.0.properties.%":       "1",
+	})
+}
+
+func TestTablesDataDetailedError(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/tables?catalog_name=a&schema_name=b",
+				Response: catalog.ListTablesResponse{
+					Tables: []catalog.TableInfo{
+						{
+							FullName: "a.b.c",
+							Name:     "c",
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/tables/a.b.c?",
+				Response: apierr.APIErrorBody{
+					ErrorCode: "PERMISSION_DENIED",
+					Message:   "no access",
+				},
+				Status: 403,
+			},
+		},
+		Resource: DataSourceTables(),
+		HCL: `
+		catalog_name = "a"
+		schema_name = "b"
+		detailed = true`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "no access")
+}
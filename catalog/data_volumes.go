@@ -8,11 +8,22 @@ import (
 	"github.com/databricks/terraform-provider-databricks/common"
 )
 
+type volumeDetails struct {
+	FullName        string `json:"full_name"`
+	Name            string `json:"name"`
+	VolumeType      string `json:"volume_type,omitempty"`
+	Owner           string `json:"owner,omitempty"`
+	Comment         string `json:"comment,omitempty"`
+	StorageLocation string `json:"storage_location,omitempty"`
+}
+
 func DataSourceVolumes() common.Resource {
 	return common.WorkspaceData(func(ctx context.Context, data *struct {
-		CatalogName string   `json:"catalog_name"`
-		SchemaName  string   `json:"schema_name"`
-		Ids         []string `json:"ids,omitempty" tf:"computed,slice_set"`
+		CatalogName string          `json:"catalog_name"`
+		SchemaName  string          `json:"schema_name"`
+		Detailed    bool            `json:"detailed,omitempty"`
+		Ids         []string        `json:"ids,omitempty" tf:"computed,slice_set"`
+		Volumes     []volumeDetails `json:"volumes,omitempty" tf:"computed"`
 	}, w *databricks.WorkspaceClient) error {
 		volumes, err := w.Volumes.ListAll(ctx, catalog.ListVolumesRequest{CatalogName: data.CatalogName, SchemaName: data.SchemaName})
 		if err != nil {
@@ -21,6 +32,23 @@ func DataSourceVolumes() common.Resource {
 		for _, v := range volumes {
 			data.Ids = append(data.Ids, v.FullName)
 		}
-		return nil
+		if !data.Detailed {
+			return nil
+		}
+		data.Volumes, err = getDetails(ctx, data.Ids, func(ctx context.Context, name string) (volumeDetails, error) {
+			v, err := w.Volumes.Read(ctx, catalog.ReadVolumeRequest{FullNameArg: name})
+			if err != nil {
+				return volumeDetails{}, err
+			}
+			return volumeDetails{
+				FullName:        v.FullName,
+				Name:            v.Name,
+				VolumeType:      string(v.VolumeType),
+				Owner:           v.Owner,
+				Comment:         v.Comment,
+				StorageLocation: v.StorageLocation,
+			}, nil
+		})
+		return err
 	})
 }
@@ -42,3 +42,48 @@ func TestDataSourceVolumes_Error(t *testing.T) {
 		ID:          "_",
 	}.ExpectError(t, "i'm a teapot")
 }
+
+func TestDataSourceVolumesDetailed(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/volumes?catalog_name=a&schema_name=b",
+				Response: catalog.ListVolumesResponseContent{
+					Volumes: []catalog.VolumeInfo{
+						{
+							FullName: "a.b.c",
+							Name:     "c",
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/volumes/a.b.c?",
+				Response: catalog.VolumeInfo{
+					FullName:        "a.b.c",
+					Name:            "c",
+					VolumeType:      "EXTERNAL",
+					Owner:           "data-engineers",
+					Comment:         "landing zone",
+					StorageLocation: "s3://bucket/landing",
+				},
+			},
+		},
+		Resource: DataSourceVolumes(),
+		HCL: `
+		catalog_name = "a"
+		schema_name = "b"
+		detailed = true`,
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ApplyAndExpectData(t, map[string]any{
+		"volumes.#":                  1,
+		"volumes.0.volume_type":      "EXTERNAL",
+		"volumes.0.owner":            "data-engineers",
+		"volumes.0.comment":          "landing zone",
+		"volumes.0.storage_location": "s3://bucket/landing",
+	})
+}
@@ -0,0 +1,37 @@
+package catalog
+
+import (
+	"context"
+	"sync"
+)
+
+// detailsConcurrency limits the number of parallel requests made by data sources with `detailed = true`,
+// so listings of big schemas don't exhaust rate limits of Unity Catalog API
+const detailsConcurrency = 10
+
+// getDetails fetches details of every object with at most detailsConcurrency requests in flight. Details
+// are returned in the same order as names, and the first error is returned if any request fails.
+func getDetails[T any](ctx context.Context, names []string, get func(ctx context.Context, name string) (T, error)) ([]T, error) {
+	details := make([]T, len(names))
+	errs := make([]error, len(names))
+	semaphore := make(chan struct{}, detailsConcurrency)
+	var wg sync.WaitGroup
+	for i, name := range names {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int, name string) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			details[i], errs[i] = get(ctx, name)
+		}(i, name)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return details, nil
+}
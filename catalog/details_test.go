@@ -0,0 +1,45 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDetailsBoundedConcurrency(t *testing.T) {
+	var names []string
+	for i := 0; i < 3*detailsConcurrency; i++ {
+		names = append(names, fmt.Sprintf("a.b.t%d", i))
+	}
+	var inFlight, maxInFlight int32
+	details, err := getDetails(context.Background(), names, func(ctx context.Context, name string) (string, error) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			m := atomic.LoadInt32(&maxInFlight)
+			if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+				break
+			}
+		}
+		return name + "!", nil
+	})
+	require.NoError(t, err)
+	assert.Len(t, details, len(names))
+	assert.Equal(t, "a.b.t0!", details[0])
+	assert.Equal(t, "a.b.t29!", details[29])
+	assert.LessOrEqual(t, maxInFlight, int32(detailsConcurrency))
+}
+
+func TestGetDetailsError(t *testing.T) {
+	_, err := getDetails(context.Background(), []string{"a", "b"}, func(ctx context.Context, name string) (int, error) {
+		if name == "b" {
+			return 0, fmt.Errorf("can't get %s", name)
+		}
+		return 1, nil
+	})
+	assert.EqualError(t, err, "can't get b")
+}
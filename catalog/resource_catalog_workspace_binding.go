@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
+	"strings"
 
 	"github.com/databricks/databricks-sdk-go/apierr"
 	"github.com/databricks/databricks-sdk-go/service/catalog"
@@ -80,6 +82,12 @@ func ResourceCatalogWorkspaceBinding() common.Resource {
 			if err != nil {
 				return err
 			}
+			if getSecurableName(d) == "" {
+				// resource is imported, so only ID is known
+				if err = bindingFromId(d); err != nil {
+					return err
+				}
+			}
 			workspaceId := int64(d.Get("workspace_id").(int))
 			bindings, err := w.WorkspaceBindings.GetBindings(ctx, catalog.GetBindingsRequest{
 				SecurableName: getSecurableName(d),
@@ -112,6 +120,22 @@ func ResourceCatalogWorkspaceBinding() common.Resource {
 	}
 }
 
+// bindingFromId sets attributes from the ID in the `<workspace_id>|<securable_type>|<securable_name>` format
+func bindingFromId(d *schema.ResourceData) error {
+	parts := strings.SplitN(d.Id(), "|", 3)
+	if len(parts) != 3 {
+		return fmt.Errorf("incorrect binding id: %s. Correct format: <workspace_id>|<securable_type>|<securable_name>", d.Id())
+	}
+	workspaceId, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("incorrect workspace id in binding id %s: %w", d.Id(), err)
+	}
+	d.Set("workspace_id", workspaceId)
+	d.Set("securable_type", parts[1])
+	d.Set("securable_name", parts[2])
+	return nil
+}
+
 // migrate to v1 state, as catalog_name is moved to securable_name
 func bindingMigrateV0(ctx context.Context, rawState map[string]any, meta any) (map[string]any, error) {
 	newState := map[string]any{}
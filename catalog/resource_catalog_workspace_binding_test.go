@@ -9,7 +9,7 @@ import (
 
 func TestCatalogWorkspaceBindingsCornerCases(t *testing.T) {
 	qa.ResourceCornerCases(t, ResourceCatalogWorkspaceBinding(),
-		qa.CornerCaseID("my_catalog|1234567890101112"),
+		qa.CornerCaseID("1234567890101112|catalog|my_catalog"),
 		qa.CornerCaseSkipCRUD("create"))
 }
 
@@ -202,3 +202,40 @@ func TestSecurableWorkspaceBindings_Delete(t *testing.T) {
 		`,
 	}.ApplyNoError(t)
 }
+
+func TestSecurableWorkspaceBindings_Import(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/bindings/external_location/my_location?",
+				Response: catalog.WorkspaceBindingsResponse{
+					Bindings: []catalog.WorkspaceBinding{
+						{
+							BindingType: catalog.WorkspaceBindingBindingTypeBindingTypeReadOnly,
+							WorkspaceId: int64(1234567890101112),
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceCatalogWorkspaceBinding(),
+		Read:     true,
+		New:      true,
+		ID:       "1234567890101112|external_location|my_location",
+	}.ApplyAndExpectData(t, map[string]any{
+		"workspace_id":   1234567890101112,
+		"securable_type": "external_location",
+		"securable_name": "my_location",
+		"binding_type":   "BINDING_TYPE_READ_ONLY",
+	})
+}
+
+func TestSecurableWorkspaceBindings_ImportWrongId(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceCatalogWorkspaceBinding(),
+		Read:     true,
+		New:      true,
+		ID:       "my_location",
+	}.ExpectError(t, "incorrect binding id: my_location. Correct format: <workspace_id>|<securable_type>|<securable_name>")
+}
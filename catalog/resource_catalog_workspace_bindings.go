@@ -0,0 +1,169 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// maximal number of workspaces that are added or removed in a single API call
+const workspaceBindingsBatchSize = 100
+
+type workspaceBindings struct {
+	SecurableName string  `json:"securable_name" tf:"force_new"`
+	SecurableType string  `json:"securable_type,omitempty" tf:"default:catalog,force_new"`
+	BindingType   string  `json:"binding_type,omitempty" tf:"default:BINDING_TYPE_READ_WRITE"`
+	WorkspaceIDs  []int64 `json:"workspace_ids" tf:"slice_set"`
+}
+
+func workspaceBindingsFromIDs(ids []int64, bindingType string) []catalog.WorkspaceBinding {
+	ids = append([]int64{}, ids...)
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+	bindings := []catalog.WorkspaceBinding{}
+	for _, id := range ids {
+		bindings = append(bindings, catalog.WorkspaceBinding{
+			WorkspaceId: id,
+			BindingType: catalog.WorkspaceBindingBindingType(bindingType),
+		})
+	}
+	return bindings
+}
+
+// updateWorkspaceBindings adds and removes bindings in batches, so that securables could be bound
+// to hundreds of workspaces without hitting request size limits
+func updateWorkspaceBindings(ctx context.Context, w *databricks.WorkspaceClient, wb workspaceBindings,
+	add, remove []catalog.WorkspaceBinding) error {
+	for len(add) > 0 || len(remove) > 0 {
+		req := catalog.UpdateWorkspaceBindingsParameters{
+			SecurableName: wb.SecurableName,
+			SecurableType: wb.SecurableType,
+		}
+		n := min(len(add), workspaceBindingsBatchSize)
+		req.Add, add = add[:n], add[n:]
+		n = min(len(remove), workspaceBindingsBatchSize-len(req.Add))
+		req.Remove, remove = remove[:n], remove[n:]
+		_, err := w.WorkspaceBindings.UpdateBindings(ctx, req)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func ResourceCatalogWorkspaceBindings() common.Resource {
+	s := common.StructToSchema(workspaceBindings{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			m["binding_type"].ValidateFunc = validation.StringInSlice([]string{
+				string(catalog.WorkspaceBindingBindingTypeBindingTypeReadWrite),
+				string(catalog.WorkspaceBindingBindingTypeBindingTypeReadOnly),
+			}, false)
+			return m
+		})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			var wb workspaceBindings
+			common.DataToStructPointer(d, s, &wb)
+			err = updateWorkspaceBindings(ctx, w, wb, workspaceBindingsFromIDs(wb.WorkspaceIDs, wb.BindingType), nil)
+			if err != nil {
+				return err
+			}
+			d.SetId(fmt.Sprintf("%s|%s", wb.SecurableType, wb.SecurableName))
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			securableType, securableName, found := strings.Cut(d.Id(), "|")
+			if !found {
+				return fmt.Errorf("incorrect bindings id: %s. Correct format: <securable_type>|<securable_name>", d.Id())
+			}
+			var wb workspaceBindings
+			common.DataToStructPointer(d, s, &wb)
+			bindings, err := w.WorkspaceBindings.GetBindings(ctx, catalog.GetBindingsRequest{
+				SecurableName: securableName,
+				SecurableType: securableType,
+			})
+			if err != nil {
+				return err
+			}
+			managed := map[int64]bool{}
+			for _, id := range wb.WorkspaceIDs {
+				managed[id] = true
+			}
+			imported := len(managed) == 0
+			current := workspaceBindings{
+				SecurableName: securableName,
+				SecurableType: securableType,
+				BindingType:   wb.BindingType,
+				WorkspaceIDs:  []int64{},
+			}
+			for _, binding := range bindings.Bindings {
+				if imported && current.BindingType == "" {
+					current.BindingType = string(binding.BindingType)
+				}
+				// bindings of other workspaces, or with another binding type, aren't managed by this resource
+				if (imported || managed[binding.WorkspaceId]) && string(binding.BindingType) == current.BindingType {
+					current.WorkspaceIDs = append(current.WorkspaceIDs, binding.WorkspaceId)
+				}
+			}
+			return common.StructToData(current, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			var wb workspaceBindings
+			common.DataToStructPointer(d, s, &wb)
+			toIDs := func(v any) map[int64]bool {
+				ids := map[int64]bool{}
+				for _, id := range v.(*schema.Set).List() {
+					ids[int64(id.(int))] = true
+				}
+				return ids
+			}
+			oldIDs, newIDs := d.GetChange("workspace_ids")
+			previous, current := toIDs(oldIDs), toIDs(newIDs)
+			var added, removed []int64
+			for id := range current {
+				// binding type of existing bindings is updated by adding them again
+				if !previous[id] || d.HasChange("binding_type") {
+					added = append(added, id)
+				}
+			}
+			for id := range previous {
+				if !current[id] {
+					removed = append(removed, id)
+				}
+			}
+			return updateWorkspaceBindings(ctx, w, wb,
+				workspaceBindingsFromIDs(added, wb.BindingType),
+				workspaceBindingsFromIDs(removed, wb.BindingType))
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			var wb workspaceBindings
+			common.DataToStructPointer(d, s, &wb)
+			return updateWorkspaceBindings(ctx, w, wb, nil, workspaceBindingsFromIDs(wb.WorkspaceIDs, wb.BindingType))
+		},
+	}
+}
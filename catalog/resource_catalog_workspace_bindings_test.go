@@ -0,0 +1,212 @@
+package catalog
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestCatalogWorkspaceBindingsBulkCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceCatalogWorkspaceBindings(),
+		qa.CornerCaseID("catalog|my_catalog"),
+		qa.CornerCaseSkipCRUD("create"),
+		// there are no API calls for empty workspace_ids
+		qa.CornerCaseSkipCRUD("update"),
+		qa.CornerCaseSkipCRUD("delete"))
+}
+
+func TestCatalogWorkspaceBindingsBulk_CreateBatches(t *testing.T) {
+	ids := []int64{}
+	hclIDs := []string{}
+	for i := 1; i <= 150; i++ {
+		ids = append(ids, int64(i))
+		hclIDs = append(hclIDs, fmt.Sprint(i))
+	}
+	bindings := workspaceBindingsFromIDs(ids, "BINDING_TYPE_READ_ONLY")
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/bindings/catalog/my_catalog",
+				ExpectedRequest: catalog.UpdateWorkspaceBindingsParameters{
+					Add: bindings[:100],
+				},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/bindings/catalog/my_catalog",
+				ExpectedRequest: catalog.UpdateWorkspaceBindingsParameters{
+					Add: bindings[100:],
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/bindings/catalog/my_catalog?",
+				Response: catalog.WorkspaceBindingsResponse{
+					Bindings: append(bindings, catalog.WorkspaceBinding{
+						WorkspaceId: 1000,
+						BindingType: catalog.WorkspaceBindingBindingTypeBindingTypeReadWrite,
+					}),
+				},
+			},
+		},
+		Resource: ResourceCatalogWorkspaceBindings(),
+		Create:   true,
+		HCL: fmt.Sprintf(`
+		securable_name = "my_catalog"
+		binding_type = "BINDING_TYPE_READ_ONLY"
+		workspace_ids = [%s]
+		`, strings.Join(hclIDs, ", ")),
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":              "catalog|my_catalog",
+		"workspace_ids.#": 150,
+	})
+}
+
+func TestCatalogWorkspaceBindingsBulk_Import(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/bindings/catalog/my_catalog?",
+				Response: catalog.WorkspaceBindingsResponse{
+					Bindings: []catalog.WorkspaceBinding{
+						{
+							WorkspaceId: 2,
+							BindingType: catalog.WorkspaceBindingBindingTypeBindingTypeReadWrite,
+						},
+						{
+							WorkspaceId: 1,
+							BindingType: catalog.WorkspaceBindingBindingTypeBindingTypeReadWrite,
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceCatalogWorkspaceBindings(),
+		Read:     true,
+		New:      true,
+		ID:       "catalog|my_catalog",
+	}.ApplyAndExpectData(t, map[string]any{
+		"securable_name":  "my_catalog",
+		"securable_type":  "catalog",
+		"binding_type":    "BINDING_TYPE_READ_WRITE",
+		"workspace_ids.#": 2,
+	})
+}
+
+func workspaceIDsHash(id int) int {
+	return ResourceCatalogWorkspaceBindings().Schema["workspace_ids"].ZeroValue().(*schema.Set).F(id)
+}
+
+func TestCatalogWorkspaceBindingsBulk_Update(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/bindings/catalog/my_catalog",
+				ExpectedRequest: catalog.UpdateWorkspaceBindingsParameters{
+					Add:    workspaceBindingsFromIDs([]int64{3}, "BINDING_TYPE_READ_WRITE"),
+					Remove: workspaceBindingsFromIDs([]int64{1}, "BINDING_TYPE_READ_WRITE"),
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/bindings/catalog/my_catalog?",
+				Response: catalog.WorkspaceBindingsResponse{
+					Bindings: workspaceBindingsFromIDs([]int64{2, 3}, "BINDING_TYPE_READ_WRITE"),
+				},
+			},
+		},
+		Resource: ResourceCatalogWorkspaceBindings(),
+		Update:   true,
+		ID:       "catalog|my_catalog",
+		InstanceState: map[string]string{
+			"securable_name":  "my_catalog",
+			"securable_type":  "catalog",
+			"binding_type":    "BINDING_TYPE_READ_WRITE",
+			"workspace_ids.#": "2",
+			fmt.Sprintf("workspace_ids.%d", workspaceIDsHash(1)): "1",
+			fmt.Sprintf("workspace_ids.%d", workspaceIDsHash(2)): "2",
+		},
+		HCL: `
+		securable_name = "my_catalog"
+		workspace_ids = [2, 3]
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"workspace_ids.#": 2,
+	})
+}
+
+func TestCatalogWorkspaceBindingsBulk_UpdateBindingType(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/bindings/catalog/my_catalog",
+				ExpectedRequest: catalog.UpdateWorkspaceBindingsParameters{
+					Add: workspaceBindingsFromIDs([]int64{1}, "BINDING_TYPE_READ_ONLY"),
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/bindings/catalog/my_catalog?",
+				Response: catalog.WorkspaceBindingsResponse{
+					Bindings: workspaceBindingsFromIDs([]int64{1}, "BINDING_TYPE_READ_ONLY"),
+				},
+			},
+		},
+		Resource: ResourceCatalogWorkspaceBindings(),
+		Update:   true,
+		ID:       "catalog|my_catalog",
+		InstanceState: map[string]string{
+			"securable_name":  "my_catalog",
+			"securable_type":  "catalog",
+			"binding_type":    "BINDING_TYPE_READ_WRITE",
+			"workspace_ids.#": "1",
+			fmt.Sprintf("workspace_ids.%d", workspaceIDsHash(1)): "1",
+		},
+		HCL: `
+		securable_name = "my_catalog"
+		binding_type = "BINDING_TYPE_READ_ONLY"
+		workspace_ids = [1]
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"binding_type":    "BINDING_TYPE_READ_ONLY",
+		"workspace_ids.#": 1,
+	})
+}
+
+func TestCatalogWorkspaceBindingsBulk_Delete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/bindings/catalog/my_catalog",
+				ExpectedRequest: catalog.UpdateWorkspaceBindingsParameters{
+					Remove: workspaceBindingsFromIDs([]int64{1, 2}, "BINDING_TYPE_READ_WRITE"),
+				},
+			},
+		},
+		Resource: ResourceCatalogWorkspaceBindings(),
+		Delete:   true,
+		ID:       "catalog|my_catalog",
+		HCL: `
+		securable_name = "my_catalog"
+		workspace_ids = [1, 2]
+		`,
+	}.ApplyNoError(t)
+}
+
+func TestCatalogWorkspaceBindingsBulk_InvalidID(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceCatalogWorkspaceBindings(),
+		Read:     true,
+		New:      true,
+		ID:       "my_catalog",
+	}.ExpectError(t, "incorrect bindings id: my_catalog. Correct format: <securable_type>|<securable_name>")
+}
@@ -2,6 +2,7 @@ package catalog
 
 import (
 	"context"
+	"strings"
 
 	"github.com/databricks/databricks-sdk-go/service/catalog"
 	"github.com/databricks/terraform-provider-databricks/common"
@@ -36,6 +37,13 @@ type ConnectionInfo struct {
 
 var sensitiveOptions = []string{"user", "password", "personalAccessToken", "access_token", "client_secret", "OAuthPvtKey", "GoogleServiceAccountKeyJson"}
 
+// connectionTypeHiveMetastore is used for federation of the Hive metastore, either external one or
+// the legacy built-in Hive metastore of the workspace, when `builtin` option is set to `true`
+const connectionTypeHiveMetastore = "HIVE_METASTORE"
+
+// options of HIVE_METASTORE connections that aren't returned by the API
+var hiveMetastoreOptions = []string{"builtin"}
+
 func ResourceConnection() common.Resource {
 	s := common.StructToSchema(ConnectionInfo{},
 		common.NoCustomize)
@@ -94,10 +102,15 @@ func ResourceConnection() common.Resource {
 			if conn.Options == nil {
 				conn.Options = map[string]string{}
 			}
+			isHiveMetastore := strings.EqualFold(string(conn.ConnectionType), connectionTypeHiveMetastore)
 			for key, element := range cOrig.Options {
 				if slices.Contains(sensitiveOptions, key) {
 					conn.Options[key] = element
 				}
+				if _, returned := conn.Options[key]; isHiveMetastore && !returned &&
+					slices.Contains(hiveMetastoreOptions, key) {
+					conn.Options[key] = element
+				}
 			}
 			return common.StructToData(conn, s, d)
 		},
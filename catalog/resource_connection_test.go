@@ -204,6 +204,84 @@ func TestConnectionsRead(t *testing.T) {
 	assert.Equal(t, map[string]interface{}{"host": "test.com"}, d.Get("options"))
 }
 
+func TestConnectionsCreateBuiltinHiveMetastore(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.1/unity-catalog/connections",
+				ExpectedRequest: catalog.CreateConnection{
+					Name:           "hms",
+					ConnectionType: catalog.ConnectionType("HIVE_METASTORE"),
+					Options: map[string]string{
+						"builtin": "true",
+					},
+				},
+				Response: catalog.ConnectionInfo{
+					Name:           "hms",
+					ConnectionType: catalog.ConnectionType("HIVE_METASTORE"),
+					FullName:       "hms",
+					MetastoreId:    "abc",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.1/unity-catalog/connections/hms?",
+				Response: catalog.ConnectionInfo{
+					Name:           "hms",
+					ConnectionType: catalog.ConnectionType("HIVE_METASTORE"),
+					FullName:       "hms",
+					MetastoreId:    "abc",
+					Owner:          "admins",
+					ReadOnly:       true,
+				},
+			},
+		},
+		Resource: ResourceConnection(),
+		Create:   true,
+		HCL: `
+		name = "hms"
+		connection_type = "HIVE_METASTORE"
+		options = {
+			builtin = "true"
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":              "abc|hms",
+		"options.builtin": "true",
+		"read_only":       true,
+	})
+}
+
+func TestConnectionsReadBuiltinHiveMetastore(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.1/unity-catalog/connections/hms?",
+				Response: catalog.ConnectionInfo{
+					Name:           "hms",
+					ConnectionType: catalog.ConnectionType("HIVE_METASTORE"),
+					FullName:       "hms",
+					MetastoreId:    "abc",
+				},
+			},
+		},
+		Resource: ResourceConnection(),
+		Read:     true,
+		ID:       "abc|hms",
+		HCL: `
+		name = "hms"
+		connection_type = "HIVE_METASTORE"
+		options = {
+			builtin = "true"
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"options.builtin": "true",
+	})
+}
+
 func TestConnectionRead_Error(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
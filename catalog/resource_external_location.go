@@ -2,7 +2,9 @@ package catalog
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/databricks/databricks-sdk-go"
 	"github.com/databricks/databricks-sdk-go/service/catalog"
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -22,6 +24,20 @@ type ExternalLocationInfo struct {
 	EncDetails     *catalog.EncryptionDetails `json:"encryption_details,omitempty"`
 }
 
+// validateExternalLocation validates the planned URL and credential before the external location is created or
+// updated, so that a failed validation doesn't leave the changed external location behind
+func validateExternalLocation(ctx context.Context, w *databricks.WorkspaceClient, d *schema.ResourceData) error {
+	if !d.Get("validate").(bool) {
+		return nil
+	}
+	return validateStorageAccess(ctx, w, fmt.Sprintf("external location %s", d.Get("name").(string)),
+		catalog.ValidateStorageCredential{
+			StorageCredentialName: d.Get("credential_name").(string),
+			Url:                   d.Get("url").(string),
+			ReadOnly:              d.Get("read_only").(bool),
+		})
+}
+
 func ResourceExternalLocation() common.Resource {
 	s := common.StructToSchema(ExternalLocationInfo{},
 		func(m map[string]*schema.Schema) map[string]*schema.Schema {
@@ -33,6 +49,10 @@ func ResourceExternalLocation() common.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			}
+			m["validate"] = &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			}
 			m["skip_validation"].DiffSuppressFunc = func(k, old, new string, d *schema.ResourceData) bool {
 				return old == "false" && new == "true"
 			}
@@ -50,6 +70,10 @@ func ResourceExternalLocation() common.Resource {
 			if err != nil {
 				return err
 			}
+			err = validateExternalLocation(ctx, w, d)
+			if err != nil {
+				return err
+			}
 			var createExternalLocationRequest catalog.CreateExternalLocation
 			common.DataToStructPointer(d, s, &createExternalLocationRequest)
 			el, err := w.ExternalLocations.Create(ctx, createExternalLocationRequest)
@@ -67,10 +91,7 @@ func ResourceExternalLocation() common.Resource {
 			common.DataToStructPointer(d, s, &updateExternalLocationRequest)
 			updateExternalLocationRequest.Name = d.Id()
 			_, err = w.ExternalLocations.Update(ctx, updateExternalLocationRequest)
-			if err != nil {
-				return err
-			}
-			return nil
+			return err
 		},
 		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
@@ -97,6 +118,10 @@ func ResourceExternalLocation() common.Resource {
 			common.DataToStructPointer(d, s, &updateExternalLocationRequest)
 			updateExternalLocationRequest.Name = d.Id()
 			updateExternalLocationRequest.Force = force
+			err = validateExternalLocation(ctx, w, d)
+			if err != nil {
+				return err
+			}
 
 			if d.HasChange("owner") {
 				_, err = w.ExternalLocations.Update(ctx, catalog.UpdateExternalLocation{
@@ -52,6 +52,140 @@ func TestCreateExternalLocation(t *testing.T) {
 	}.ApplyNoError(t)
 }
 
+func TestCreateExternalLocationWithValidation(t *testing.T) {
+	// the external location isn't created when the validation fails
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/validate-storage-credentials",
+				ExpectedRequest: catalog.ValidateStorageCredential{
+					StorageCredentialName: "bcd",
+					Url:                   "s3://foo/bar",
+				},
+				Response: catalog.ValidateStorageCredentialResponse{
+					Results: []catalog.ValidationResult{
+						{
+							Operation: catalog.ValidationResultOperationRead,
+							Result:    catalog.ValidationResultResultPass,
+						},
+						{
+							Operation: catalog.ValidationResultOperationWrite,
+							Result:    catalog.ValidationResultResultFail,
+							Message:   "Access Denied",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceExternalLocation(),
+		Create:   true,
+		HCL: `
+		name = "abc"
+		url = "s3://foo/bar"
+		credential_name = "bcd"
+		validate = true
+		`,
+	}.ExpectError(t, "validation of external location abc failed:\nREAD: PASS\nWRITE: FAIL (Access Denied)")
+}
+
+func TestUpdateExternalLocationWithValidation(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.1/unity-catalog/external-locations/abc",
+				ExpectedRequest: catalog.UpdateExternalLocation{
+					Url:            "s3://foo/bar",
+					CredentialName: "bcd",
+					Comment:        "def",
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/validate-storage-credentials",
+				ExpectedRequest: catalog.ValidateStorageCredential{
+					StorageCredentialName: "bcd",
+					Url:                   "s3://foo/bar",
+				},
+				Response: catalog.ValidateStorageCredentialResponse{
+					Results: []catalog.ValidationResult{
+						{
+							Operation: catalog.ValidationResultOperationRead,
+							Result:    catalog.ValidationResultResultPass,
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/external-locations/abc?",
+				Response: catalog.ExternalLocationInfo{
+					Name:           "abc",
+					Url:            "s3://foo/bar",
+					CredentialName: "bcd",
+					Comment:        "def",
+				},
+			},
+		},
+		Resource: ResourceExternalLocation(),
+		Update:   true,
+		ID:       "abc",
+		InstanceState: map[string]string{
+			"name":            "abc",
+			"url":             "s3://foo/bar",
+			"credential_name": "bcd",
+			"comment":         "abc",
+		},
+		HCL: `
+		name = "abc"
+		url = "s3://foo/bar"
+		credential_name = "bcd"
+		comment = "def"
+		validate = true
+		`,
+	}.ApplyNoError(t)
+}
+
+func TestUpdateExternalLocationValidationFailure(t *testing.T) {
+	// the external location isn't updated when the validation fails
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/validate-storage-credentials",
+				ExpectedRequest: catalog.ValidateStorageCredential{
+					StorageCredentialName: "bcd",
+					Url:                   "s3://foo/baz",
+				},
+				Response: catalog.ValidateStorageCredentialResponse{
+					Results: []catalog.ValidationResult{
+						{
+							Operation: catalog.ValidationResultOperationRead,
+							Result:    catalog.ValidationResultResultFail,
+							Message:   "Access Denied",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceExternalLocation(),
+		Update:   true,
+		ID:       "abc",
+		InstanceState: map[string]string{
+			"name":            "abc",
+			"url":             "s3://foo/bar",
+			"credential_name": "bcd",
+		},
+		HCL: `
+		name = "abc"
+		url = "s3://foo/baz"
+		credential_name = "bcd"
+		validate = true
+		`,
+	}.ExpectError(t, "validation of external location abc failed:\nREAD: FAIL (Access Denied)")
+}
+
 func TestCreateExternalLocationWithOwner(t *testing.T) {
 	qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -3,9 +3,7 @@ package catalog
 import (
 	"context"
 	"errors"
-	"fmt"
 	"sort"
-	"strings"
 	"time"
 
 	"github.com/databricks/databricks-sdk-go/apierr"
@@ -69,6 +67,7 @@ func diffPermissionsForPrincipal(principal string, desired catalog.PermissionsLi
 // replacePermissionsForPrincipal merges removal diff of existing permissions on the platform
 func replacePermissionsForPrincipal(a permissions.UnityCatalogPermissionsAPI, securable string, name string, principal string, list catalog.PermissionsList) error {
 	securableType := permissions.Mappings.GetSecurableType(securable)
+	defer grantsMutex.Lock(securableType.String() + "/" + name)()
 	existing, err := a.GetPermissions(securableType, name)
 	if err != nil {
 		return err
@@ -106,17 +105,19 @@ func filterPermissionsForPrincipal(in catalog.PermissionsList, principal string)
 	return &grantsForPrincipal[0], nil
 }
 
+var grantID = common.NewCompositeID("/", "securable_type", "name", "principal")
+
 func toSecurableId(d *schema.ResourceData) string {
-	principal := d.Get("principal").(string)
-	return fmt.Sprintf("%s/%s", permissions.Mappings.Id(d), principal)
+	securable, name := permissions.Mappings.KeyValue(d)
+	return grantID.Pack(securable, name, d.Get("principal"))
 }
 
 func parseSecurableId(d *schema.ResourceData) (string, string, string, error) {
-	split := strings.SplitN(d.Id(), "/", 3)
-	if len(split) != 3 {
-		return "", "", "", fmt.Errorf("ID must be three elements split by `/`: %s", d.Id())
+	parts, err := grantID.Unpack(d.Id())
+	if err != nil {
+		return "", "", "", err
 	}
-	return split[0], split[1], split[2], nil
+	return parts[0], parts[1], parts[2], nil
 }
 
 func ResourceGrant() common.Resource {
@@ -142,7 +143,8 @@ func ResourceGrant() common.Resource {
 		})
 
 	return common.Resource{
-		Schema: s,
+		Schema:      s,
+		CompositeID: grantID,
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
 			if err != nil {
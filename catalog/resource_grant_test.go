@@ -451,7 +451,7 @@ func TestResourceGrantReadMalformedId(t *testing.T) {
 		principal = "me"
 		privileges = ["MODIFY", "SELECT"]
 		`,
-	}.ExpectError(t, "ID must be three elements split by `/`: foo.bar")
+	}.ExpectError(t, "invalid ID: foo.bar. Expected format: <securable_type>/<name>/<principal>")
 }
 
 func TestResourceGrantCreateNoSecurable(t *testing.T) {
@@ -73,9 +73,13 @@ func diffPermissions(pl catalog.PermissionsList, existing catalog.PermissionsLis
 	return diff
 }
 
+// grantsMutex serializes updates of the same securable from databricks_grants and databricks_grant
+var grantsMutex common.NamedMutex
+
 // replaceAllPermissions merges removal diff of existing permissions on the platform
 func replaceAllPermissions(a permissions.UnityCatalogPermissionsAPI, securable string, name string, list catalog.PermissionsList) error {
 	securableType := permissions.Mappings.GetSecurableType(securable)
+	defer grantsMutex.Lock(securableType.String() + "/" + name)()
 	existing, err := a.GetPermissions(securableType, name)
 	if err != nil {
 		return err
@@ -282,12 +286,14 @@ func sdkPermissionsListToPermissionsList(sdkPermissionsList catalog.PermissionsL
 	return
 }
 
+var grantsID = common.NewCompositeID("/", "securable_type", "name")
+
 func parseId(d *schema.ResourceData) (string, string, error) {
-	split := strings.SplitN(d.Id(), "/", 2)
-	if len(split) != 2 {
-		return "", "", fmt.Errorf("ID must be two elements split by `/`: %s", d.Id())
+	parts, err := grantsID.Unpack(d.Id())
+	if err != nil {
+		return "", "", err
 	}
-	return split[0], split[1], nil
+	return parts[0], parts[1], nil
 }
 
 func ResourceGrants() common.Resource {
@@ -308,7 +314,8 @@ func ResourceGrants() common.Resource {
 			return s
 		})
 	return common.Resource{
-		Schema: s,
+		Schema:      s,
+		CompositeID: grantsID,
 		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff) error {
 			if d.Id() == "" {
 				// unfortunately we cannot do validation before dependent resources exist with tfsdkv2
@@ -355,7 +355,7 @@ func TestGrantReadMalformedId(t *testing.T) {
 			privileges = ["MODIFY", "SELECT"]
 		}
 		`,
-	}.ExpectError(t, "ID must be two elements split by `/`: foo.bar")
+	}.ExpectError(t, "invalid ID: foo.bar. Expected format: <securable_type>/<name>")
 }
 
 type data map[string]string
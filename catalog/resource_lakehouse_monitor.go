@@ -0,0 +1,216 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const lakehouseMonitorDefaultProvisionTimeout = 15 * time.Minute
+
+// MonitorSnapshot is a marker of snapshot profile, it has no configuration
+type MonitorSnapshot struct{}
+
+// LakehouseMonitor is a Terraform representation of catalog.MonitorInfo, as the `snapshot` field
+// is declared as `any` in the Go SDK
+type LakehouseMonitor struct {
+	TableName                string                                   `json:"table_name" tf:"force_new"`
+	AssetsDir                string                                   `json:"assets_dir"`
+	OutputSchemaName         string                                   `json:"output_schema_name"`
+	BaselineTableName        string                                   `json:"baseline_table_name,omitempty"`
+	CustomMetrics            []catalog.MonitorCustomMetric            `json:"custom_metrics,omitempty"`
+	DataClassificationConfig *catalog.MonitorDataClassificationConfig `json:"data_classification_config,omitempty"`
+	InferenceLog             *catalog.MonitorInferenceLogProfileType  `json:"inference_log,omitempty"`
+	Notifications            []catalog.MonitorNotificationsConfig     `json:"notifications,omitempty"`
+	Schedule                 *catalog.MonitorCronSchedule             `json:"schedule,omitempty"`
+	SlicingExprs             []string                                 `json:"slicing_exprs,omitempty"`
+	Snapshot                 *MonitorSnapshot                         `json:"snapshot,omitempty"`
+	TimeSeries               *catalog.MonitorTimeSeriesProfileType    `json:"time_series,omitempty"`
+	SkipBuiltinDashboard     bool                                     `json:"skip_builtin_dashboard,omitempty"`
+	WarehouseID              string                                   `json:"warehouse_id,omitempty"`
+	DashboardID              string                                   `json:"dashboard_id,omitempty" tf:"computed"`
+	DriftMetricsTableName    string                                   `json:"drift_metrics_table_name,omitempty" tf:"computed"`
+	ProfileMetricsTableName  string                                   `json:"profile_metrics_table_name,omitempty" tf:"computed"`
+	MonitorVersion           string                                   `json:"monitor_version,omitempty" tf:"computed"`
+	Status                   string                                   `json:"status,omitempty" tf:"computed"`
+}
+
+func (m LakehouseMonitor) snapshot() any {
+	if m.Snapshot == nil {
+		return nil
+	}
+	return map[string]any{}
+}
+
+func (m LakehouseMonitor) toCreateMonitor() catalog.CreateMonitor {
+	return catalog.CreateMonitor{
+		FullName:                 m.TableName,
+		AssetsDir:                m.AssetsDir,
+		OutputSchemaName:         m.OutputSchemaName,
+		BaselineTableName:        m.BaselineTableName,
+		CustomMetrics:            m.CustomMetrics,
+		DataClassificationConfig: m.DataClassificationConfig,
+		InferenceLog:             m.InferenceLog,
+		Notifications:            m.Notifications,
+		Schedule:                 m.Schedule,
+		SlicingExprs:             m.SlicingExprs,
+		Snapshot:                 m.snapshot(),
+		TimeSeries:               m.TimeSeries,
+		SkipBuiltinDashboard:     m.SkipBuiltinDashboard,
+		WarehouseId:              m.WarehouseID,
+	}
+}
+
+func (m LakehouseMonitor) toUpdateMonitor() catalog.UpdateMonitor {
+	return catalog.UpdateMonitor{
+		FullName:                 m.TableName,
+		AssetsDir:                m.AssetsDir,
+		OutputSchemaName:         m.OutputSchemaName,
+		BaselineTableName:        m.BaselineTableName,
+		CustomMetrics:            m.CustomMetrics,
+		DataClassificationConfig: m.DataClassificationConfig,
+		InferenceLog:             m.InferenceLog,
+		Notifications:            m.Notifications,
+		Schedule:                 m.Schedule,
+		SlicingExprs:             m.SlicingExprs,
+		Snapshot:                 m.snapshot(),
+		TimeSeries:               m.TimeSeries,
+	}
+}
+
+func lakehouseMonitorFromInfo(info *catalog.MonitorInfo) LakehouseMonitor {
+	m := LakehouseMonitor{
+		TableName:                info.TableName,
+		AssetsDir:                info.AssetsDir,
+		OutputSchemaName:         info.OutputSchemaName,
+		BaselineTableName:        info.BaselineTableName,
+		CustomMetrics:            info.CustomMetrics,
+		DataClassificationConfig: info.DataClassificationConfig,
+		InferenceLog:             info.InferenceLog,
+		Notifications:            info.Notifications,
+		Schedule:                 info.Schedule,
+		SlicingExprs:             info.SlicingExprs,
+		TimeSeries:               info.TimeSeries,
+		DashboardID:              info.DashboardId,
+		DriftMetricsTableName:    info.DriftMetricsTableName,
+		ProfileMetricsTableName:  info.ProfileMetricsTableName,
+		MonitorVersion:           info.MonitorVersion,
+		Status:                   info.Status.String(),
+	}
+	if info.Snapshot != nil {
+		m.Snapshot = &MonitorSnapshot{}
+	}
+	return m
+}
+
+// WaitForMonitor waits until the monitor of the given table becomes active
+func WaitForMonitor(ctx context.Context, w *databricks.WorkspaceClient, tableName string, timeout time.Duration) error {
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		monitor, err := w.LakehouseMonitors.GetByFullName(ctx, tableName)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		switch monitor.Status {
+		case catalog.MonitorInfoStatusMonitorStatusActive:
+			return nil
+		case catalog.MonitorInfoStatusMonitorStatusError, catalog.MonitorInfoStatusMonitorStatusFailed:
+			return retry.NonRetryableError(fmt.Errorf("monitor of %s has status %s: %s",
+				tableName, monitor.Status, monitor.LatestMonitorFailureMsg))
+		}
+		log.Printf("[DEBUG] Monitor of %s has status %s", tableName, monitor.Status)
+		return retry.RetryableError(fmt.Errorf("monitor of %s is still %s", tableName, monitor.Status))
+	})
+}
+
+// ResourceLakehouseMonitor manages Lakehouse Monitoring quality monitors of UC tables
+func ResourceLakehouseMonitor() common.Resource {
+	s := common.StructToSchema(LakehouseMonitor{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			common.CustomizeSchemaPath(m, "table_name").SetCustomSuppressDiff(common.EqualFoldDiffSuppress)
+			common.CustomizeSchemaPath(m, "skip_builtin_dashboard").SetCustomSuppressDiff(
+				func(k, old, new string, d *schema.ResourceData) bool {
+					// only used during creation of the monitor
+					return d.Id() != ""
+				})
+			common.CustomizeSchemaPath(m, "warehouse_id").SetCustomSuppressDiff(
+				func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Id() != ""
+				})
+			common.CustomizeSchemaPath(m, "schedule", "quartz_cron_expression").SetValidateFunc(
+				common.ValidateQuartzCronExpression)
+			common.CustomizeSchemaPath(m, "schedule", "timezone_id").SetValidateFunc(common.ValidateTimezoneID)
+			common.CustomizeSchemaPath(m, "snapshot").SetConflictsWith([]string{"time_series", "inference_log"})
+			common.CustomizeSchemaPath(m, "time_series").SetConflictsWith([]string{"snapshot", "inference_log"})
+			common.CustomizeSchemaPath(m, "inference_log").SetConflictsWith([]string{"snapshot", "time_series"})
+			return m
+		})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			var m LakehouseMonitor
+			common.DataToStructPointer(d, s, &m)
+			_, err = w.LakehouseMonitors.Create(ctx, m.toCreateMonitor())
+			if err != nil {
+				return err
+			}
+			d.SetId(m.TableName)
+			return WaitForMonitor(ctx, w, m.TableName, d.Timeout(schema.TimeoutCreate))
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			info, err := w.LakehouseMonitors.GetByFullName(ctx, d.Id())
+			if err != nil {
+				return err
+			}
+			err = common.StructToData(lakehouseMonitorFromInfo(info), s, d)
+			if err != nil {
+				return err
+			}
+			if info.Snapshot != nil {
+				// empty blocks are skipped by StructToData
+				return d.Set("snapshot", []any{map[string]any{}})
+			}
+			return nil
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			var m LakehouseMonitor
+			common.DataToStructPointer(d, s, &m)
+			update := m.toUpdateMonitor()
+			update.FullName = d.Id()
+			_, err = w.LakehouseMonitors.Update(ctx, update)
+			if err != nil {
+				return err
+			}
+			return WaitForMonitor(ctx, w, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			return w.LakehouseMonitors.DeleteByFullName(ctx, d.Id())
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(lakehouseMonitorDefaultProvisionTimeout),
+			Update: schema.DefaultTimeout(lakehouseMonitorDefaultProvisionTimeout),
+		},
+	}
+}
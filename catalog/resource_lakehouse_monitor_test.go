@@ -0,0 +1,261 @@
+package catalog
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestLakehouseMonitorCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceLakehouseMonitor())
+}
+
+var activeSnapshotMonitor = catalog.MonitorInfo{
+	TableName:        "main.sales.orders",
+	AssetsDir:        "/Shared/monitors/orders",
+	OutputSchemaName: "main.monitoring",
+	Snapshot:         map[string]any{},
+	Schedule: &catalog.MonitorCronSchedule{
+		QuartzCronExpression: "0 0 12 * * ?",
+		TimezoneId:           "UTC",
+	},
+	CustomMetrics: []catalog.MonitorCustomMetric{
+		{
+			Name:           "total_amount",
+			Definition:     "sum(`amount`)",
+			InputColumns:   []string{":table"},
+			OutputDataType: "double",
+			Type:           catalog.MonitorCustomMetricTypeCustomMetricTypeAggregate,
+		},
+	},
+	DashboardId:             "dashboard",
+	ProfileMetricsTableName: "main.monitoring.orders_profile_metrics",
+	DriftMetricsTableName:   "main.monitoring.orders_drift_metrics",
+	MonitorVersion:          "1",
+	Status:                  catalog.MonitorInfoStatusMonitorStatusActive,
+}
+
+func TestLakehouseMonitorCreate(t *testing.T) {
+	pending := activeSnapshotMonitor
+	pending.Status = catalog.MonitorInfoStatusMonitorStatusPending
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.1/unity-catalog/tables/main.sales.orders/monitor",
+				ExpectedRequest: catalog.CreateMonitor{
+					AssetsDir:        "/Shared/monitors/orders",
+					OutputSchemaName: "main.monitoring",
+					Snapshot:         map[string]any{},
+					Schedule: &catalog.MonitorCronSchedule{
+						QuartzCronExpression: "0 0 12 * * ?",
+						TimezoneId:           "UTC",
+					},
+					CustomMetrics:        activeSnapshotMonitor.CustomMetrics,
+					SkipBuiltinDashboard: true,
+				},
+				Response: pending,
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.1/unity-catalog/tables/main.sales.orders/monitor?",
+				Response: pending,
+			},
+			{
+				Method:       http.MethodGet,
+				Resource:     "/api/2.1/unity-catalog/tables/main.sales.orders/monitor?",
+				Response:     activeSnapshotMonitor,
+				ReuseRequest: true,
+			},
+		},
+		Resource: ResourceLakehouseMonitor(),
+		HCL: `
+		table_name = "main.sales.orders"
+		assets_dir = "/Shared/monitors/orders"
+		output_schema_name = "main.monitoring"
+		skip_builtin_dashboard = true
+		snapshot {}
+		schedule {
+			quartz_cron_expression = "0 0 12 * * ?"
+			timezone_id = "UTC"
+		}
+		custom_metrics {
+			name = "total_amount"
+			definition = "sum(` + "`amount`" + `)"
+			input_columns = [":table"]
+			output_data_type = "double"
+			type = "CUSTOM_METRIC_TYPE_AGGREGATE"
+		}
+		`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                         "main.sales.orders",
+		"status":                     "MONITOR_STATUS_ACTIVE",
+		"dashboard_id":               "dashboard",
+		"profile_metrics_table_name": "main.monitoring.orders_profile_metrics",
+		"snapshot.#":                 1,
+	})
+}
+
+func TestLakehouseMonitorCreate_Failed(t *testing.T) {
+	failed := activeSnapshotMonitor
+	failed.Status = catalog.MonitorInfoStatusMonitorStatusFailed
+	failed.LatestMonitorFailureMsg = "no such table"
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.1/unity-catalog/tables/main.sales.orders/monitor",
+				Response: failed,
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.1/unity-catalog/tables/main.sales.orders/monitor?",
+				Response: failed,
+			},
+		},
+		Resource: ResourceLakehouseMonitor(),
+		HCL: `
+		table_name = "main.sales.orders"
+		assets_dir = "/Shared/monitors/orders"
+		output_schema_name = "main.monitoring"
+		snapshot {}
+		`,
+		Create: true,
+	}.ExpectError(t, "monitor of main.sales.orders has status MONITOR_STATUS_FAILED: no such table")
+}
+
+func TestLakehouseMonitorRead(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.1/unity-catalog/tables/main.sales.orders/monitor?",
+				Response: activeSnapshotMonitor,
+			},
+		},
+		Resource: ResourceLakehouseMonitor(),
+		Read:     true,
+		New:      true,
+		ID:       "main.sales.orders",
+	}.ApplyAndExpectData(t, map[string]any{
+		"table_name":                        "main.sales.orders",
+		"assets_dir":                        "/Shared/monitors/orders",
+		"output_schema_name":                "main.monitoring",
+		"schedule.0.quartz_cron_expression": "0 0 12 * * ?",
+		"custom_metrics.0.name":             "total_amount",
+		"custom_metrics.0.type":             "CUSTOM_METRIC_TYPE_AGGREGATE",
+		"drift_metrics_table_name":          "main.monitoring.orders_drift_metrics",
+		"monitor_version":                   "1",
+		"snapshot.#":                        1,
+		"time_series.#":                     0,
+	})
+}
+
+func TestLakehouseMonitorRead_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.1/unity-catalog/tables/main.sales.orders/monitor?",
+				Status:   http.StatusNotFound,
+				Response: apierr.NotFound("nope"),
+			},
+		},
+		Resource: ResourceLakehouseMonitor(),
+		Read:     true,
+		Removed:  true,
+		ID:       "main.sales.orders",
+	}.ApplyNoError(t)
+}
+
+func TestLakehouseMonitorUpdate(t *testing.T) {
+	updated := activeSnapshotMonitor
+	updated.Schedule = &catalog.MonitorCronSchedule{
+		QuartzCronExpression: "0 0 6 * * ?",
+		TimezoneId:           "UTC",
+	}
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.1/unity-catalog/tables/main.sales.orders/monitor",
+				ExpectedRequest: catalog.UpdateMonitor{
+					AssetsDir:        "/Shared/monitors/orders",
+					OutputSchemaName: "main.monitoring",
+					Snapshot:         map[string]any{},
+					Schedule:         updated.Schedule,
+				},
+				Response: updated,
+			},
+			{
+				Method:       http.MethodGet,
+				Resource:     "/api/2.1/unity-catalog/tables/main.sales.orders/monitor?",
+				Response:     updated,
+				ReuseRequest: true,
+			},
+		},
+		Resource: ResourceLakehouseMonitor(),
+		Update:   true,
+		ID:       "main.sales.orders",
+		InstanceState: map[string]string{
+			"id":                                "main.sales.orders",
+			"table_name":                        "main.sales.orders",
+			"assets_dir":                        "/Shared/monitors/orders",
+			"output_schema_name":                "main.monitoring",
+			"snapshot.#":                        "1",
+			"schedule.#":                        "1",
+			"schedule.0.quartz_cron_expression": "0 0 12 * * ?",
+			"schedule.0.timezone_id":            "UTC",
+			"skip_builtin_dashboard":            "true",
+		},
+		HCL: `
+		table_name = "main.sales.orders"
+		assets_dir = "/Shared/monitors/orders"
+		output_schema_name = "main.monitoring"
+		skip_builtin_dashboard = true
+		snapshot {}
+		schedule {
+			quartz_cron_expression = "0 0 6 * * ?"
+			timezone_id = "UTC"
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"schedule.0.quartz_cron_expression": "0 0 6 * * ?",
+	})
+}
+
+func TestLakehouseMonitorDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodDelete,
+				Resource: "/api/2.1/unity-catalog/tables/main.sales.orders/monitor?",
+			},
+		},
+		Resource: ResourceLakehouseMonitor(),
+		Delete:   true,
+		ID:       "main.sales.orders",
+	}.ApplyNoError(t)
+}
+
+func TestLakehouseMonitorCreate_InvalidSchedule(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceLakehouseMonitor(),
+		HCL: `
+		table_name = "main.sales.orders"
+		assets_dir = "/Shared/monitors/orders"
+		output_schema_name = "main.monitoring"
+		snapshot {}
+		schedule {
+			quartz_cron_expression = "0 0 12 * * MON"
+			timezone_id = "UTC"
+		}
+		`,
+		Create: true,
+	}.ExpectError(t, "invalid config supplied. [schedule.#.quartz_cron_expression] schedule.0.quartz_cron_expression: "+
+		"'?' should be specified in exactly one of day-of-month and day-of-week fields: 0 0 12 * * MON")
+}
@@ -2,6 +2,7 @@ package catalog
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,7 +22,7 @@ type ProviderInfo struct {
 	Name                string `json:"name" tf:"force_new"`
 	Comment             string `json:"comment,omitempty"`
 	AuthenticationType  string `json:"authentication_type"`
-	RecipientProfileStr string `json:"recipient_profile_str" tf:"sensitive"`
+	RecipientProfileStr string `json:"recipient_profile_str,omitempty" tf:"sensitive"`
 }
 
 type Providers struct {
@@ -52,7 +53,12 @@ func (a ProvidersAPI) updateProvider(ci *ProviderInfo) error {
 
 func ResourceProvider() common.Resource {
 	providerSchema := common.StructToSchema(ProviderInfo{}, func(m map[string]*schema.Schema) map[string]*schema.Schema {
-		m["authentication_type"].ValidateFunc = validation.StringInSlice([]string{"TOKEN"}, false)
+		// providers of Databricks-to-Databricks sharing are created automatically and could be only imported
+		m["authentication_type"].ValidateFunc = validation.StringInSlice([]string{"TOKEN", "DATABRICKS"}, false)
+		// the profile isn't returned by the API, so it's unknown right after the import
+		m["recipient_profile_str"].DiffSuppressFunc = func(k, old, new string, d *schema.ResourceData) bool {
+			return d.Id() != "" && old == ""
+		}
 		return m
 	})
 
@@ -66,6 +72,10 @@ func ResourceProvider() common.Resource {
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			var ri ProviderInfo
 			common.DataToStructPointer(d, providerSchema, &ri)
+			if ri.AuthenticationType == "DATABRICKS" {
+				return fmt.Errorf("providers of Databricks-to-Databricks sharing are created automatically " +
+					"and could be only imported")
+			}
 			if err := NewProvidersAPI(ctx, c).createProvider(&ri); err != nil {
 				return err
 			}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestProviderCornerCases(t *testing.T) {
@@ -61,6 +62,52 @@ func TestCreateProvider_InvalidAuthType(t *testing.T) {
 		`,
 	}.ExpectError(t, "invalid config supplied. "+
 		"[authentication_type] expected authentication_type "+
-		"to be one of [TOKEN], got temp")
+		"to be one of [TOKEN DATABRICKS], got temp")
 
 }
+
+func TestCreateProvider_DatabricksAuthType(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceProvider(),
+		Create:   true,
+		HCL: `
+		name = "a"
+		authentication_type = "DATABRICKS"
+		`,
+	}.ExpectError(t, "providers of Databricks-to-Databricks sharing are created automatically "+
+		"and could be only imported")
+}
+
+func TestImportProvider(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/unity-catalog/providers/partner",
+				Response: ProviderInfo{
+					Name:               "partner",
+					Comment:            "b",
+					AuthenticationType: "DATABRICKS",
+				},
+			},
+		},
+		Resource: ResourceProvider(),
+		Read:     true,
+		New:      true,
+		ID:       "partner",
+	}.ApplyAndExpectData(t, map[string]any{
+		"name":                "partner",
+		"comment":             "b",
+		"authentication_type": "DATABRICKS",
+	})
+}
+
+func TestProviderProfileDiffIsSuppressedAfterImport(t *testing.T) {
+	r := ResourceProvider()
+	d := r.ToResource().TestResourceData()
+	suppress := r.Schema["recipient_profile_str"].DiffSuppressFunc
+	assert.False(t, suppress("recipient_profile_str", "", "{}", d))
+	d.SetId("partner")
+	assert.True(t, suppress("recipient_profile_str", "", "{}", d))
+	assert.False(t, suppress("recipient_profile_str", "{\"a\": 1}", "{}", d))
+}
@@ -88,9 +88,15 @@ func (si *ShareInfo) suppressCDFEnabledDiff() {
 	}
 }
 
-func (a SharesAPI) get(name string) (si ShareInfo, err error) {
+// getWithSharedData returns the share with all shared objects exactly as they are returned by the API
+func (a SharesAPI) getWithSharedData(name string) (si ShareInfo, err error) {
 	err = a.client.Get(a.context, "/unity-catalog/shares/"+name+"?include_shared_data=true", nil, &si)
 	si.sortSharesByName()
+	return
+}
+
+func (a SharesAPI) get(name string) (si ShareInfo, err error) {
+	si, err = a.getWithSharedData(name)
 	si.suppressCDFEnabledDiff()
 	return
 }
@@ -2,6 +2,9 @@ package catalog
 
 import (
 	"context"
+	"fmt"
+	"log"
+	"strings"
 
 	"github.com/databricks/databricks-sdk-go"
 	"github.com/databricks/databricks-sdk-go/service/catalog"
@@ -35,9 +38,60 @@ func removeGcpSaField(originalSchema map[string]*schema.Schema) map[string]*sche
 
 var storageCredentialSchema = common.StructToSchema(StorageCredentialInfo{},
 	func(m map[string]*schema.Schema) map[string]*schema.Schema {
+		m["validate"] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+		}
 		return adjustDataAccessSchema(m)
 	})
 
+// validateStorageAccess calls the validation endpoint and returns an error with results of all tested
+// operations, if any of them has failed
+func validateStorageAccess(ctx context.Context, w *databricks.WorkspaceClient, what string,
+	request catalog.ValidateStorageCredential) error {
+	validation, err := w.StorageCredentials.Validate(ctx, request)
+	if err != nil {
+		return fmt.Errorf("can't validate %s: %w", what, err)
+	}
+	failed := false
+	results := []string{}
+	for _, result := range validation.Results {
+		line := fmt.Sprintf("%s: %s", result.Operation, result.Result)
+		if result.Message != "" {
+			line += " (" + result.Message + ")"
+		}
+		results = append(results, line)
+		if result.Result == catalog.ValidationResultResultFail {
+			failed = true
+		}
+	}
+	if failed {
+		return fmt.Errorf("validation of %s failed:\n%s", what, strings.Join(results, "\n"))
+	}
+	return nil
+}
+
+// validateStorageCredential validates the planned configuration of the storage credential before it's created
+// or updated, so that a failed validation doesn't leave the changed credential behind. Credentials with
+// a Databricks-managed GCP service account are validated by name, as the service account only exists after
+// the credential is created.
+func validateStorageCredential(ctx context.Context, w *databricks.WorkspaceClient, d *schema.ResourceData,
+	request catalog.ValidateStorageCredential) error {
+	if !d.Get("validate").(bool) {
+		return nil
+	}
+	if _, ok := d.GetOk("databricks_gcp_service_account"); ok {
+		if d.Id() == "" {
+			return nil
+		}
+		request = catalog.ValidateStorageCredential{
+			StorageCredentialName: d.Id(),
+			ReadOnly:              request.ReadOnly,
+		}
+	}
+	return validateStorageAccess(ctx, w, fmt.Sprintf("storage credential %s", d.Get("name").(string)), request)
+}
+
 func ResourceStorageCredential() common.Resource {
 	return common.Resource{
 		Schema: storageCredentialSchema,
@@ -66,6 +120,9 @@ func ResourceStorageCredential() common.Resource {
 					return err
 				}
 				d.SetId(storageCredential.CredentialInfo.Name)
+				if d.Get("validate").(bool) {
+					log.Printf("[WARN] validation of storage credentials is only supported with workspace-level provider")
+				}
 
 				// Don't update owner if it is not provided
 				if d.Get("owner") == "" {
@@ -86,11 +143,36 @@ func ResourceStorageCredential() common.Resource {
 				if err != nil {
 					return err
 				}
+				err = validateStorageCredential(ctx, w, d, catalog.ValidateStorageCredential{
+					AwsIamRole:            create.AwsIamRole,
+					AzureManagedIdentity:  create.AzureManagedIdentity,
+					AzureServicePrincipal: create.AzureServicePrincipal,
+					CloudflareApiToken:    create.CloudflareApiToken,
+					ReadOnly:              create.ReadOnly,
+				})
+				if err != nil {
+					return err
+				}
 				storageCredential, err := w.StorageCredentials.Create(ctx, create)
 				if err != nil {
 					return err
 				}
 				d.SetId(storageCredential.Name)
+				if create.DatabricksGcpServiceAccount != nil {
+					// the service account is created together with the credential, so it's validated now,
+					// and the credential is removed if the validation fails
+					err = validateStorageCredential(ctx, w, d, catalog.ValidateStorageCredential{
+						ReadOnly: create.ReadOnly,
+					})
+					if err != nil {
+						deleteErr := w.StorageCredentials.DeleteByName(ctx, d.Id())
+						if deleteErr != nil {
+							return fmt.Errorf("%w, and the storage credential can't be deleted: %w", err, deleteErr)
+						}
+						d.SetId("")
+						return err
+					}
+				}
 
 				// Don't update owner if it is not provided
 				if d.Get("owner") == "" {
@@ -99,10 +181,7 @@ func ResourceStorageCredential() common.Resource {
 
 				update.Name = d.Id()
 				_, err = w.StorageCredentials.Update(ctx, update)
-				if err != nil {
-					return err
-				}
-				return nil
+				return err
 			})
 		},
 		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
@@ -187,6 +266,16 @@ func ResourceStorageCredential() common.Resource {
 				if err != nil {
 					return err
 				}
+				err = validateStorageCredential(ctx, w, d, catalog.ValidateStorageCredential{
+					AwsIamRole:            update.AwsIamRole,
+					AzureManagedIdentity:  update.AzureManagedIdentity,
+					AzureServicePrincipal: update.AzureServicePrincipal,
+					CloudflareApiToken:    update.CloudflareApiToken,
+					ReadOnly:              update.ReadOnly,
+				})
+				if err != nil {
+					return err
+				}
 				if d.HasChange("owner") {
 					_, err := w.StorageCredentials.Update(ctx, catalog.UpdateStorageCredential{
 						Name:  update.Name,
@@ -58,6 +58,140 @@ func TestCreateStorageCredentials(t *testing.T) {
 	})
 }
 
+func TestCreateStorageCredentialsWithValidation(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/storage-credentials",
+				ExpectedRequest: catalog.CreateStorageCredential{
+					Name: "a",
+					AwsIamRole: &catalog.AwsIamRole{
+						RoleArn: "def",
+					},
+					ReadOnly: true,
+				},
+				Response: catalog.StorageCredentialInfo{
+					Name: "a",
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/validate-storage-credentials",
+				ExpectedRequest: catalog.ValidateStorageCredential{
+					AwsIamRole: &catalog.AwsIamRole{
+						RoleArn: "def",
+					},
+					ReadOnly: true,
+				},
+				Response: catalog.ValidateStorageCredentialResponse{
+					Results: []catalog.ValidationResult{
+						{
+							Operation: catalog.ValidationResultOperationRead,
+							Result:    catalog.ValidationResultResultPass,
+						},
+						{
+							Operation: catalog.ValidationResultOperationList,
+							Result:    catalog.ValidationResultResultPass,
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/storage-credentials/a?",
+				Response: catalog.StorageCredentialInfo{
+					Name: "a",
+					AwsIamRole: &catalog.AwsIamRole{
+						RoleArn: "def",
+					},
+					ReadOnly: true,
+				},
+			},
+		},
+		Resource: ResourceStorageCredential(),
+		Create:   true,
+		HCL: `
+		name = "a"
+		aws_iam_role {
+			role_arn = "def"
+		}
+		read_only = true
+		validate = true
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"name":     "a",
+		"validate": true,
+	})
+}
+
+func TestCreateStorageCredentialsValidationError(t *testing.T) {
+	// the storage credential isn't created when the validation fails
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/validate-storage-credentials",
+				Status:   400,
+				Response: apierr.APIError{
+					ErrorCode: "INVALID_PARAMETER_VALUE",
+					Message:   "Credential is invalid",
+				},
+			},
+		},
+		Resource: ResourceStorageCredential(),
+		Create:   true,
+		HCL: `
+		name = "a"
+		aws_iam_role {
+			role_arn = "def"
+		}
+		validate = true
+		`,
+	}.ExpectError(t, "can't validate storage credential a: Credential is invalid")
+}
+
+func TestCreateStorageCredentialsGcpValidationError(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/storage-credentials",
+				Response: catalog.StorageCredentialInfo{
+					Name: "a",
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/unity-catalog/validate-storage-credentials",
+				ExpectedRequest: catalog.ValidateStorageCredential{
+					StorageCredentialName: "a",
+				},
+				Response: catalog.ValidateStorageCredentialResponse{
+					Results: []catalog.ValidationResult{
+						{
+							Operation: catalog.ValidationResultOperationList,
+							Result:    catalog.ValidationResultResultFail,
+							Message:   "Permission denied",
+						},
+					},
+				},
+			},
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.1/unity-catalog/storage-credentials/a?",
+			},
+		},
+		Resource: ResourceStorageCredential(),
+		Create:   true,
+		HCL: `
+		name = "a"
+		databricks_gcp_service_account {}
+		validate = true
+		`,
+	}.ExpectError(t, "validation of storage credential a failed:\nLIST: FAIL (Permission denied)")
+}
+
 func TestCreateStorageCredentialWithOwner(t *testing.T) {
 	qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
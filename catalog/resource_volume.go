@@ -32,6 +32,10 @@ func ResourceVolume() common.Resource {
 	s := common.StructToSchema(VolumeInfo{},
 		func(m map[string]*schema.Schema) map[string]*schema.Schema {
 			m["storage_location"].DiffSuppressFunc = ucDirectoryPathSlashAndEmptySuppressDiff
+			m["volume_path"] = &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			}
 			return m
 		})
 	return common.Resource{
@@ -72,6 +76,7 @@ func ResourceVolume() common.Resource {
 			if err != nil {
 				return err
 			}
+			d.Set("volume_path", "/Volumes/"+v.CatalogName+"/"+v.SchemaName+"/"+v.Name)
 			return common.StructToData(v, s, d)
 		},
 		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
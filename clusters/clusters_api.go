@@ -13,7 +13,6 @@ import (
 	"github.com/databricks/databricks-sdk-go/service/compute"
 
 	"github.com/databricks/terraform-provider-databricks/common"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -209,7 +208,7 @@ type LocalFileInfo struct {
 
 // WorkspaceFileInfo represents a file in the Databricks workspace.
 type WorkspaceFileInfo struct {
-	Destination string `json:"destination,omitempty"`
+	Destination string `json:"destination"`
 }
 
 // StorageInfo contains the struct for either DBFS or S3 storage depending on which one is relevant.
@@ -723,21 +722,21 @@ func wrapMissingClusterError(err error, id string) error {
 }
 
 func (a ClustersAPI) waitForClusterStatus(clusterID string, desired ClusterState) (result ClusterInfo, err error) {
-	// this tangles client with terraform more, which is inevitable
-	// nolint should be a bigger context-aware refactor
-	return result, resource.RetryContext(a.context, a.defaultTimeout(), func() *resource.RetryError {
+	err = common.WaitConfig{
+		Name:    fmt.Sprintf("cluster %s to be %s", clusterID, desired),
+		Timeout: a.defaultTimeout(),
+	}.Poll(a.context, func() (bool, string, error) {
 		clusterInfo, err := a.Get(clusterID)
 		if apierr.IsMissing(err) {
 			log.Printf("[INFO] Cluster %s not found. Retrying", clusterID)
-			return resource.RetryableError(err)
+			return false, err.Error(), nil
 		}
 		if err != nil {
-			return resource.NonRetryableError(err)
+			return false, "", err
 		}
 		result = clusterInfo
-		log.Printf("[DEBUG] Cluster %s is %s: %s", clusterID, clusterInfo.State, clusterInfo.StateMessage)
 		if clusterInfo.State == desired {
-			return nil
+			return true, "", nil
 		}
 		if !clusterInfo.State.CanReach(desired) {
 			docLink := "https://docs.databricks.com/dev-tools/api/latest/clusters.html#clusterclusterstate"
@@ -747,14 +746,13 @@ func (a ClustersAPI) waitForClusterStatus(clusterID string, desired ClusterState
 					clusterInfo.TerminationReason.Code, clusterInfo.TerminationReason.Type,
 					clusterInfo.TerminationReason.Parameters)
 			}
-			return resource.NonRetryableError(fmt.Errorf(
+			return false, "", fmt.Errorf(
 				"%s is not able to transition from %s to %s: %s%s. Please see %s for more details",
-				clusterID, clusterInfo.State, desired, clusterInfo.StateMessage, details, docLink))
+				clusterID, clusterInfo.State, desired, clusterInfo.StateMessage, details, docLink)
 		}
-		return resource.RetryableError(
-			fmt.Errorf("%s is %s, but has to be %s",
-				clusterID, clusterInfo.State, desired))
+		return false, fmt.Sprintf("%s is %s: %s", clusterID, clusterInfo.State, clusterInfo.StateMessage), nil
 	})
+	return
 }
 
 // Terminate terminates a Spark cluster given its ID
@@ -0,0 +1,120 @@
+package clusters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/service/files"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// initScriptDestination returns destination of the given storage type from `init_scripts` block
+func initScriptDestination(script map[string]any, storage string) string {
+	blocks, ok := script[storage].([]any)
+	if !ok || len(blocks) == 0 || blocks[0] == nil {
+		return ""
+	}
+	destination, _ := blocks[0].(map[string]any)["destination"].(string)
+	return destination
+}
+
+// validateInitScriptPaths performs hermetic checks of init script locations during plan.
+// Unknown destinations are empty and skipped.
+func validateInitScriptPaths(d *schema.ResourceDiff) error {
+	scripts, ok := d.Get("init_scripts").([]any)
+	if !ok {
+		return nil
+	}
+	for _, raw := range scripts {
+		script, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		workspace := initScriptDestination(script, "workspace")
+		if workspace != "" && !strings.HasPrefix(workspace, "/") {
+			return fmt.Errorf("workspace init script destination must be an absolute path: %s", workspace)
+		}
+		volumes := initScriptDestination(script, "volumes")
+		if volumes != "" && !strings.HasPrefix(volumes, "/Volumes/") {
+			return fmt.Errorf("volumes init script destination must start with /Volumes/: %s", volumes)
+		}
+	}
+	return nil
+}
+
+// warnDbfsInitScript emits a warning for every init script on DBFS, as they are deprecated
+func warnDbfsInitScript(v any, path cty.Path) diag.Diagnostics {
+	return diag.Diagnostics{{
+		Severity:      diag.Warning,
+		Summary:       fmt.Sprintf("Init script %s is stored on DBFS", v),
+		Detail:        DbfsDeprecationWarning,
+		AttributePath: path,
+	}}
+}
+
+// checkInitScriptsExist verifies during plan that new or changed init scripts from workspace and
+// volumes exist, so that misconfiguration doesn't surface only as a failed cluster start.
+// Permission and authentication errors are not fatal, as the cluster may run as another identity.
+func checkInitScriptsExist(ctx context.Context, d *schema.ResourceDiff, c *common.DatabricksClient) error {
+	if !d.HasChange("init_scripts") {
+		return nil
+	}
+	scripts, ok := d.Get("init_scripts").([]any)
+	if !ok {
+		return nil
+	}
+	var workspaceFiles, volumeFiles []string
+	for _, raw := range scripts {
+		script, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		if workspace := initScriptDestination(script, "workspace"); workspace != "" {
+			workspaceFiles = append(workspaceFiles, workspace)
+		}
+		if volumes := initScriptDestination(script, "volumes"); volumes != "" {
+			volumeFiles = append(volumeFiles, volumes)
+		}
+	}
+	if len(workspaceFiles) == 0 && len(volumeFiles) == 0 {
+		return nil
+	}
+	w, err := c.WorkspaceClient()
+	if err != nil {
+		log.Printf("[WARN] Cannot check existence of init scripts: %s", err)
+		return nil
+	}
+	check := func(path, location string, err error) error {
+		if errors.Is(err, apierr.ErrNotFound) {
+			return fmt.Errorf("init script %s does not exist in %s", path, location)
+		}
+		if err != nil {
+			log.Printf("[WARN] Cannot check init script %s: %s", path, err)
+		}
+		return nil
+	}
+	for _, path := range workspaceFiles {
+		workspacePath := path
+		if strings.HasPrefix(workspacePath, "/Workspace/") {
+			workspacePath = strings.TrimPrefix(workspacePath, "/Workspace")
+		}
+		_, err := w.Workspace.GetStatusByPath(ctx, workspacePath)
+		if err = check(path, "workspace", err); err != nil {
+			return err
+		}
+	}
+	for _, path := range volumeFiles {
+		_, err := w.Files.GetStatus(ctx, files.GetStatusRequest{Path: path})
+		if err = check(path, "volumes", err); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package clusters
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceClusterCreateInitScriptNotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/workspace/get-status?path=%2FShared%2Finit.sh",
+				Response: apierr.APIErrorBody{
+					ErrorCode: "RESOURCE_DOES_NOT_EXIST",
+					Message:   "Path (/Shared/init.sh) doesn't exist.",
+				},
+				Status: 404,
+			},
+		},
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `
+		cluster_name = "Shared Autoscaling"
+		spark_version = "7.1-scala12"
+		node_type_id = "i3.xlarge"
+		num_workers = 1
+		init_scripts {
+			workspace {
+				destination = "/Workspace/Shared/init.sh"
+			}
+		}`,
+	}.ExpectError(t, "init script /Workspace/Shared/init.sh does not exist in workspace")
+}
+
+func TestResourceClusterCreateVolumesInitScriptNotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/fs/get-status?path=%2FVolumes%2Fmain%2Fdefault%2Fscripts%2Finit.sh",
+				Response: apierr.APIErrorBody{
+					ErrorCode: "NOT_FOUND",
+					Message:   "Not found",
+				},
+				Status: 404,
+			},
+		},
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `
+		cluster_name = "Shared Autoscaling"
+		spark_version = "7.1-scala12"
+		node_type_id = "i3.xlarge"
+		num_workers = 1
+		init_scripts {
+			volumes {
+				destination = "/Volumes/main/default/scripts/init.sh"
+			}
+		}`,
+	}.ExpectError(t, "init script /Volumes/main/default/scripts/init.sh does not exist in volumes")
+}
+
+func TestResourceClusterInitScriptRelativePath(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `
+		cluster_name = "Shared Autoscaling"
+		spark_version = "7.1-scala12"
+		node_type_id = "i3.xlarge"
+		num_workers = 1
+		init_scripts {
+			workspace {
+				destination = "Shared/init.sh"
+			}
+		}`,
+	}.ExpectError(t, "workspace init script destination must be an absolute path: Shared/init.sh")
+}
+
+func TestResourceClusterInitScriptVolumesPath(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `
+		cluster_name = "Shared Autoscaling"
+		spark_version = "7.1-scala12"
+		node_type_id = "i3.xlarge"
+		num_workers = 1
+		init_scripts {
+			volumes {
+				destination = "/Shared/init.sh"
+			}
+		}`,
+	}.ExpectError(t, "volumes init script destination must start with /Volumes/: /Shared/init.sh")
+}
+
+func TestResourceClusterInitScriptWorkspaceNoDestination(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `
+		cluster_name = "Shared Autoscaling"
+		spark_version = "7.1-scala12"
+		node_type_id = "i3.xlarge"
+		num_workers = 1
+		init_scripts {
+			workspace {
+			}
+		}`,
+	}.ExpectError(t, "invalid config supplied. [init_scripts.#.workspace.#.destination] Missing required argument")
+}
+
+func TestResourceClusterUpdateInitScriptNotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/workspace/get-status?path=%2FShared%2Finit.sh",
+				Response: apierr.APIErrorBody{
+					ErrorCode: "RESOURCE_DOES_NOT_EXIST",
+					Message:   "Path (/Shared/init.sh) doesn't exist.",
+				},
+				Status: 404,
+			},
+		},
+		Update:   true,
+		ID:       "abc",
+		Resource: ResourceCluster(),
+		InstanceState: map[string]string{
+			"cluster_name":  "Shared Autoscaling",
+			"spark_version": "7.1-scala12",
+			"node_type_id":  "i3.xlarge",
+			"num_workers":   "1",
+		},
+		HCL: `
+		cluster_name = "Shared Autoscaling"
+		spark_version = "7.1-scala12"
+		node_type_id = "i3.xlarge"
+		num_workers = 1
+		init_scripts {
+			workspace {
+				destination = "/Shared/init.sh"
+			}
+		}`,
+	}.ExpectError(t, "init script /Shared/init.sh does not exist in workspace")
+}
+
+func TestWarnDbfsInitScript(t *testing.T) {
+	diags := warnDbfsInitScript("dbfs:/init.sh", cty.Path{})
+	assert.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, "Init script dbfs:/init.sh is stored on DBFS", diags[0].Summary)
+}
@@ -30,6 +30,9 @@ func ResourceCluster() common.Resource {
 			d *schema.ResourceData, c *common.DatabricksClient) error {
 			return NewClustersAPI(ctx, c).PermanentDelete(d.Id())
 		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff) error {
+			return validateInitScriptPaths(d)
+		},
 		Schema:        clusterSchema,
 		SchemaVersion: 2,
 		Timeouts: &schema.ResourceTimeout{
@@ -37,6 +40,7 @@ func ResourceCluster() common.Resource {
 			Update: schema.DefaultTimeout(DefaultProvisionTimeout),
 			Delete: schema.DefaultTimeout(DefaultProvisionTimeout),
 		},
+		CustomizeDiffWithClient: checkInitScriptsExist,
 	}
 }
 
@@ -50,13 +54,8 @@ func SparkConfDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool
 	return false
 }
 
-func ZoneDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
-	if old != "" && (new == "auto" || new == "") {
-		log.Printf("[INFO] Suppressing diff on availability zone")
-		return true
-	}
-	return false
-}
+// ZoneDiffSuppress suppresses diffs of availability zones that are chosen by the backend for `auto`
+var ZoneDiffSuppress = common.ServerDefaultDiffSuppress("databricks_cluster", "aws_attributes.zone_id")
 
 type ClusterResourceProvider struct{}
 
@@ -78,7 +77,7 @@ func (ClusterResourceProvider) CustomizeSchema(s map[string]*schema.Schema) map[
 	common.CustomizeSchemaPath(s, "ssh_public_keys").SetMaxItems(10)
 	common.CustomizeSchemaPath(s, "init_scripts").SetMaxItems(10).AddNewField("abfss", common.StructToSchema(InitScriptStorageInfo{}, nil)["abfss"]).AddNewField("gcs", common.StructToSchema(InitScriptStorageInfo{}, nil)["gcs"])
 	common.CustomizeSchemaPath(s, "init_scripts", "dbfs").SetDeprecated(DbfsDeprecationWarning)
-	common.CustomizeSchemaPath(s, "init_scripts", "dbfs", "destination").SetRequired()
+	common.CustomizeSchemaPath(s, "init_scripts", "dbfs", "destination").SetRequired().SetValidateDiagFunc(warnDbfsInitScript)
 	common.CustomizeSchemaPath(s, "init_scripts", "s3", "destination").SetRequired()
 	common.CustomizeSchemaPath(s, "init_scripts", "volumes", "destination").SetRequired()
 	common.CustomizeSchemaPath(s, "init_scripts", "workspace", "destination").SetRequired()
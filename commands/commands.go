@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
 	"time"
 
+	"github.com/databricks/databricks-sdk-go/service/sql"
 	"github.com/databricks/terraform-provider-databricks/clusters"
 	"github.com/databricks/terraform-provider-databricks/common"
 
@@ -34,8 +36,16 @@ type CommandsAPI struct {
 }
 
 // Execute creates a spark context and executes a command and then closes context
-// Any leading whitespace is trimmed
+// Any leading whitespace is trimmed. SQL commands are executed on the SQL warehouse and commands without
+// the cluster are executed on the cluster from the CommandExecutorConfig of the client, if they are configured
 func (a CommandsAPI) Execute(clusterID, language, commandStr string) common.CommandResults {
+	config := a.client.CommandExecutorConfig()
+	if language == "sql" && config.WarehouseID != "" {
+		return a.executeOnWarehouse(config.WarehouseID, commandStr)
+	}
+	if clusterID == "" {
+		clusterID = config.ClusterID
+	}
 	// this is the place, where API version propagation through context looks strange
 	ctx := context.WithValue(a.context, common.Api, common.API_2_0)
 	cluster, err := clusters.NewClustersAPI(ctx, a.client).Get(clusterID)
@@ -106,6 +116,89 @@ func (a CommandsAPI) Execute(clusterID, language, commandStr string) common.Comm
 	return *command.Results
 }
 
+// executeOnWarehouse executes SQL statement on the SQL warehouse and returns the results in the same
+// format as the command on the cluster, so they could be scanned in the same way
+func (a CommandsAPI) executeOnWarehouse(warehouseID, commandStr string) common.CommandResults {
+	w, err := a.client.WorkspaceClient()
+	if err != nil {
+		return common.CommandResults{
+			ResultType: "error",
+			Summary:    err.Error(),
+		}
+	}
+	commandStr = TrimLeadingWhitespace(commandStr)
+	log.Printf("[INFO] Executing SQL statement on warehouse %s:\n%s", warehouseID, commandStr)
+	res, err := w.StatementExecution.ExecuteStatement(a.context, sql.ExecuteStatementRequest{
+		Statement:     commandStr,
+		WarehouseId:   warehouseID,
+		WaitTimeout:   "50s", // max allowed by the Statement Execution API
+		OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutCancel,
+	})
+	if err != nil {
+		return common.CommandResults{
+			ResultType: "error",
+			Summary:    err.Error(),
+		}
+	}
+	if res.Status == nil || res.Status.State != sql.StatementStateSucceeded {
+		summary := "statement failed to execute"
+		if res.Status != nil {
+			summary = fmt.Sprintf("%s: %s", summary, res.Status.State)
+			if res.Status.Error != nil {
+				summary = res.Status.Error.Message
+			}
+		}
+		return common.CommandResults{
+			ResultType: "error",
+			Summary:    summary,
+		}
+	}
+	var columns []sql.ColumnInfo
+	if res.Manifest != nil && res.Manifest.Schema != nil {
+		columns = res.Manifest.Schema.Columns
+	}
+	schema := []any{}
+	for _, column := range columns {
+		schema = append(schema, map[string]any{
+			"name": column.Name,
+			"type": column.TypeText,
+		})
+	}
+	rows := []any{}
+	if res.Result != nil {
+		for _, row := range res.Result.DataArray {
+			cols := []any{}
+			for i, v := range row {
+				cols = append(cols, warehouseValue(columns, i, v))
+			}
+			rows = append(rows, cols)
+		}
+	}
+	return common.CommandResults{
+		ResultType: "table",
+		Data:       rows,
+		Schema:     schema,
+	}
+}
+
+// warehouseValue converts the value returned as string by the Statement Execution API into the type
+// of the column, like it's returned by the commands on the cluster
+func warehouseValue(columns []sql.ColumnInfo, i int, v string) any {
+	if i >= len(columns) {
+		return v
+	}
+	switch columns[i].TypeName {
+	case sql.ColumnInfoTypeNameBoolean:
+		return v == "true"
+	case sql.ColumnInfoTypeNameByte, sql.ColumnInfoTypeNameShort, sql.ColumnInfoTypeNameInt,
+		sql.ColumnInfoTypeNameLong:
+		if n, err := strconv.Atoi(v); err == nil {
+			return n
+		}
+	}
+	return v
+}
+
 type genericCommandRequest struct {
 	CommandID string `json:"commandId,omitempty" url:"commandId,omitempty"`
 	Language  string `json:"language,omitempty" url:"language,omitempty"`
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/service/sql"
 	"github.com/databricks/terraform-provider-databricks/clusters"
 	"github.com/databricks/terraform-provider-databricks/common"
 
@@ -513,3 +514,88 @@ func TestCommandsAPIExecute_NoCommandResults(t *testing.T) {
 		assert.EqualError(t, cr.Err(), "Command has no results")
 	})
 }
+
+func TestCommandsAPIExecute_DesignatedCluster(t *testing.T) {
+	qa.HTTPFixturesApply(t, commonFixtureWithStatusResponse(Command{
+		Status: "Finished",
+		Results: &common.CommandResults{
+			ResultType: "text",
+			Data:       "done",
+		},
+	}), func(ctx context.Context, client *common.DatabricksClient) {
+		client.WithCommandExecutorConfig(common.CommandExecutorConfig{ClusterID: "abc"})
+		commands := NewCommandsAPI(ctx, client)
+		result := commands.Execute("", "python", `print("done")`)
+		assert.NoError(t, result.Err())
+		assert.Equal(t, "done", result.Text())
+	})
+}
+
+func TestCommandsAPIExecute_Warehouse(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/sql/statements/",
+			ExpectedRequest: sql.ExecuteStatementRequest{
+				Statement:     "SHOW GRANTS ON TABLE `foo`\n",
+				WaitTimeout:   "50s",
+				WarehouseId:   "def",
+				OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutCancel,
+			},
+			Response: sql.ExecuteStatementResponse{
+				StatementId: "statement1",
+				Status: &sql.StatementStatus{
+					State: sql.StatementStateSucceeded,
+				},
+				Manifest: &sql.ResultManifest{
+					Schema: &sql.ResultSchema{
+						Columns: []sql.ColumnInfo{
+							{Name: "principal", TypeName: sql.ColumnInfoTypeNameString},
+							{Name: "position", TypeName: sql.ColumnInfoTypeNameInt},
+							{Name: "inherited", TypeName: sql.ColumnInfoTypeNameBoolean},
+						},
+					},
+				},
+				Result: &sql.ResultData{
+					DataArray: [][]string{{"users", "1", "true"}},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		client.WithCommandExecutorConfig(common.CommandExecutorConfig{ClusterID: "abc", WarehouseID: "def"})
+		commands := NewCommandsAPI(ctx, client)
+		result := commands.Execute("abc", "sql", "SHOW GRANTS ON TABLE `foo`")
+		require.NoError(t, result.Err())
+		var principal string
+		var position int
+		var inherited bool
+		assert.True(t, result.Scan(&principal, &position, &inherited))
+		assert.Equal(t, "users", principal)
+		assert.Equal(t, 1, position)
+		assert.True(t, inherited)
+		assert.False(t, result.Scan(&principal, &position, &inherited))
+	})
+}
+
+func TestCommandsAPIExecute_WarehouseFailed(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/sql/statements/",
+			Response: sql.ExecuteStatementResponse{
+				StatementId: "statement1",
+				Status: &sql.StatementStatus{
+					State: sql.StatementStateFailed,
+					Error: &sql.ServiceError{
+						Message: "Table or view not found: foo",
+					},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		client.WithCommandExecutorConfig(common.CommandExecutorConfig{WarehouseID: "def"})
+		commands := NewCommandsAPI(ctx, client)
+		result := commands.Execute("", "sql", "SHOW GRANTS ON TABLE `foo`")
+		assert.EqualError(t, result.Err(), "Table or view not found: foo")
+	})
+}
@@ -45,6 +45,7 @@ type DatabricksClient struct {
 
 	// callback used to create API1.2 call wrapper, which simplifies unit testing
 	commandFactory        func(context.Context, *DatabricksClient) CommandExecutor
+	commandConfig         CommandExecutorConfig
 	cachedWorkspaceClient *databricks.WorkspaceClient
 	cachedAccountClient   *databricks.AccountClient
 	mu                    sync.Mutex
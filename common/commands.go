@@ -40,6 +40,26 @@ func (c *DatabricksClient) CommandExecutor(ctx context.Context) CommandExecutor
 	return c.commandFactory(ctx, c)
 }
 
+// CommandExecutorConfig configures the backend that executes commands, when it's not the cluster
+// passed to the CommandExecutor
+type CommandExecutorConfig struct {
+	// ID of an existing cluster that executes commands when the caller doesn't specify the cluster
+	ClusterID string
+	// ID of SQL warehouse that executes `sql` commands through the Statement Execution API,
+	// so no interactive cluster is needed for them
+	WarehouseID string
+}
+
+// WithCommandExecutorConfig sets the execution backend for commands
+func (c *DatabricksClient) WithCommandExecutorConfig(config CommandExecutorConfig) {
+	c.commandConfig = config
+}
+
+// CommandExecutorConfig returns the execution backend for commands
+func (c *DatabricksClient) CommandExecutorConfig() CommandExecutorConfig {
+	return c.commandConfig
+}
+
 // CommandMock mocks the execution of command
 type CommandMock func(commandStr string) CommandResults
 
@@ -0,0 +1,96 @@
+package common
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// CompositeID encodes and decodes resource IDs that consist of multiple parts
+// joined by a separator, like `scope|||principal` or `securable/name/principal`.
+// The last part takes the remainder of the ID, so it may contain the separator.
+type CompositeID struct {
+	separator string
+	fields    []string
+}
+
+// NewCompositeID creates ID codec for given fields joined by a separator
+func NewCompositeID(separator string, fields ...string) *CompositeID {
+	if len(fields) < 2 {
+		panic("composite ID must have at least two fields")
+	}
+	return &CompositeID{
+		separator: separator,
+		fields:    fields,
+	}
+}
+
+// Format returns the expected format of ID, i.e. `<scope>|||<principal>`
+func (c *CompositeID) Format() string {
+	parts := make([]string, len(c.fields))
+	for i, f := range c.fields {
+		parts[i] = "<" + f + ">"
+	}
+	return strings.Join(parts, c.separator)
+}
+
+// Pack joins values into ID
+func (c *CompositeID) Pack(values ...any) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = fmt.Sprintf("%v", v)
+	}
+	return strings.Join(parts, c.separator)
+}
+
+// PackData sets ID from the resource attributes with the same names as fields
+func (c *CompositeID) PackData(d *schema.ResourceData) {
+	values := make([]any, len(c.fields))
+	for i, f := range c.fields {
+		values[i] = d.Get(f)
+	}
+	d.SetId(c.Pack(values...))
+}
+
+// Unpack splits ID into parts, returning errors that document the expected format
+func (c *CompositeID) Unpack(id string) ([]string, error) {
+	parts := strings.SplitN(id, c.separator, len(c.fields))
+	if len(parts) != len(c.fields) {
+		return nil, fmt.Errorf("invalid ID: %s. Expected format: %s", id, c.Format())
+	}
+	for i, p := range parts {
+		if p == "" {
+			return nil, fmt.Errorf("%s cannot be empty in ID: %s. Expected format: %s",
+				c.fields[i], id, c.Format())
+		}
+	}
+	return parts, nil
+}
+
+// UnpackData splits ID into parts and sets resource attributes with the same names as fields,
+// if they are present in the schema
+func (c *CompositeID) UnpackData(d *schema.ResourceData, s map[string]*schema.Schema) ([]string, error) {
+	parts, err := c.Unpack(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	for i, f := range c.fields {
+		fs, ok := s[f]
+		if !ok {
+			continue
+		}
+		var v any = parts[i]
+		if fs.Type == schema.TypeInt {
+			v, err = strconv.ParseInt(parts[i], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%s must be a number in ID: %s", f, d.Id())
+			}
+		}
+		if err = d.Set(f, v); err != nil {
+			return nil, err
+		}
+	}
+	return parts, nil
+}
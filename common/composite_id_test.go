@@ -0,0 +1,83 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompositeIDPackUnpack(t *testing.T) {
+	c := NewCompositeID("/", "securable_type", "name", "principal")
+	assert.Equal(t, "<securable_type>/<name>/<principal>", c.Format())
+	assert.Equal(t, "table/a.b.c/me", c.Pack("table", "a.b.c", "me"))
+
+	parts, err := c.Unpack("table/a.b.c/some/principal")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"table", "a.b.c", "some/principal"}, parts)
+
+	_, err = c.Unpack("table/a.b.c")
+	assert.EqualError(t, err, "invalid ID: table/a.b.c. Expected format: <securable_type>/<name>/<principal>")
+
+	_, err = c.Unpack("table//me")
+	assert.EqualError(t, err, "name cannot be empty in ID: table//me. Expected format: <securable_type>/<name>/<principal>")
+}
+
+func TestCompositeIDNeedsTwoFields(t *testing.T) {
+	assert.Panics(t, func() {
+		NewCompositeID("|", "a")
+	})
+}
+
+func TestCompositeIDData(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"workspace_id": {Type: schema.TypeInt, Required: true},
+		"principal_id": {Type: schema.TypeString, Required: true},
+	}
+	c := NewCompositeID("|", "workspace_id", "principal_id")
+	d := schema.TestResourceDataRaw(t, s, map[string]any{
+		"workspace_id": 123,
+		"principal_id": "abc",
+	})
+	c.PackData(d)
+	assert.Equal(t, "123|abc", d.Id())
+
+	d = schema.TestResourceDataRaw(t, s, map[string]any{})
+	d.SetId("456|def")
+	parts, err := c.UnpackData(d, s)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"456", "def"}, parts)
+	assert.Equal(t, 456, d.Get("workspace_id"))
+	assert.Equal(t, "def", d.Get("principal_id"))
+
+	d.SetId("x|def")
+	_, err = c.UnpackData(d, s)
+	assert.EqualError(t, err, "workspace_id must be a number in ID: x|def")
+}
+
+func TestImportingValidatesCompositeID(t *testing.T) {
+	r := Resource{
+		Read: func(ctx context.Context, d *schema.ResourceData, c *DatabricksClient) error {
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"foo": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+		CompositeID: NewCompositeID("|||", "scope", "principal"),
+	}.ToResource()
+
+	d := r.TestResourceData()
+	d.SetId("abc")
+	_, err := r.Importer.StateContext(context.Background(), d, &DatabricksClient{})
+	assert.EqualError(t, err, "invalid ID: abc. Expected format: <scope>|||<principal>")
+
+	d.SetId("abc|||me")
+	datas, err := r.Importer.StateContext(context.Background(), d, &DatabricksClient{})
+	require.NoError(t, err)
+	assert.Len(t, datas, 1)
+}
@@ -0,0 +1,46 @@
+package common
+
+import (
+	"log"
+	"sync"
+)
+
+// NamedMutex serializes operations on the same named object, like a securable or a group,
+// so that concurrent read-modify-write updates from the same apply don't overwrite each other.
+// Operations on different names are not blocked. Zero value is ready to use.
+type NamedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*namedLock
+}
+
+type namedLock struct {
+	sync.Mutex
+	refs int
+}
+
+// Lock acquires the lock for a given name and returns function that releases it
+func (m *NamedMutex) Lock(name string) func() {
+	m.mu.Lock()
+	if m.locks == nil {
+		m.locks = map[string]*namedLock{}
+	}
+	l, ok := m.locks[name]
+	if !ok {
+		l = &namedLock{}
+		m.locks[name] = l
+	}
+	l.refs++
+	m.mu.Unlock()
+
+	log.Printf("[TRACE] Acquiring lock for %s", name)
+	l.Lock()
+	return func() {
+		l.Unlock()
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		l.refs--
+		if l.refs == 0 {
+			delete(m.locks, name)
+		}
+	}
+}
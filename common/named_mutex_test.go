@@ -0,0 +1,39 @@
+package common
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNamedMutexSerializesSameName(t *testing.T) {
+	var m NamedMutex
+	var wg sync.WaitGroup
+	counter := 0
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			unlock := m.Lock("catalog/main")
+			defer unlock()
+			// read-modify-write that would race without the lock
+			v := counter
+			counter = v + 1
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 50, counter)
+	assert.Len(t, m.locks, 0)
+}
+
+func TestNamedMutexDifferentNames(t *testing.T) {
+	var m NamedMutex
+	unlockA := m.Lock("a")
+	// must not block
+	unlockB := m.Lock("b")
+	assert.Len(t, m.locks, 2)
+	unlockB()
+	unlockA()
+	assert.Len(t, m.locks, 0)
+}
@@ -4,15 +4,14 @@ import (
 	"context"
 	"fmt"
 	"strconv"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-// Pair defines an ID pair
+// Pair defines an ID pair together with the schema of its fields
 type Pair struct {
 	left, right string
-	separator   string
+	id          *CompositeID
 	schema      map[string]*schema.Schema
 }
 
@@ -24,9 +23,9 @@ func NewPairID(left, right string) *Pair {
 // NewPairSeparatedID creates new ID pair with a custom separator
 func NewPairSeparatedID(left, right, separator string) *Pair {
 	return &Pair{
-		left:      left,
-		right:     right,
-		separator: separator,
+		left:  left,
+		right: right,
+		id:    NewCompositeID(separator, left, right),
 		schema: map[string]*schema.Schema{
 			left:  {Type: schema.TypeString, ForceNew: true, Required: true},
 			right: {Type: schema.TypeString, ForceNew: true, Required: true},
@@ -42,21 +41,12 @@ func (p *Pair) Schema(do func(map[string]*schema.Schema) map[string]*schema.Sche
 
 // Unpack ID into two strings and set data
 func (p *Pair) Unpack(d *schema.ResourceData) (string, string, error) {
-	id := d.Id()
-	parts := strings.SplitN(id, p.separator, 2)
-	if len(parts) != 2 {
-		d.SetId("")
-		return "", "", fmt.Errorf("invalid ID: %s", id)
-	}
-	if parts[0] == "" {
-		d.SetId("")
-		return "", "", fmt.Errorf("%s cannot be empty", p.left)
-	}
-	if parts[1] == "" {
+	parts, err := p.id.Unpack(d.Id())
+	if err != nil {
 		d.SetId("")
-		return "", "", fmt.Errorf("%s cannot be empty", p.right)
+		return "", "", err
 	}
-	err := p.setField(d, p.left, parts[0])
+	err = p.setField(d, p.left, parts[0])
 	if err != nil {
 		return parts[0], parts[1], err
 	}
@@ -77,7 +67,7 @@ func (p *Pair) setField(d *schema.ResourceData, col, val string) error {
 
 // Pack data attributes to ID
 func (p *Pair) Pack(d *schema.ResourceData) {
-	d.SetId(fmt.Sprintf("%v%s%v", d.Get(p.left), p.separator, d.Get(p.right)))
+	p.id.PackData(d)
 }
 
 // BindResource defines resource with simplified functions
@@ -90,7 +80,8 @@ type BindResource struct {
 // BindResource creates resource that relies on binding ID pair with simple schema & importer
 func (p *Pair) BindResource(pr BindResource) Resource {
 	return Resource{
-		Schema: p.schema,
+		Schema:      p.schema,
+		CompositeID: p.id,
 		Read: func(ctx context.Context, d *schema.ResourceData, c *DatabricksClient) error {
 			left, right, err := p.Unpack(d)
 			if err != nil {
@@ -29,22 +29,22 @@ func TestPairIDResource(t *testing.T) {
 		{
 			read:        true,
 			id:          "a",
-			assertError: "invalid ID: a",
+			assertError: "invalid ID: a. Expected format: <left_id>|<right_id>",
 		},
 		{
 			read:        true,
 			id:          "a|",
-			assertError: "right_id cannot be empty",
+			assertError: "right_id cannot be empty in ID: a|. Expected format: <left_id>|<right_id>",
 		},
 		{
 			read:        true,
 			id:          "|b",
-			assertError: "left_id cannot be empty",
+			assertError: "left_id cannot be empty in ID: |b. Expected format: <left_id>|<right_id>",
 		},
 		{
 			delete:      true,
 			id:          "a",
-			assertError: "invalid ID: a",
+			assertError: "invalid ID: a. Expected format: <left_id>|<right_id>",
 		},
 		{
 			read:     true,
@@ -27,6 +27,11 @@ type Resource struct {
 	Timeouts           *schema.ResourceTimeout
 	DeprecationMessage string
 	Importer           *schema.ResourceImporter
+	// CompositeID is used to validate IDs given to `terraform import`
+	CompositeID *CompositeID
+	// CustomizeDiffWithClient is for plan-time checks that must call APIs, like existence of referenced
+	// objects. Authentication may not be possible during plan, so API errors shouldn't fail the plan.
+	CustomizeDiffWithClient func(ctx context.Context, d *schema.ResourceDiff, c *DatabricksClient) error
 }
 
 func nicerError(ctx context.Context, err error, action string) error {
@@ -57,10 +62,10 @@ func recoverable(cb func(
 }
 
 func (r Resource) saferCustomizeDiff() schema.CustomizeDiffFunc {
-	if r.CustomizeDiff == nil {
+	if r.CustomizeDiff == nil && r.CustomizeDiffWithClient == nil {
 		return nil
 	}
-	return func(ctx context.Context, rd *schema.ResourceDiff, _ any) (err error) {
+	return func(ctx context.Context, rd *schema.ResourceDiff, m any) (err error) {
 		defer func() {
 			// this is deliberate decision to convert a panic into error,
 			// so that any unforeseen bug would we visible to end-user
@@ -74,7 +79,14 @@ func (r Resource) saferCustomizeDiff() schema.CustomizeDiffFunc {
 		// we don't propagate instance of SDK client to the diff function, because
 		// authentication is not deterministic at this stage with the recent Terraform
 		// versions. Diff customization must be limited to hermetic checks only anyway.
-		err = r.CustomizeDiff(ctx, rd)
+		if r.CustomizeDiff != nil {
+			err = r.CustomizeDiff(ctx, rd)
+		}
+		// the only exception are checks, that explicitly tolerate failed authentication
+		c, ok := m.(*DatabricksClient)
+		if err == nil && ok && r.CustomizeDiffWithClient != nil {
+			err = r.CustomizeDiffWithClient(ctx, rd, c)
+		}
 		if err != nil {
 			err = nicerError(ctx, err, "customize diff for")
 		}
@@ -190,6 +202,11 @@ func (r Resource) ToResource() *schema.Resource {
 		resource.Importer = &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData,
 				m any) (data []*schema.ResourceData, e error) {
+				if r.CompositeID != nil {
+					if _, err := r.CompositeID.Unpack(d.Id()); err != nil {
+						return nil, err
+					}
+				}
 				d.MarkNewResource()
 				diags := generateReadFunc(false)(ctx, d, m)
 				var err error
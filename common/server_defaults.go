@@ -0,0 +1,87 @@
+package common
+
+import (
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ServerDefault describes an attribute, which value is generated by the backend when it isn't configured,
+// or when it's configured with a placeholder value
+type ServerDefault struct {
+	// Placeholders are configured values that the backend replaces with generated ones, i.e. `auto`
+	Placeholders []string
+	// Pattern matches values generated by the backend. Any value is treated as generated when it's nil
+	Pattern *regexp.Regexp
+}
+
+// serverDefaults lists attributes rewritten by the backend per resource type, with nested attributes
+// specified as dot-separated paths without indices. Both the provider, to suppress diffs, and the
+// exporter, to omit attributes, use it, so generated code doesn't produce diffs.
+var serverDefaults = map[string]map[string]ServerDefault{
+	"databricks_cluster": {
+		"aws_attributes.zone_id": {Placeholders: []string{"auto"}},
+	},
+	"databricks_instance_pool": {
+		"aws_attributes.zone_id": {Placeholders: []string{"auto"}},
+	},
+	"databricks_pipeline": {
+		"storage": {Pattern: regexp.MustCompile(
+			`^dbfs:/pipelines/[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)},
+		"cluster.aws_attributes.zone_id": {Placeholders: []string{"auto"}},
+	},
+}
+
+// GetServerDefault returns the server default registered for the attribute of the resource type
+func GetServerDefault(resourceType, path string) (ServerDefault, bool) {
+	sd, ok := serverDefaults[resourceType][path]
+	return sd, ok
+}
+
+func (sd ServerDefault) isPlaceholder(value string) bool {
+	for _, placeholder := range sd.Placeholders {
+		if strings.EqualFold(value, placeholder) {
+			return true
+		}
+	}
+	return false
+}
+
+func (sd ServerDefault) isGenerated(value string) bool {
+	if sd.Pattern == nil {
+		return value != ""
+	}
+	return sd.Pattern.MatchString(value)
+}
+
+// SuppressDiff suppresses the diff between the generated value in the state and the configuration
+// that is either empty or a placeholder
+func (sd ServerDefault) SuppressDiff(k, old, new string, d *schema.ResourceData) bool {
+	if sd.isGenerated(old) && (new == "" || sd.isPlaceholder(new)) {
+		log.Printf("[DEBUG] Suppressing diff for server default of %s: platform=%#v config=%#v", k, old, new)
+		return true
+	}
+	return false
+}
+
+// IsServerDefault checks if the value of the attribute could be omitted from the configuration, because
+// it's either a placeholder or recognizably generated by the backend. Values of attributes without
+// the Pattern could be configured explicitly, so only placeholders are reported for them.
+func IsServerDefault(resourceType, path, value string) bool {
+	sd, ok := GetServerDefault(resourceType, path)
+	if !ok {
+		return false
+	}
+	return sd.isPlaceholder(value) || (sd.Pattern != nil && sd.Pattern.MatchString(value))
+}
+
+// ServerDefaultDiffSuppress returns the diff suppression function for the registered attribute
+func ServerDefaultDiffSuppress(resourceType, path string) schema.SchemaDiffSuppressFunc {
+	sd, ok := GetServerDefault(resourceType, path)
+	if !ok {
+		panic("no server default registered for " + resourceType + " " + path)
+	}
+	return sd.SuppressDiff
+}
@@ -0,0 +1,38 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServerDefaultSuppressDiff(t *testing.T) {
+	zone := ServerDefaultDiffSuppress("databricks_cluster", "aws_attributes.zone_id")
+	assert.True(t, zone("aws_attributes.0.zone_id", "us-west-2a", "auto", nil))
+	assert.True(t, zone("aws_attributes.0.zone_id", "us-west-2a", "AUTO", nil))
+	assert.True(t, zone("aws_attributes.0.zone_id", "us-west-2a", "", nil))
+	assert.False(t, zone("aws_attributes.0.zone_id", "us-west-2a", "us-west-2b", nil))
+	assert.False(t, zone("aws_attributes.0.zone_id", "", "auto", nil))
+
+	storage := ServerDefaultDiffSuppress("databricks_pipeline", "storage")
+	generated := "dbfs:/pipelines/c609bbb0-2e42-4bc8-bb6e-1e2f5ce5c8a0"
+	assert.True(t, storage("storage", generated, "", nil))
+	assert.False(t, storage("storage", generated, "/tmp/abc", nil))
+	assert.False(t, storage("storage", "/tmp/abc", "", nil))
+}
+
+func TestServerDefaultDiffSuppressUnknown(t *testing.T) {
+	assert.Panics(t, func() {
+		ServerDefaultDiffSuppress("databricks_cluster", "unknown")
+	})
+}
+
+func TestIsServerDefault(t *testing.T) {
+	assert.True(t, IsServerDefault("databricks_cluster", "aws_attributes.zone_id", "auto"))
+	// explicitly configured zones can't be distinguished from generated ones
+	assert.False(t, IsServerDefault("databricks_cluster", "aws_attributes.zone_id", "us-west-2a"))
+	assert.True(t, IsServerDefault("databricks_pipeline", "storage",
+		"dbfs:/pipelines/c609bbb0-2e42-4bc8-bb6e-1e2f5ce5c8a0"))
+	assert.False(t, IsServerDefault("databricks_pipeline", "storage", "dbfs:/custom"))
+	assert.False(t, IsServerDefault("databricks_job", "name", "auto"))
+}
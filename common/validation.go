@@ -0,0 +1,140 @@
+package common
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	// time zone database is embedded, so validation doesn't depend on the machine running Terraform
+	_ "time/tzdata"
+)
+
+// cronField describes allowed values of a single field of Quartz cron expression
+type cronField struct {
+	name    string
+	min     int
+	max     int
+	names   []string
+	special *regexp.Regexp
+}
+
+var (
+	monthNames = []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}
+	dayNames   = []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}
+
+	quartzCronFields = []cronField{
+		{name: "seconds", min: 0, max: 59},
+		{name: "minutes", min: 0, max: 59},
+		{name: "hours", min: 0, max: 23},
+		{name: "day-of-month", min: 1, max: 31, special: regexp.MustCompile(`^(\?|L|LW|L-\d{1,2}|\d{1,2}W)$`)},
+		{name: "month", min: 1, max: 12, names: monthNames},
+		{name: "day-of-week", min: 1, max: 7, names: dayNames,
+			special: regexp.MustCompile(`^(\?|L|([1-7]|SUN|MON|TUE|WED|THU|FRI|SAT)(L|#[1-5]))$`)},
+		{name: "year", min: 1970, max: 2099},
+	}
+
+	// short time zone IDs that are supported by Java, but aren't part of the IANA database
+	javaShortTimezoneIDs = map[string]bool{
+		"ACT": true, "AET": true, "AGT": true, "ART": true, "AST": true, "BET": true, "BST": true,
+		"CAT": true, "CNT": true, "CST": true, "CTT": true, "EAT": true, "ECT": true, "IET": true,
+		"IST": true, "JST": true, "MIT": true, "NET": true, "NST": true, "PLT": true, "PNT": true,
+		"PRT": true, "PST": true, "SST": true, "VST": true,
+	}
+	timezoneOffsetRegex = regexp.MustCompile(`^(GMT|UTC|UT)?[+-]\d{1,2}(:?\d{2})?$`)
+)
+
+func (f cronField) value(s string) (int, error) {
+	for i, name := range f.names {
+		if strings.EqualFold(s, name) {
+			return i + f.min, nil
+		}
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value '%s' of %s field", s, f.name)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("value %d of %s field is out of range %d-%d", v, f.name, f.min, f.max)
+	}
+	return v, nil
+}
+
+func (f cronField) validateRange(s string) error {
+	if s == "*" {
+		return nil
+	}
+	from, to, isRange := strings.Cut(s, "-")
+	if _, err := f.value(from); err != nil {
+		return err
+	}
+	if isRange {
+		if _, err := f.value(to); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f cronField) validate(s string) error {
+	for _, item := range strings.Split(s, ",") {
+		if f.special != nil && f.special.MatchString(strings.ToUpper(item)) {
+			continue
+		}
+		base, step, hasStep := strings.Cut(item, "/")
+		if hasStep {
+			v, err := strconv.Atoi(step)
+			if err != nil || v < 1 {
+				return fmt.Errorf("invalid increment '%s' of %s field", step, f.name)
+			}
+		}
+		if err := f.validateRange(base); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateQuartzCronExpression checks that the value is a valid Quartz cron expression, as used by
+// schedules of jobs and other objects, i.e. `0 0 12 * * ?`
+func ValidateQuartzCronExpression(i any, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	fields := strings.Fields(v)
+	if len(fields) != 6 && len(fields) != 7 {
+		return nil, []error{fmt.Errorf("%s: expected 6 or 7 fields in Quartz cron expression, got %d: %s",
+			k, len(fields), v)}
+	}
+	for i, field := range fields {
+		if err := quartzCronFields[i].validate(field); err != nil {
+			return nil, []error{fmt.Errorf("%s: %w: %s", k, err, v)}
+		}
+	}
+	if (fields[3] == "?") == (fields[5] == "?") {
+		return nil, []error{fmt.Errorf("%s: '?' should be specified in exactly one of day-of-month "+
+			"and day-of-week fields: %s", k, v)}
+	}
+	return nil, nil
+}
+
+// ValidateTimezoneID checks that the value is a time zone ID supported by Java, i.e. `Europe/Amsterdam`,
+// `UTC` or `PST`
+func ValidateTimezoneID(i any, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if javaShortTimezoneIDs[v] || timezoneOffsetRegex.MatchString(v) {
+		return nil, nil
+	}
+	// Local is specific to Go and an empty string is treated as UTC by it
+	if v != "" && v != "Local" {
+		if _, err := time.LoadLocation(v); err == nil {
+			return nil, nil
+		}
+	}
+	return nil, []error{fmt.Errorf("%s: unknown time zone ID '%s'", k, v)}
+}
@@ -0,0 +1,57 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateQuartzCronExpression(t *testing.T) {
+	for _, v := range []string{
+		"0 0 12 * * ?",
+		"0 15 10 ? * MON-FRI",
+		"0 0/5 14,18 * * ?",
+		"0 15 10 L * ?",
+		"0 15 10 15W * ?",
+		"0 15 10 ? * 6L 2030",
+		"0 15 10 ? * 6#3",
+		"0 0 0 1 JAN,jul ?",
+		"*/30 * * ? * *",
+	} {
+		_, errs := ValidateQuartzCronExpression(v, "quartz_cron_expression")
+		assert.Len(t, errs, 0, v)
+	}
+}
+
+func TestValidateQuartzCronExpression_Invalid(t *testing.T) {
+	for v, msg := range map[string]string{
+		"0 12 * * *":        "expected 6 or 7 fields in Quartz cron expression, got 5",
+		"0 0 25 * * ?":      "value 25 of hours field is out of range 0-23",
+		"0 0 12 * FOO ?":    "invalid value 'FOO' of month field",
+		"0 0/0 12 * * ?":    "invalid increment '0' of minutes field",
+		"0 0 12 * * MON":    "'?' should be specified in exactly one of day-of-month and day-of-week fields",
+		"0 0 12 ? * ?":      "'?' should be specified in exactly one of day-of-month and day-of-week fields",
+		"0 0 12 ? * 8":      "value 8 of day-of-week field is out of range 1-7",
+		"0 0 12 * * ? 1900": "value 1900 of year field is out of range 1970-2099",
+	} {
+		_, errs := ValidateQuartzCronExpression(v, "quartz_cron_expression")
+		if assert.Len(t, errs, 1, v) {
+			assert.Contains(t, errs[0].Error(), "quartz_cron_expression: "+msg)
+		}
+	}
+	_, errs := ValidateQuartzCronExpression(1, "quartz_cron_expression")
+	assert.EqualError(t, errs[0], "expected type of quartz_cron_expression to be string")
+}
+
+func TestValidateTimezoneID(t *testing.T) {
+	for _, v := range []string{"UTC", "Europe/Amsterdam", "America/Los_Angeles", "PST", "EST", "GMT+01:00", "UTC-5"} {
+		_, errs := ValidateTimezoneID(v, "timezone_id")
+		assert.Len(t, errs, 0, v)
+	}
+	for _, v := range []string{"", "Local", "Europe/Amsterdm", "PDT"} {
+		_, errs := ValidateTimezoneID(v, "timezone_id")
+		if assert.Len(t, errs, 1, v) {
+			assert.EqualError(t, errs[0], "timezone_id: unknown time zone ID '"+v+"'")
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+const (
+	waitPending = "pending"
+	waitDone    = "done"
+)
+
+// WaitConfig configures polling of long-running operations, like starting of clusters or creation of workspaces
+type WaitConfig struct {
+	// Name of the awaited object, used in progress logs and errors, i.e. `cluster abc`
+	Name string
+	// Timeout of the whole operation
+	Timeout time.Duration
+	// Interval between checks of the status. When it's not set, exponential backoff of up to 10 seconds is used
+	Interval time.Duration
+	// Progress is called with the current status after every check that isn't done yet. When it's not set,
+	// the status is logged
+	Progress func(ctx context.Context, status string, elapsed time.Duration)
+}
+
+// WaitCheckFunc checks the status of the long-running operation. It returns true when the operation is done,
+// otherwise it returns the current status. Returned error stops the polling.
+type WaitCheckFunc func() (done bool, status string, err error)
+
+func logWaitProgress(name string) func(ctx context.Context, status string, elapsed time.Duration) {
+	return func(ctx context.Context, status string, elapsed time.Duration) {
+		tflog.Info(ctx, fmt.Sprintf("Still waiting for %s: %s", name, status), map[string]any{
+			"elapsed": elapsed.Round(time.Second).String(),
+		})
+	}
+}
+
+// Poll calls the check function until the operation is done, the check fails or the timeout is reached
+func (w WaitConfig) Poll(ctx context.Context, check WaitCheckFunc) error {
+	progress := w.Progress
+	if progress == nil {
+		progress = logWaitProgress(w.Name)
+	}
+	start := time.Now()
+	lastStatus := ""
+	conf := &retry.StateChangeConf{
+		Pending:      []string{waitPending},
+		Target:       []string{waitDone},
+		Timeout:      w.Timeout,
+		MinTimeout:   500 * time.Millisecond,
+		PollInterval: w.Interval,
+		Refresh: func() (any, string, error) {
+			done, status, err := check()
+			if err != nil {
+				return nil, "", err
+			}
+			if done {
+				return status, waitDone, nil
+			}
+			lastStatus = status
+			progress(ctx, status, time.Since(start))
+			return status, waitPending, nil
+		},
+	}
+	_, err := conf.WaitForStateContext(ctx)
+	var timeoutErr *retry.TimeoutError
+	if errors.As(err, &timeoutErr) {
+		return fmt.Errorf("timeout after %s while waiting for %s: %s", w.Timeout, w.Name, lastStatus)
+	}
+	return err
+}
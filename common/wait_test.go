@@ -0,0 +1,49 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitConfigPoll(t *testing.T) {
+	statuses := []string{}
+	checks := 0
+	err := WaitConfig{
+		Name:     "cluster abc",
+		Timeout:  time.Minute,
+		Interval: time.Millisecond,
+		Progress: func(ctx context.Context, status string, elapsed time.Duration) {
+			statuses = append(statuses, status)
+		},
+	}.Poll(context.Background(), func() (bool, string, error) {
+		checks++
+		return checks == 3, fmt.Sprintf("PENDING %d", checks), nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"PENDING 1", "PENDING 2"}, statuses)
+}
+
+func TestWaitConfigPoll_Error(t *testing.T) {
+	err := WaitConfig{
+		Name:    "cluster abc",
+		Timeout: time.Minute,
+	}.Poll(context.Background(), func() (bool, string, error) {
+		return false, "", fmt.Errorf("nope")
+	})
+	assert.EqualError(t, err, "nope")
+}
+
+func TestWaitConfigPoll_Timeout(t *testing.T) {
+	err := WaitConfig{
+		Name:     "cluster abc",
+		Timeout:  50 * time.Millisecond,
+		Interval: 10 * time.Millisecond,
+	}.Poll(context.Background(), func() (bool, string, error) {
+		return false, "PENDING", nil
+	})
+	assert.EqualError(t, err, "timeout after 50ms while waiting for cluster abc: PENDING")
+}
@@ -0,0 +1,248 @@
+package dashboards
+
+import (
+	"context"
+	"crypto/md5"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go/service/dashboards"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Dashboard is a Lakeview dashboard, as returned by REST API
+type Dashboard struct {
+	DashboardID         string `json:"dashboard_id,omitempty"`
+	DisplayName         string `json:"display_name,omitempty"`
+	WarehouseID         string `json:"warehouse_id,omitempty"`
+	ParentPath          string `json:"parent_path,omitempty"`
+	Path                string `json:"path,omitempty"`
+	SerializedDashboard string `json:"serialized_dashboard,omitempty"`
+	Etag                string `json:"etag,omitempty"`
+	CreateTime          string `json:"create_time,omitempty"`
+	UpdateTime          string `json:"update_time,omitempty"`
+	LifecycleState      string `json:"lifecycle_state,omitempty"`
+}
+
+type dashboardEntity struct {
+	DisplayName             string            `json:"display_name"`
+	WarehouseID             string            `json:"warehouse_id"`
+	ParentPath              string            `json:"parent_path" tf:"force_new"`
+	SerializedDashboard     string            `json:"serialized_dashboard,omitempty"`
+	SerializedDashboardFile string            `json:"serialized_dashboard_file,omitempty"`
+	TemplateVariables       map[string]string `json:"template_variables,omitempty"`
+	EmbedCredentials        bool              `json:"embed_credentials,omitempty" tf:"default:true"`
+	MD5                     string            `json:"md5,omitempty"`
+	DashboardID             string            `json:"dashboard_id,omitempty" tf:"computed"`
+	Path                    string            `json:"path,omitempty" tf:"computed"`
+	Etag                    string            `json:"etag,omitempty" tf:"computed"`
+	CreateTime              string            `json:"create_time,omitempty" tf:"computed"`
+	UpdateTime              string            `json:"update_time,omitempty" tf:"computed"`
+}
+
+type listDashboardsResponse struct {
+	Dashboards    []Dashboard `json:"dashboards,omitempty"`
+	NextPageToken string      `json:"next_page_token,omitempty"`
+}
+
+// NewDashboardAPI creates DashboardAPI instance from provider meta
+func NewDashboardAPI(ctx context.Context, m any) DashboardAPI {
+	return DashboardAPI{m.(*common.DatabricksClient), ctx}
+}
+
+// DashboardAPI exposes the Lakeview dashboards API
+type DashboardAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+// Create creates a draft dashboard
+func (a DashboardAPI) Create(d Dashboard) (result Dashboard, err error) {
+	err = a.client.Post(a.context, "/lakeview/dashboards", d, &result)
+	return
+}
+
+// Read returns a draft dashboard
+func (a DashboardAPI) Read(dashboardID string) (result Dashboard, err error) {
+	err = a.client.Get(a.context, fmt.Sprintf("/lakeview/dashboards/%s", dashboardID), nil, &result)
+	return
+}
+
+// List returns all dashboards, that aren't in trash
+func (a DashboardAPI) List() (result []Dashboard, err error) {
+	request := map[string]any{"page_size": 100}
+	for {
+		var response listDashboardsResponse
+		err = a.client.Get(a.context, "/lakeview/dashboards", request, &response)
+		if err != nil {
+			return
+		}
+		result = append(result, response.Dashboards...)
+		if response.NextPageToken == "" {
+			return
+		}
+		request["page_token"] = response.NextPageToken
+	}
+}
+
+// Update updates a draft dashboard
+func (a DashboardAPI) Update(dashboardID string, d Dashboard) (result Dashboard, err error) {
+	err = a.client.PatchWithResponse(a.context, fmt.Sprintf("/lakeview/dashboards/%s", dashboardID), d, &result)
+	return
+}
+
+// Delete moves a dashboard to trash
+func (a DashboardAPI) Delete(dashboardID string) error {
+	return a.client.Delete(a.context, fmt.Sprintf("/lakeview/dashboards/%s", dashboardID), nil)
+}
+
+// placeholders like `{{ catalog }}` are replaced with values of template_variables
+var templateVariableRegex = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+// renderTemplate substitutes all placeholders, failing on the ones without values
+func renderTemplate(content string, variables map[string]any) (string, error) {
+	missing := map[string]bool{}
+	rendered := templateVariableRegex.ReplaceAllStringFunc(content, func(m string) string {
+		name := templateVariableRegex.FindStringSubmatch(m)[1]
+		v, ok := variables[name]
+		if !ok {
+			missing[name] = true
+			return m
+		}
+		return fmt.Sprint(v)
+	})
+	if len(missing) > 0 {
+		names := []string{}
+		for name := range missing {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return "", fmt.Errorf("template variables are not defined in template_variables: %s",
+			strings.Join(names, ", "))
+	}
+	return rendered, nil
+}
+
+// readSerializedDashboard returns the dashboard definition with substituted template variables
+// and sets its MD5 checksum
+func readSerializedDashboard(d *schema.ResourceData) (string, error) {
+	content := d.Get("serialized_dashboard").(string)
+	if fileName := d.Get("serialized_dashboard_file").(string); fileName != "" {
+		log.Printf("[INFO] Reading %s", fileName)
+		raw, err := os.ReadFile(fileName)
+		if err != nil {
+			return "", err
+		}
+		content = string(raw)
+	}
+	rendered, err := renderTemplate(content, d.Get("template_variables").(map[string]any))
+	if err != nil {
+		return "", err
+	}
+	d.Set("md5", fmt.Sprintf("%x", md5.Sum([]byte(rendered))))
+	return rendered, nil
+}
+
+func publishDashboard(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+	w, err := c.WorkspaceClient()
+	if err != nil {
+		return err
+	}
+	return w.Lakeview.Publish(ctx, dashboards.PublishRequest{
+		DashboardId:      d.Id(),
+		WarehouseId:      d.Get("warehouse_id").(string),
+		EmbedCredentials: d.Get("embed_credentials").(bool),
+		ForceSendFields:  []string{"EmbedCredentials"},
+	})
+}
+
+// ResourceDashboard manages Lakeview dashboards
+func ResourceDashboard() common.Resource {
+	s := common.StructToSchema(dashboardEntity{}, func(m map[string]*schema.Schema) map[string]*schema.Schema {
+		sources := []string{"serialized_dashboard", "serialized_dashboard_file"}
+		for _, source := range sources {
+			m[source].ExactlyOneOf = sources
+		}
+		m["md5"].Default = "different"
+		m["md5"].DiffSuppressFunc = func(k, old, new string, d *schema.ResourceData) bool {
+			if _, err := readSerializedDashboard(d); err != nil {
+				return false
+			}
+			return old == d.Get("md5")
+		}
+		// the server value is normalized, so we rely on md5 of the configured definition
+		m["serialized_dashboard"].DiffSuppressFunc = func(k, old, new string, d *schema.ResourceData) bool {
+			return d.Id() != ""
+		}
+		m["parent_path"].DiffSuppressFunc = func(k, old, new string, d *schema.ResourceData) bool {
+			return strings.TrimPrefix(old, "/Workspace") == strings.TrimPrefix(new, "/Workspace")
+		}
+		return m
+	})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			content, err := readSerializedDashboard(d)
+			if err != nil {
+				return err
+			}
+			dashboard, err := NewDashboardAPI(ctx, c).Create(Dashboard{
+				DisplayName:         d.Get("display_name").(string),
+				WarehouseID:         d.Get("warehouse_id").(string),
+				ParentPath:          d.Get("parent_path").(string),
+				SerializedDashboard: content,
+			})
+			if err != nil {
+				return err
+			}
+			d.SetId(dashboard.DashboardID)
+			d.Set("etag", dashboard.Etag)
+			return publishDashboard(ctx, d, c)
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			dashboard, err := NewDashboardAPI(ctx, c).Read(d.Id())
+			if err != nil {
+				return err
+			}
+			if d.Get("etag").(string) != dashboard.Etag {
+				// dashboard was modified outside of Terraform, so the checksum won't match
+				// the configured definition and the dashboard will be updated
+				log.Printf("[INFO] Dashboard %s was changed remotely", d.Id())
+				d.Set("md5", "changed_remotely")
+			}
+			d.Set("dashboard_id", dashboard.DashboardID)
+			d.Set("display_name", dashboard.DisplayName)
+			d.Set("warehouse_id", dashboard.WarehouseID)
+			d.Set("parent_path", dashboard.ParentPath)
+			d.Set("path", dashboard.Path)
+			d.Set("etag", dashboard.Etag)
+			d.Set("create_time", dashboard.CreateTime)
+			d.Set("update_time", dashboard.UpdateTime)
+			return nil
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			content, err := readSerializedDashboard(d)
+			if err != nil {
+				return err
+			}
+			dashboard, err := NewDashboardAPI(ctx, c).Update(d.Id(), Dashboard{
+				DisplayName:         d.Get("display_name").(string),
+				WarehouseID:         d.Get("warehouse_id").(string),
+				SerializedDashboard: content,
+			})
+			if err != nil {
+				return err
+			}
+			d.Set("etag", dashboard.Etag)
+			return publishDashboard(ctx, d, c)
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewDashboardAPI(ctx, c).Delete(d.Id())
+		},
+	}
+}
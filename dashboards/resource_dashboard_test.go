@@ -0,0 +1,221 @@
+package dashboards
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/service/dashboards"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderTemplate(t *testing.T) {
+	rendered, err := renderTemplate(`{"catalog": "{{catalog}}", "x": "{{ catalog }}.{{schema}}"}`, map[string]any{
+		"catalog": "dev",
+		"schema":  "sales",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `{"catalog": "dev", "x": "dev.sales"}`, rendered)
+
+	_, err = renderTemplate(`{{ b }} {{a}} {{b}}`, map[string]any{})
+	assert.EqualError(t, err, "template variables are not defined in template_variables: a, b")
+}
+
+func TestResourceDashboardCreateFromFile(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "dashboard.lvdash.json")
+	require.NoError(t, os.WriteFile(fileName, []byte(`{"catalog": "{{ catalog }}"}`), 0644))
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/lakeview/dashboards",
+				ExpectedRequest: Dashboard{
+					DisplayName:         "Sales",
+					WarehouseID:         "abc",
+					ParentPath:          "/Shared/dashboards",
+					SerializedDashboard: `{"catalog": "prod"}`,
+				},
+				Response: Dashboard{
+					DashboardID: "xyz",
+					Etag:        "1",
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/lakeview/dashboards/xyz/published",
+				ExpectedRequest: dashboards.PublishRequest{
+					WarehouseId:      "abc",
+					EmbedCredentials: true,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/lakeview/dashboards/xyz",
+				Response: Dashboard{
+					DashboardID: "xyz",
+					DisplayName: "Sales",
+					WarehouseID: "abc",
+					ParentPath:  "/Workspace/Shared/dashboards",
+					Path:        "/Workspace/Shared/dashboards/Sales.lvdash.json",
+					Etag:        "1",
+				},
+			},
+		},
+		Resource: ResourceDashboard(),
+		Create:   true,
+		HCL: `
+		display_name = "Sales"
+		warehouse_id = "abc"
+		parent_path = "/Shared/dashboards"
+		serialized_dashboard_file = "` + fileName + `"
+		template_variables = {
+			catalog = "prod"
+		}`,
+	}.Apply(t)
+	require.NoError(t, err)
+	assert.Equal(t, "xyz", d.Id())
+	assert.Equal(t, "/Workspace/Shared/dashboards/Sales.lvdash.json", d.Get("path"))
+	assert.Equal(t, "1", d.Get("etag"))
+	assert.NotEqual(t, "changed_remotely", d.Get("md5"))
+}
+
+func TestResourceDashboardCreateMissingVariable(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceDashboard(),
+		Create:   true,
+		HCL: `
+		display_name = "Sales"
+		warehouse_id = "abc"
+		parent_path = "/Shared/dashboards"
+		serialized_dashboard = "{\"catalog\": \"{{catalog}}\"}"`,
+	}.ExpectError(t, "template variables are not defined in template_variables: catalog")
+}
+
+func TestResourceDashboardReadChangedRemotely(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/lakeview/dashboards/xyz",
+				Response: Dashboard{
+					DashboardID: "xyz",
+					DisplayName: "Sales",
+					WarehouseID: "abc",
+					Etag:        "2",
+				},
+			},
+		},
+		Resource: ResourceDashboard(),
+		Read:     true,
+		ID:       "xyz",
+		InstanceState: map[string]string{
+			"etag": "1",
+			"md5":  "abc",
+		},
+	}.Apply(t)
+	require.NoError(t, err)
+	assert.Equal(t, "changed_remotely", d.Get("md5"))
+	assert.Equal(t, "2", d.Get("etag"))
+}
+
+func TestResourceDashboardUpdate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/lakeview/dashboards/xyz",
+				ExpectedRequest: Dashboard{
+					DisplayName:         "Sales",
+					WarehouseID:         "def",
+					SerializedDashboard: `{"catalog": "dev"}`,
+				},
+				Response: Dashboard{
+					DashboardID: "xyz",
+					Etag:        "3",
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/lakeview/dashboards/xyz/published",
+				ExpectedRequest: map[string]any{
+					"warehouse_id":      "def",
+					"embed_credentials": false,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/lakeview/dashboards/xyz",
+				Response: Dashboard{
+					DashboardID: "xyz",
+					DisplayName: "Sales",
+					WarehouseID: "def",
+					ParentPath:  "/Shared/dashboards",
+					Etag:        "3",
+				},
+			},
+		},
+		Resource: ResourceDashboard(),
+		Update:   true,
+		ID:       "xyz",
+		InstanceState: map[string]string{
+			"display_name":         "Sales",
+			"warehouse_id":         "abc",
+			"parent_path":          "/Shared/dashboards",
+			"serialized_dashboard": `{"catalog": "{{catalog}}"}`,
+			"etag":                 "2",
+		},
+		HCL: `
+		display_name = "Sales"
+		warehouse_id = "def"
+		parent_path = "/Shared/dashboards"
+		embed_credentials = false
+		serialized_dashboard = "{\"catalog\": \"{{catalog}}\"}"
+		template_variables = {
+			catalog = "dev"
+		}`,
+	}.ApplyNoError(t)
+}
+
+func TestResourceDashboardDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/lakeview/dashboards/xyz",
+			},
+		},
+		Resource: ResourceDashboard(),
+		Delete:   true,
+		ID:       "xyz",
+	}.ApplyNoError(t)
+}
+
+func TestDashboardAPIList(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/lakeview/dashboards?page_size=100",
+			Response: listDashboardsResponse{
+				Dashboards:    []Dashboard{{DashboardID: "a", DisplayName: "A"}},
+				NextPageToken: "next",
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/lakeview/dashboards?page_size=100&page_token=next",
+			Response: listDashboardsResponse{
+				Dashboards: []Dashboard{{DashboardID: "b", DisplayName: "B"}},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		list, err := NewDashboardAPI(ctx, client).List()
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		assert.Equal(t, "a", list[0].DashboardID)
+		assert.Equal(t, "b", list[1].DashboardID)
+	})
+}
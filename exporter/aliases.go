@@ -0,0 +1,165 @@
+package exporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const aliasesFileName = "resource_aliases.json"
+
+// attributes that identify the same object across different workspaces, checked in order
+var aliasNameAttributes = []string{
+	"name",
+	"display_name",
+	"user_name",
+	"application_id",
+	"cluster_name",
+	"instance_pool_name",
+	"instance_profile_arn",
+	"path",
+}
+
+// resourceAlias describes the source object of a generated resource address
+type resourceAlias struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+// resourceAliases is a content of the aliases file: resource address -> source object
+type resourceAliases map[string]resourceAlias
+
+type aliasesHolder struct {
+	// resource type + source object name -> resource name
+	byName map[string]string
+	// resource names that were already given to some objects
+	used  map[string]bool
+	mutex sync.Mutex
+}
+
+func aliasKey(resourceType, name string) string {
+	return resourceType + "/" + name
+}
+
+// aliasSourceName returns the name of the source object, that is stable across workspaces
+func aliasSourceName(d *schema.ResourceData) string {
+	if d == nil {
+		return ""
+	}
+	for _, attr := range aliasNameAttributes {
+		if v, ok := d.GetOk(attr); ok {
+			if s, ok := v.(string); ok && s != "" {
+				return s
+			}
+		}
+	}
+	return ""
+}
+
+func readResourceAliases(fileName string) (resourceAliases, error) {
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	aliases := resourceAliases{}
+	err = json.Unmarshal(content, &aliases)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse aliases file %s: %w", fileName, err)
+	}
+	return aliases, nil
+}
+
+// loadAliases reads the aliases file from a previous export, so that equivalent objects
+// get identical resource addresses
+func (ic *importContext) loadAliases(fileName string) error {
+	aliases, err := readResourceAliases(fileName)
+	if err != nil {
+		return err
+	}
+	holder := &aliasesHolder{
+		byName: map[string]string{},
+		used:   map[string]bool{},
+	}
+	for address, alias := range aliases {
+		if alias.Name == "" {
+			continue
+		}
+		resourceType, name, err := splitResourceAddress(address)
+		if err != nil {
+			return err
+		}
+		holder.byName[aliasKey(resourceType, alias.Name)] = name
+	}
+	log.Printf("[INFO] Loaded %d aliases from %s", len(holder.byName), fileName)
+	ic.aliases = holder
+	return nil
+}
+
+func splitResourceAddress(address string) (string, string, error) {
+	resourceType, name, found := strings.Cut(address, ".")
+	if !found || resourceType == "" || name == "" {
+		return "", "", fmt.Errorf("invalid resource address: %s", address)
+	}
+	return resourceType, name, nil
+}
+
+// aliasedName returns the name of equivalent resource from the aliases file, if it wasn't given yet
+func (ic *importContext) aliasedName(r *resource) (string, bool) {
+	if ic.aliases == nil {
+		return "", false
+	}
+	sourceName := aliasSourceName(r.Data)
+	if sourceName == "" {
+		return "", false
+	}
+	ic.aliases.mutex.Lock()
+	defer ic.aliases.mutex.Unlock()
+	name, ok := ic.aliases.byName[aliasKey(r.Resource, sourceName)]
+	if !ok {
+		return "", false
+	}
+	address := r.Resource + "." + name
+	if ic.aliases.used[address] {
+		log.Printf("[WARN] Alias %s is already used, generating new name for %s", address, r)
+		return "", false
+	}
+	ic.aliases.used[address] = true
+	return name, true
+}
+
+// generateAliases writes the mapping of generated resource addresses to source objects
+func (ic *importContext) generateAliases() error {
+	fileName := fmt.Sprintf("%s/%s", ic.Directory, aliasesFileName)
+	aliases := resourceAliases{}
+	if ic.incremental {
+		existing, err := readResourceAliases(fileName)
+		if err == nil {
+			aliases = existing
+		} else {
+			log.Printf("[WARN] Can't load existing aliases: %v", err)
+		}
+	}
+	for _, r := range ic.Scope.Sorted() {
+		if r.Mode == "data" {
+			continue
+		}
+		if r.Resource == "databricks_group_member" && ic.groupMembers != nil {
+			// memberships are generated as instances of `for_each` resources, not under their own names
+			continue
+		}
+		aliases[r.Resource+"."+r.Name] = resourceAlias{
+			ID:   r.ID,
+			Name: aliasSourceName(r.Data),
+		}
+	}
+	content, err := json.MarshalIndent(aliases, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(fileName, content, 0644)
+}
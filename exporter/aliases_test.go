@@ -0,0 +1,68 @@
+package exporter
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/policies"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAliasesRoundTrip(t *testing.T) {
+	tmpDir := fmt.Sprintf("/tmp/tf-%s", qa.RandomName())
+	require.NoError(t, os.MkdirAll(tmpDir, 0755))
+	defer os.RemoveAll(tmpDir)
+
+	ic := newImportContext(&common.DatabricksClient{})
+	ic.Directory = tmpDir
+	d := policies.ResourceClusterPolicy().ToResource().TestResourceData()
+	d.SetId("123")
+	d.Set("name", "Shared Policy")
+	r := &resource{Resource: "databricks_cluster_policy", ID: "123", Data: d}
+	r.Name = "shared_policy_from_a"
+	ic.Scope.Append(r)
+	ic.Scope.Append(&resource{Resource: "databricks_current_metastore", ID: "abc", Name: "this", Mode: "data"})
+	ic.Scope.Append(&resource{Resource: "databricks_group_member", ID: "1|u1", Name: "admins_u1"})
+	ic.groupMembers = map[string]map[string]struct{}{}
+	require.NoError(t, ic.generateAliases())
+
+	aliases, err := readResourceAliases(tmpDir + "/" + aliasesFileName)
+	require.NoError(t, err)
+	assert.Equal(t, resourceAliases{
+		"databricks_cluster_policy.shared_policy_from_a": {ID: "123", Name: "Shared Policy"},
+	}, aliases)
+
+	other := newImportContext(&common.DatabricksClient{})
+	require.NoError(t, other.loadAliases(tmpDir+"/"+aliasesFileName))
+	d2 := policies.ResourceClusterPolicy().ToResource().TestResourceData()
+	d2.SetId("456")
+	d2.Set("name", "Shared Policy")
+	assert.Equal(t, "shared_policy_from_a", other.ResourceName(&resource{
+		Resource: "databricks_cluster_policy", ID: "456", Data: d2}))
+	// the same alias isn't given to the second object
+	assert.Equal(t, "shared_policy", other.ResourceName(&resource{
+		Resource: "databricks_cluster_policy", ID: "789", Data: d2}))
+}
+
+func TestLoadAliasesErrors(t *testing.T) {
+	tmpDir := fmt.Sprintf("/tmp/tf-%s", qa.RandomName())
+	require.NoError(t, os.MkdirAll(tmpDir, 0755))
+	defer os.RemoveAll(tmpDir)
+	ic := newImportContext(&common.DatabricksClient{})
+
+	err := ic.loadAliases(tmpDir + "/missing.json")
+	assert.Error(t, err)
+
+	fname := tmpDir + "/bad.json"
+	_ = os.WriteFile(fname, []byte("{"), 0644)
+	err = ic.loadAliases(fname)
+	assert.EqualError(t, err, "can't parse aliases file "+fname+": unexpected end of JSON input")
+
+	_ = os.WriteFile(fname, []byte(`{"no_address": {"id": "1", "name": "a"}}`), 0644)
+	err = ic.loadAliases(fname)
+	assert.EqualError(t, err, "invalid resource address: no_address")
+}
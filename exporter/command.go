@@ -6,12 +6,15 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"regexp"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/databricks/databricks-sdk-go/client"
 	"github.com/databricks/databricks-sdk-go/config"
 	"github.com/databricks/terraform-provider-databricks/common"
+	"golang.org/x/exp/maps"
 )
 
 type levelWriter []string
@@ -50,6 +53,191 @@ func (ic *importContext) allServicesAndListing() (string, string) {
 	return services, listing
 }
 
+const (
+	allWorkspaceServices = "all-workspace"
+	allAccountServices   = "all-account"
+)
+
+// servicesForLevel returns sorted names of services that have workspace or account level resources
+func (ic *importContext) servicesForLevel(accountLevel bool) []string {
+	services := map[string]struct{}{}
+	for _, ir := range ic.Importables {
+		if (accountLevel && ir.AccountLevel) || (!accountLevel && ir.WorkspaceLevel) {
+			services[ir.Service] = struct{}{}
+		}
+	}
+	keys := maps.Keys(services)
+	sort.Strings(keys)
+	return keys
+}
+
+// isKnownService checks if any resource belongs to the service
+func (ic *importContext) isKnownService(service string) bool {
+	for _, ir := range ic.Importables {
+		if ir.Service == service {
+			return true
+		}
+	}
+	return false
+}
+
+// isFilterableService checks if any resource of the service is listed by name, so `-filter` has an effect on it
+func (ic *importContext) isFilterableService(service string) bool {
+	for _, ir := range ic.Importables {
+		if ir.Service == service && ir.Filterable {
+			return true
+		}
+	}
+	return false
+}
+
+var filterServiceRegex = regexp.MustCompile(`^[a-z-]+$`)
+
+// parseFilters parses `-filter` in the <service>=<regex>[,<service>=<regex>...] form. Parts that don't start
+// with <service>= are appended to the previous expression, so expressions could contain commas, like `a{1,3}`
+func (ic *importContext) parseFilters(filters string) (map[string]*regexp.Regexp, error) {
+	expressions := map[string]string{}
+	last := ""
+	for _, part := range strings.Split(filters, ",") {
+		service, expr, found := strings.Cut(part, "=")
+		service = strings.TrimSpace(service)
+		if found && filterServiceRegex.MatchString(service) {
+			if !ic.isKnownService(service) {
+				return nil, fmt.Errorf("unknown service in -filter: %s", service)
+			}
+			if !ic.isFilterableService(service) {
+				return nil, fmt.Errorf("-filter isn't supported for service %s, as its objects aren't listed by name",
+					service)
+			}
+			expressions[service] = expr
+			last = service
+			continue
+		}
+		if last == "" {
+			if strings.TrimSpace(part) == "" {
+				continue
+			}
+			return nil, fmt.Errorf("expected <service>=<regex> in -filter, got %s", part)
+		}
+		expressions[last] += "," + part
+	}
+	result := map[string]*regexp.Regexp{}
+	for service, expr := range expressions {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression for %s in -filter: %w", service, err)
+		}
+		result[service] = re
+	}
+	return result, nil
+}
+
+// excludeServices removes excluded services from the comma-separated list of services
+func excludeServices(services, excluded string) string {
+	if excluded == "" {
+		return services
+	}
+	skip := map[string]bool{}
+	for _, s := range strings.Split(excluded, ",") {
+		skip[strings.TrimSpace(s)] = true
+	}
+	result := []string{}
+	for _, s := range strings.Split(services, ",") {
+		s = strings.TrimSpace(s)
+		if s != "" && !skip[s] {
+			result = append(result, s)
+		}
+	}
+	return strings.Join(result, ",")
+}
+
+// default value of `-last-active-days`
+const defaultLastActiveDays int64 = 3650
+
+// parseOwners parses comma-separated `-owner` value into the set of lower-cased names
+func parseOwners(owners string) map[string]struct{} {
+	result := map[string]struct{}{}
+	for _, owner := range strings.Split(owners, ",") {
+		owner = strings.ToLower(strings.TrimSpace(owner))
+		if owner != "" {
+			result[owner] = struct{}{}
+		}
+	}
+	return result
+}
+
+// excludeRegexFlag collects `-exclude-regex` values in the <resource type or service>=<regex> form
+type excludeRegexFlag map[string][]*regexp.Regexp
+
+func (f excludeRegexFlag) String() string {
+	values := []string{}
+	for k, regexes := range f {
+		for _, re := range regexes {
+			values = append(values, k+"="+re.String())
+		}
+	}
+	sort.Strings(values)
+	return strings.Join(values, ",")
+}
+
+func (f excludeRegexFlag) Set(value string) error {
+	key, expr, found := strings.Cut(value, "=")
+	key = strings.TrimSpace(key)
+	if !found || key == "" || expr == "" {
+		return fmt.Errorf("expected <resource type or service>=<regex>, got %s", value)
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return fmt.Errorf("invalid regular expression for %s: %w", key, err)
+	}
+	f[key] = append(f[key], re)
+	return nil
+}
+
+// expandServices replaces `all-workspace` & `all-account` shorthands with corresponding services
+// and checks that all requested services exist
+func (ic *importContext) expandServices(services string) (string, error) {
+	known := map[string]struct{}{}
+	for _, ir := range ic.Importables {
+		known[ir.Service] = struct{}{}
+	}
+	result := []string{}
+	seen := map[string]bool{}
+	unknown := []string{}
+	for _, s := range strings.Split(services, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		expanded := []string{s}
+		switch s {
+		case allWorkspaceServices:
+			expanded = ic.servicesForLevel(false)
+		case allAccountServices:
+			expanded = ic.servicesForLevel(true)
+		default:
+			if _, ok := known[s]; !ok {
+				unknown = append(unknown, s)
+				continue
+			}
+		}
+		for _, e := range expanded {
+			if !seen[e] {
+				seen[e] = true
+				result = append(result, e)
+			}
+		}
+	}
+	if len(unknown) > 0 {
+		validServices := maps.Keys(known)
+		sort.Strings(validServices)
+		return "", fmt.Errorf("unknown services: %s. Valid services are: %s, or %s, %s shorthands",
+			strings.Join(unknown, ", "), strings.Join(validServices, ", "),
+			allWorkspaceServices, allAccountServices)
+	}
+	return strings.Join(result, ","), nil
+}
+
 func (ic *importContext) interactivePrompts() {
 	req, _ := http.NewRequest("GET", "/", nil)
 	for ic.Client.DatabricksClient.Config.Authenticate(req) != nil {
@@ -80,19 +268,22 @@ func (ic *importContext) interactivePrompts() {
 func Run(args ...string) error {
 	log.SetOutput(&logLevel)
 	log.Printf("[WARN] This tooling is experimental and provided as is. It has an evolving interface, which may change or be removed in future versions of the provider.")
-	client, err := client.New(&config.Config{})
+	transport := newObservingTransport()
+	client, err := client.New(&config.Config{HTTPTransport: transport})
 	if err != nil {
 		return err
 	}
+	transport.setInsecureSkipVerify(client.Config.InsecureSkipVerify)
 	ic := newImportContext(&common.DatabricksClient{
 		DatabricksClient: client,
 	})
+	transport.observe = ic.observeResponse
 
 	flags := flag.NewFlagSet("exporter", flag.ExitOnError)
 	flags.StringVar(&ic.Module, "module", "",
 		"Terraform module name, that changes are imported. "+
 			"Defaults to empty string. Makes effect on generated "+
-			"import.sh file")
+			"import.sh file or imports.tf file")
 
 	cwd, err := os.Getwd()
 	if err != nil {
@@ -103,27 +294,53 @@ func Run(args ...string) error {
 	flags.BoolVar(&ic.includeUserDomains, "includeUserDomains", false, "Include domain portion in `databricks_user` resource name")
 	flags.BoolVar(&ic.importAllUsers, "importAllUsers", false,
 		"Import all users and service principals, even if they aren't referenced in any resource")
+	flags.StringVar(&ic.groupsFilter, "groups-filter", "",
+		"Comma-separated list of groups. Only users & service principals that are direct or transitive "+
+			"members of these groups are exported")
+	flags.BoolVar(&ic.includeDefaultConf, "include-default-conf", false,
+		"Export workspace configuration keys even if they have default values")
 	flags.BoolVar(&ic.exportDeletedUsersAssets, "exportDeletedUsersAssets", false,
-		"Export assets (notebooks, etc.) of deleted users & service principals")
+		"Export assets (notebooks, SQL queries, etc.) of deleted & deactivated users & service principals")
 	flags.StringVar(&ic.Directory, "directory", cwd,
 		"Directory to generate sources in. Defaults to current directory.")
-	flags.Int64Var(&ic.lastActiveDays, "last-active-days", 3650,
-		"Items with older than activity specified won't be imported.")
+	flags.Int64Var(&ic.lastActiveDays, "last-active-days", defaultLastActiveDays,
+		"Items with older than activity specified won't be imported. Applies to clusters, DLT pipelines, "+
+			"SQL warehouses, serving endpoints and MLflow experiments.")
+	flags.DurationVar(&ic.progressInterval, "progress-interval", defaultProgressInterval,
+		"Interval between progress lines with numbers of found, imported and generated objects, printed to stderr. "+
+			"0 disables them.")
 	flags.BoolVar(&ic.incremental, "incremental", false, "Incremental export of the data. Requires -updated-since parameter")
-	flags.BoolVar(&ic.noFormat, "noformat", false, "Don't run `terraform fmt` on exported files")
+	flags.BoolVar(&ic.noFormat, "noformat", false, "Don't format exported files")
 	flags.StringVar(&ic.updatedSinceStr, "updated-since", "",
 		"Include only resources updated since a given timestamp (in ISO8601 format, i.e. 2023-07-01T00:00:00Z)")
 	flags.BoolVar(&debug, "debug", false, "Print extra debug information.")
 	flags.BoolVar(&trace, "trace", false, "Print full debug information.")
 	flags.BoolVar(&ic.mounts, "mounts", false, "List DBFS mount points.")
+	flags.BoolVar(&ic.mountsNoCluster, "mounts-no-cluster", false,
+		"List DBFS mount points without starting a cluster. Mount points are found through the DBFS API, "+
+			"and their sources are taken from terraform.tfstate file of the previous export in the output directory, "+
+			"so mount points aren't exported without it.")
+	var commandsConfig common.CommandExecutorConfig
+	flags.StringVar(&commandsConfig.ClusterID, "commands-cluster-id", "",
+		"ID of an existing cluster to execute discovery commands, like listing of mount points, "+
+			"instead of creating a new cluster.")
+	flags.StringVar(&commandsConfig.WarehouseID, "commands-warehouse-id", "",
+		"ID of SQL warehouse to execute SQL discovery commands, instead of executing them on a cluster.")
 	flags.BoolVar(&ic.generateDeclaration, "generateProviderDeclaration", true,
 		"Generate Databricks provider declaration.")
 	flags.StringVar(&ic.notebooksFormat, "notebooksFormat", "SOURCE",
-		"Format to export notebooks: SOURCE, DBC, JUPYTER. Default: SOURCE")
+		"Format to export notebooks: SOURCE, DBC, JUPYTER, HTML. Default: SOURCE")
+	flags.StringVar(&ic.notebooksLayout, "notebooks-layout", notebooksLayoutFlat,
+		"Layout of exported notebooks and workspace files: flat (default) uses normalized paths with object IDs, "+
+			"workspace mirrors workspace paths as is.")
+	flags.StringVar(&ic.naming, "naming", namingName,
+		"Naming of generated resources: name (default) uses normalized names of objects, id uses IDs of objects, "+
+			"name_id_hash adds the hash of the ID to the name, slug removes diacritics from names.")
 	services, listing := ic.allServicesAndListing()
 	var configuredServices string
 	flags.StringVar(&configuredServices, "services", services,
-		"Comma-separated list of services to import. By default all services are imported.")
+		"Comma-separated list of services to import. By default all services are imported. "+
+			"Use `all-workspace` or `all-account` to select all workspace or account level services.")
 	flags.StringVar(&ic.listing, "listing", listing,
 		"Comma-separated list of services to be listed and further passed on for importing. "+
 			"`-services` parameter controls which transitive dependencies will be processed. "+
@@ -131,6 +348,73 @@ func Run(args ...string) error {
 	flags.StringVar(&ic.match, "match", "", "Match resource names during listing operation. "+
 		"This filter applies to all resources that are getting listed, so if you want to import "+
 		"all dependencies of just one cluster, specify -listing=compute")
+	flags.BoolVar(&ic.exportRepoContent, "export-repo-content", false,
+		"Export notebooks & files stored in repos without Git provider as databricks_notebook/databricks_workspace_file "+
+			"resources. Requires `notebooks` service.")
+	flags.BoolVar(&ic.exportVolumeContent, "export-volume-content", false,
+		"Export files stored in UC volumes as databricks_file resources. Requires `uc-volumes` service.")
+	flags.BoolVar(&ic.secretStoreStubs, "secret-store-stubs", false,
+		"Generate data sources of Azure Key Vault or AWS Secrets Manager for values of secrets in scopes, "+
+			"which names indicate the backing store, i.e. my-kv or prod-secretsmanager, instead of variables.")
+	flags.BoolVar(&ic.exportTokens, "export-tokens", false,
+		"List personal access tokens and tokens created on behalf of service principals, for audits of token usage. "+
+			"Values of tokens aren't exported. Requires `tokens` service.")
+	flags.BoolVar(&ic.generateReadme, "generate-readme", false,
+		"Generate README.md with the number of exported objects per service, variables that require values "+
+			"and instructions on importing the exported resources.")
+	flags.StringVar(&ic.outputFormat, "format", "hcl",
+		"Format of the generated configuration: hcl (default) or json. The json format generates *.tf.json files "+
+			"that could be processed without HCL parser.")
+	flags.BoolVar(&ic.nativeImport, "native-import", false,
+		"Generate import blocks of Terraform 1.5+ in the imports.tf file instead of the import.sh script.")
+	flags.BoolVar(&ic.dryRun, "dry-run", false,
+		"Perform only listing, and print the number of objects per service that would be exported, together with "+
+			"the number of API calls, without writing any files. Objects discovered while importing other objects, "+
+			"like dependencies or UC schemas and tables of catalogs, aren't counted, and affected services are printed.")
+	flags.BoolVar(&ic.runImports, "run-imports", false,
+		"Import exported resources into the Terraform state after generation, so the output directory is ready for "+
+			"terraform plan. Imports are executed in parallel (EXPORTER_IMPORT_PARALLELISM, default 4) with retries. "+
+			"With -native-import, the plan of import blocks is applied only if it doesn't change any resource.")
+	flags.BoolVar(&ic.includeSystemObjects, "include-system-objects", false,
+		"Export objects that are created by Databricks, like system catalogs or starter warehouses.")
+	flags.BoolVar(&ic.includeBundleManaged, "include-bundle-managed", false,
+		"Export jobs and DLT pipelines deployed by Databricks Asset Bundles. By default they are skipped and "+
+			"listed in the bundle-managed.txt file.")
+	flags.BoolVar(&ic.emitDataSources, "emit-data-sources", false,
+		"Generate data blocks for objects that are referenced by exported resources, but belong to services that "+
+			"aren't exported, like groups or SQL warehouses, instead of hard-coding their IDs.")
+	flags.BoolVar(&ic.separateAcls, "separate-acls", false,
+		"Write permissions, grants and secret ACLs into acls_<service>.tf files, named after services of "+
+			"secured objects, so access to exported objects could be reviewed separately.")
+	flags.BoolVar(&ic.tfvarsSecretAcls, "tfvars-secret-acls", false,
+		"List principals with access to secret scopes in terraform.tfvars.example and secrets.auto.tfvars, "+
+			"so owners of secrets could be found.")
+	flags.BoolVar(&ic.separateEntitlements, "separate-entitlements", false,
+		"Export entitlements of users, service principals and groups as databricks_entitlements resources, "+
+			"instead of embedding them into identity resources. Useful when identities are managed by IdP.")
+	flags.StringVar(&ic.aliasesFile, "aliases", "",
+		"Path to the resource_aliases.json file generated by export of another workspace. "+
+			"Equivalent objects will get the same resource names as in that export.")
+	flags.StringVar(&ic.existingStateFile, "existing-state", "",
+		"Path to the Terraform state file that already manages some of the exported objects. Such objects "+
+			"aren't added to import.sh, and the state-ops.sh script is generated to move them into the new state.")
+	var filters string
+	flags.StringVar(&filters, "filter", "",
+		"Comma-separated list of regular expressions per service, matched against names of objects during listing, "+
+			"i.e. jobs=prod-.*,dlt=bronze. Complements -match, that applies to all services. Only services, whose "+
+			"objects are listed by name, are supported.")
+	var owners string
+	flags.StringVar(&owners, "owner", "",
+		"Comma-separated list of user names or application IDs of service principals. Only jobs, DLT pipelines, "+
+			"SQL queries and dashboards, and repos created by, owned by, or running as them are listed.")
+	var excludedServices string
+	flags.StringVar(&excludedServices, "exclude-services", "",
+		"Comma-separated list of services to exclude from -services and -listing. "+
+			"The same shorthands as for -services are supported.")
+	ic.excludeRegexes = map[string][]*regexp.Regexp{}
+	flags.Var(excludeRegexFlag(ic.excludeRegexes), "exclude-regex",
+		"Exclude objects of a resource type or a service, which ID, path or name matches a regular expression, "+
+			"in the <resource type or service>=<regex> form, i.e. databricks_notebook=^/Users/. Could be repeated.")
 	prefix := ""
 	flags.StringVar(&prefix, "prefix", "", "Prefix that will be added to the name of all exported resources")
 	newArgs := args
@@ -147,11 +431,48 @@ func Run(args ...string) error {
 	if len(prefix) > 0 {
 		ic.prefix = prefix + "_"
 	}
+	if ic.mountsNoCluster {
+		ic.mounts = true
+	}
+	if ic.outputFormat != "hcl" && ic.outputFormat != "json" {
+		return fmt.Errorf("unsupported format: %s, only hcl and json are supported", ic.outputFormat)
+	}
+	if ic.outputFormat == "json" && ic.incremental {
+		return fmt.Errorf("-incremental can't be used together with -format json")
+	}
+	if ic.runImports && ic.Module != "" {
+		return fmt.Errorf("-run-imports can't be used together with -module")
+	}
+	ic.Client.WithCommandExecutorConfig(commandsConfig)
 	if trace {
 		logLevel = append(logLevel, "[DEBUG]", "[TRACE]")
 	} else if debug {
 		logLevel = append(logLevel, "[DEBUG]")
 	}
+	configuredServices, err = ic.expandServices(configuredServices)
+	if err != nil {
+		return err
+	}
+	ic.listing, err = ic.expandServices(ic.listing)
+	if err != nil {
+		return err
+	}
+	ic.filters, err = ic.parseFilters(filters)
+	if err != nil {
+		return err
+	}
+	ic.owners = parseOwners(owners)
+	for key := range ic.excludeRegexes {
+		if _, ok := ic.Importables[key]; !ok && !ic.isKnownService(key) {
+			return fmt.Errorf("unknown resource type or service in -exclude-regex: %s", key)
+		}
+	}
+	excludedServices, err = ic.expandServices(excludedServices)
+	if err != nil {
+		return err
+	}
+	configuredServices = excludeServices(configuredServices, excludedServices)
+	ic.listing = excludeServices(ic.listing, excludedServices)
 	ic.enableServices(configuredServices)
 	return ic.Run()
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/databricks/databricks-sdk-go/config"
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type dummyReader string
@@ -47,3 +48,70 @@ func TestInteractivePrompts(t *testing.T) {
 	assert.Equal(t, "y", ic.match)
 	assert.True(t, ic.mounts)
 }
+
+func TestExpandServices(t *testing.T) {
+	ic := &importContext{
+		Importables: map[string]importable{
+			"a": {Service: "compute", WorkspaceLevel: true},
+			"b": {Service: "users", WorkspaceLevel: true, AccountLevel: true},
+			"c": {Service: "uc-metastores", AccountLevel: true},
+		},
+	}
+	services, err := ic.expandServices("all-workspace")
+	assert.NoError(t, err)
+	assert.Equal(t, "compute,users", services)
+
+	services, err = ic.expandServices("all-account, compute")
+	assert.NoError(t, err)
+	assert.Equal(t, "uc-metastores,users,compute", services)
+
+	services, err = ic.expandServices("users,users,")
+	assert.NoError(t, err)
+	assert.Equal(t, "users", services)
+
+	_, err = ic.expandServices("users,jobz,cluster")
+	assert.EqualError(t, err, "unknown services: jobz, cluster. Valid services are: "+
+		"compute, uc-metastores, users, or all-workspace, all-account shorthands")
+}
+
+func TestExcludeServices(t *testing.T) {
+	assert.Equal(t, "compute,users", excludeServices("compute,users", ""))
+	assert.Equal(t, "compute", excludeServices("compute, notebooks,users", "users,notebooks"))
+}
+
+func TestParseFilters(t *testing.T) {
+	ic := importContextForTest()
+	filters, err := ic.parseFilters("jobs=prod-.*, dlt=^a{1,3}$")
+	require.NoError(t, err)
+	assert.Len(t, filters, 2)
+	assert.Equal(t, "prod-.*", filters["jobs"].String())
+	assert.Equal(t, "^a{1,3}$", filters["dlt"].String())
+
+	filters, err = ic.parseFilters("")
+	require.NoError(t, err)
+	assert.Len(t, filters, 0)
+
+	_, err = ic.parseFilters("prod-.*")
+	assert.EqualError(t, err, "expected <service>=<regex> in -filter, got prod-.*")
+	_, err = ic.parseFilters("jobs=a,unknown=b")
+	assert.EqualError(t, err, "unknown service in -filter: unknown")
+	_, err = ic.parseFilters("jobs=a,repos=b")
+	assert.EqualError(t, err, "-filter isn't supported for service repos, as its objects aren't listed by name")
+	_, err = ic.parseFilters("jobs=tmp_(")
+	assert.ErrorContains(t, err, "invalid regular expression for jobs in -filter")
+}
+
+func TestParseOwners(t *testing.T) {
+	assert.Equal(t, map[string]struct{}{}, parseOwners(""))
+	assert.Equal(t, map[string]struct{}{"a@b.com": {}, "c@d.com": {}}, parseOwners("A@b.com, c@d.com,"))
+}
+
+func TestExcludeRegexFlag(t *testing.T) {
+	f := excludeRegexFlag{}
+	assert.NoError(t, f.Set("databricks_notebook=^/Users/"))
+	assert.NoError(t, f.Set("jobs=^tmp_"))
+	assert.NoError(t, f.Set("databricks_notebook=/Shared/Scratch/"))
+	assert.Equal(t, "databricks_notebook=/Shared/Scratch/,databricks_notebook=^/Users/,jobs=^tmp_", f.String())
+	assert.EqualError(t, f.Set("^/Users/"), "expected <resource type or service>=<regex>, got ^/Users/")
+	assert.ErrorContains(t, f.Set("jobs=tmp_("), "invalid regular expression for jobs")
+}
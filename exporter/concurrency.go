@@ -0,0 +1,162 @@
+package exporter
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"sync"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+// number of successful API calls after which the concurrency limit is increased by one
+const adaptiveIncreaseAfter = 20
+
+// adaptiveLimiter controls how many resources of the same type are imported concurrently.
+// The limit is halved when the API starts throttling requests or failing with 5xx errors,
+// and slowly grows back up to the configured number of goroutines when calls succeed.
+type adaptiveLimiter struct {
+	mu   sync.Mutex
+	cond *sync.Cond
+
+	configured int
+	limit      int
+	minLimit   int
+	active     int
+
+	// responses observed since the last change of the limit
+	successes     int
+	sinceDecrease int
+
+	requests  int
+	throttled int
+}
+
+func newAdaptiveLimiter(configured int) *adaptiveLimiter {
+	if configured < 1 {
+		configured = 1
+	}
+	l := &adaptiveLimiter{
+		configured:    configured,
+		limit:         configured,
+		minLimit:      configured,
+		sinceDecrease: configured,
+	}
+	l.cond = sync.NewCond(&l.mu)
+	return l
+}
+
+// Acquire blocks until the number of active imports is below the current limit
+func (l *adaptiveLimiter) Acquire() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for l.active >= l.limit {
+		l.cond.Wait()
+	}
+	l.active++
+}
+
+func (l *adaptiveLimiter) Release() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.active--
+	l.cond.Signal()
+}
+
+// Observe adjusts the limit based on HTTP status code of the API response
+func (l *adaptiveLimiter) Observe(statusCode int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.requests++
+	l.sinceDecrease++
+	if statusCode == http.StatusTooManyRequests || statusCode >= 500 {
+		l.throttled++
+		l.successes = 0
+		// responses of requests that were already in flight shouldn't decrease the limit again
+		if l.sinceDecrease < l.limit || l.limit == 1 {
+			return
+		}
+		l.limit = l.limit / 2
+		if l.limit < 1 {
+			l.limit = 1
+		}
+		if l.limit < l.minLimit {
+			l.minLimit = l.limit
+		}
+		l.sinceDecrease = 0
+		return
+	}
+	l.successes++
+	if l.successes >= adaptiveIncreaseAfter && l.limit < l.configured {
+		l.limit++
+		l.successes = 0
+		l.cond.Broadcast()
+	}
+}
+
+// Stats returns the concurrency chosen for the resource type, to be reported in the stats file
+func (l *adaptiveLimiter) Stats() map[string]int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return map[string]int{
+		"configured": l.configured,
+		"final":      l.limit,
+		"min":        l.minLimit,
+		"requests":   l.requests,
+		"throttled":  l.throttled,
+	}
+}
+
+// limiterFor returns the limiter of the channel that handles given resource type
+func (ic *importContext) limiterFor(resourceType string) *adaptiveLimiter {
+	if l, ok := ic.limiters[resourceType]; ok {
+		return l
+	}
+	return ic.limiters["default"]
+}
+
+// observeResponse feeds responses for resource reads to the corresponding limiter
+func (ic *importContext) observeResponse(ctx context.Context, statusCode int) {
+	ic.apiCalls.Add(1)
+	resource, ok := ctx.Value(common.ResourceName).(string)
+	if !ok || resource == "exporter" {
+		return
+	}
+	if l := ic.limiterFor("databricks_" + resource); l != nil {
+		l.Observe(statusCode)
+	}
+}
+
+func (ic *importContext) concurrencyStats() map[string]map[string]int {
+	stats := map[string]map[string]int{}
+	for resourceType, l := range ic.limiters {
+		stats[resourceType] = l.Stats()
+	}
+	return stats
+}
+
+// observingTransport reports status codes of all API responses, including ones retried by the SDK
+type observingTransport struct {
+	inner   *http.Transport
+	observe func(ctx context.Context, statusCode int)
+}
+
+func newObservingTransport() *observingTransport {
+	return &observingTransport{
+		inner: http.DefaultTransport.(*http.Transport).Clone(),
+	}
+}
+
+func (t *observingTransport) setInsecureSkipVerify(insecure bool) {
+	t.inner.TLSClientConfig = &tls.Config{
+		InsecureSkipVerify: insecure,
+	}
+}
+
+func (t *observingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	resp, err := t.inner.RoundTrip(r)
+	if err == nil && t.observe != nil {
+		t.observe(r.Context(), resp.StatusCode)
+	}
+	return resp, err
+}
@@ -0,0 +1,81 @@
+package exporter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdaptiveLimiterDecreasesOnThrottling(t *testing.T) {
+	l := newAdaptiveLimiter(8)
+	for i := 0; i < 8; i++ {
+		l.Observe(200)
+	}
+	l.Observe(429)
+	assert.Equal(t, 4, l.limit)
+	// responses of requests, that were in flight, don't decrease the limit further
+	l.Observe(429)
+	l.Observe(503)
+	assert.Equal(t, 4, l.limit)
+	l.Observe(200)
+	l.Observe(429)
+	assert.Equal(t, 2, l.limit)
+	assert.Equal(t, map[string]int{
+		"configured": 8,
+		"final":      2,
+		"min":        2,
+		"requests":   13,
+		"throttled":  4,
+	}, l.Stats())
+}
+
+func TestAdaptiveLimiterIncreasesOnSuccess(t *testing.T) {
+	l := newAdaptiveLimiter(2)
+	l.Observe(429)
+	assert.Equal(t, 1, l.limit)
+	for i := 0; i < adaptiveIncreaseAfter; i++ {
+		l.Observe(200)
+	}
+	assert.Equal(t, 2, l.limit)
+	for i := 0; i < adaptiveIncreaseAfter; i++ {
+		l.Observe(404)
+	}
+	assert.Equal(t, 2, l.limit, "limit never exceeds configured number of goroutines")
+	assert.Equal(t, 1, l.Stats()["min"])
+}
+
+func TestAdaptiveLimiterAcquireWaitsForRelease(t *testing.T) {
+	l := newAdaptiveLimiter(1)
+	l.Acquire()
+	acquired := make(chan struct{})
+	go func() {
+		l.Acquire()
+		close(acquired)
+	}()
+	select {
+	case <-acquired:
+		t.Fatal("limit is exceeded")
+	case <-time.After(50 * time.Millisecond):
+	}
+	l.Release()
+	<-acquired
+}
+
+func TestObserveResponseRoutesToChannelLimiter(t *testing.T) {
+	ic := importContextForTest()
+	ic.limiters = map[string]*adaptiveLimiter{
+		"databricks_user": newAdaptiveLimiter(1),
+		"default":         newAdaptiveLimiter(4),
+	}
+	ic.observeResponse(context.WithValue(context.Background(), common.ResourceName, "user"), 200)
+	ic.observeResponse(context.WithValue(context.Background(), common.ResourceName, "notebook"), 429)
+	ic.observeResponse(context.WithValue(context.Background(), common.ResourceName, "exporter"), 429)
+	ic.observeResponse(context.Background(), 429)
+	assert.Equal(t, 1, ic.limiters["databricks_user"].requests)
+	assert.Equal(t, 1, ic.limiters["default"].requests)
+	assert.Equal(t, 1, ic.limiters["default"].throttled)
+	assert.Contains(t, ic.concurrencyStats(), "databricks_user")
+}
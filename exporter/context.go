@@ -3,19 +3,18 @@ package exporter
 import (
 	"bufio"
 	"context"
-	"crypto/md5"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
 	"os"
-	"os/exec"
 	"reflect"
 	"regexp"
 	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/databricks/databricks-sdk-go"
@@ -72,6 +71,12 @@ type importContext struct {
 	variables         map[string]string
 	workspaceConfKeys map[string]any
 
+	// names of variables with values of secrets, that are generated with `sensitive = true`
+	sensitiveVariables map[string]struct{}
+	// secret scopes of variables, so tfvars templates group variables by scopes
+	variableScopes map[string]string
+	variablesMutex sync.Mutex
+
 	workspaceClient *databricks.WorkspaceClient
 	accountClient   *databricks.AccountClient
 
@@ -86,7 +91,9 @@ type importContext struct {
 	// command-line resources (immutable, or set by the single thread)
 	includeUserDomains       bool
 	importAllUsers           bool
+	groupsFilter             string
 	exportDeletedUsersAssets bool
+	includeDefaultConf       bool
 	incremental              bool
 	mounts                   bool
 	noFormat                 bool
@@ -101,13 +108,46 @@ type importContext struct {
 	accountLevel             bool
 	shImports                map[string]bool
 	notebooksFormat          string
+	notebooksLayout          string
+	naming                   string
 	updatedSinceStr          string
 	updatedSinceMs           int64
+	aliasesFile              string
+	exportRepoContent        bool
+	exportVolumeContent      bool
+	exportTokens             bool
+	generateReadme           bool
+	nativeImport             bool
+	outputFormat             string
+	separateEntitlements     bool
+	includeSystemObjects     bool
+	includeBundleManaged     bool
+	emitDataSources          bool
+	separateAcls             bool
+	tfvarsSecretAcls         bool
+	existingStateFile        string
+	progressInterval         time.Duration
+	secretStoreStubs         bool
+	runImports               bool
+	dryRun                   bool
+	importParallelism        int
+	progress                 *exportProgress
+	// number of API responses, reported by -dry-run
+	apiCalls  atomic.Int64
+	terraform terraformRunner
+	// regular expressions from `-filter` (service -> expression)
+	filters map[string]*regexp.Regexp
+	// lower-cased user names or application IDs from `-owner`
+	owners map[string]struct{}
+	// regular expressions from `-exclude-regex` (resource type or service -> expressions)
+	excludeRegexes map[string][]*regexp.Regexp
 
 	waitGroup *sync.WaitGroup
 
 	// TODO: protect by mutex?
 	mountMap map[string]mount
+	// discover mounts through the DBFS API and Terraform state instead of a cluster
+	mountsNoCluster bool
 
 	//
 	testEmits      map[string]bool
@@ -116,6 +156,11 @@ type importContext struct {
 	//
 	allGroups   []scim.Group
 	groupsMutex sync.Mutex
+	// members of groups (group ID -> set of member IDs) that are generated together
+	groupMembers      map[string]map[string]struct{}
+	groupMembersMutex sync.Mutex
+	// IDs of users & service principals that are (transitive) members of groups from `-groups-filter`
+	groupsFilterMembers map[string]struct{}
 
 	//
 	allUsers        map[string]scim.User
@@ -128,6 +173,10 @@ type importContext struct {
 	allSpsMapping map[string]string // maps application_id -> internal ID
 	spsMutex      sync.RWMutex
 
+	// lower-cased user names & application IDs of deactivated users & service principals
+	inactiveUsersOrSps      map[string]struct{}
+	inactiveUsersOrSpsMutex sync.RWMutex
+
 	//
 	importing      map[string]bool
 	importingMutex sync.RWMutex
@@ -149,7 +198,21 @@ type importContext struct {
 
 	// tracking ignored objects
 	ignoredResourcesMutex sync.Mutex
-	ignoredResources      map[string]struct{}
+	ignoredResources      map[string]ignoredResource
+
+	// tracking objects deployed by Databricks Asset Bundles
+	bundleManagedMutex sync.Mutex
+	bundleManaged      map[string]ignoredResource
+
+	// addresses of resources in the state passed with `-existing-state` (resource type/ID -> address)
+	existingResources map[string]string
+	// commands that move resources from the existing state into the export
+	stateOpsMutex sync.Mutex
+	stateOps      []string
+
+	// generated resources by their addresses, written into mapping.json
+	mappingsMutex sync.Mutex
+	mappings      map[string]addressMapping
 
 	// emitting of users/SPs
 	emittedUsers      map[string]struct{}
@@ -158,6 +221,12 @@ type importContext struct {
 	//
 	userOrSpDirectories      map[string]bool
 	userOrSpDirectoriesMutex sync.RWMutex
+
+	// resource names loaded from the aliases file of another export
+	aliases *aliasesHolder
+
+	// adaptive concurrency limits per resource channel
+	limiters map[string]*adaptiveLimiter
 }
 
 type mount struct {
@@ -179,9 +248,10 @@ var simpleNameFixes = []regexFix{
 	{nameNormalizationRegex, "_"},
 }
 
+// keys of workspace configuration that are exported, with their documented default values
 var workspaceConfKeys = map[string]any{
 	"enableIpAccessLists":                              false,
-	"enableTokensConfig":                               false,
+	"enableTokensConfig":                               true,
 	"maxTokenLifetimeDays":                             0,
 	"maxUserInactiveDays":                              0,
 	"storeInteractiveNotebookResultsInCustomerAccount": false,
@@ -249,20 +319,27 @@ func newImportContext(c *common.DatabricksClient) *importContext {
 		nameFixes:                nameFixes,
 		hclFixes:                 []regexFix{}, // Be careful with that! it may break working code
 		variables:                map[string]string{},
+		sensitiveVariables:       map[string]struct{}{},
 		allDirectories:           []workspace.ObjectStatus{},
 		allWorkspaceObjects:      []workspace.ObjectStatus{},
 		workspaceConfKeys:        workspaceConfKeys,
 		shImports:                map[string]bool{},
 		notebooksFormat:          "SOURCE",
+		notebooksLayout:          notebooksLayoutFlat,
+		naming:                   namingName,
 		allUsers:                 map[string]scim.User{},
 		allSps:                   map[string]scim.User{},
 		waitGroup:                &sync.WaitGroup{},
 		channels:                 makeResourcesChannels(),
 		defaultHanlerChannelSize: defaultHanlerChannelSize,
 		defaultChannel:           make(resourceChannel, defaultHanlerChannelSize),
-		ignoredResources:         map[string]struct{}{},
+		ignoredResources:         map[string]ignoredResource{},
+		bundleManaged:            map[string]ignoredResource{},
+		mappings:                 map[string]addressMapping{},
 		emittedUsers:             map[string]struct{}{},
 		userOrSpDirectories:      map[string]bool{},
+		importParallelism:        getEnvAsInt(envVarImportParallelism, defaultImportParallelism),
+		terraform:                runTerraform,
 	}
 }
 
@@ -310,6 +387,19 @@ func (ic *importContext) Run() error {
 		ic.updatedSinceMs = tm.UnixMilli()
 	}
 
+	if ic.aliasesFile != "" {
+		if err := ic.loadAliases(ic.aliasesFile); err != nil {
+			return err
+		}
+	}
+	if ic.existingStateFile != "" {
+		existing, err := loadExistingState(ic.existingStateFile)
+		if err != nil {
+			return err
+		}
+		ic.existingResources = existing
+	}
+
 	log.Printf("[INFO] Importing %s module into %s directory Databricks resources of %s services",
 		ic.Module, ic.Directory, maps.Keys(ic.services))
 
@@ -318,9 +408,19 @@ func (ic *importContext) Run() error {
 	if !supportedFormat && ic.notebooksFormat != "SOURCE" {
 		return fmt.Errorf("unsupported notebook format: '%s'", ic.notebooksFormat)
 	}
+	if ic.notebooksLayout != notebooksLayoutFlat && ic.notebooksLayout != notebooksLayoutWorkspace {
+		return fmt.Errorf("unsupported notebooks layout: '%s', only %s and %s are supported",
+			ic.notebooksLayout, notebooksLayoutFlat, notebooksLayoutWorkspace)
+	}
+	if _, ok := namingStrategies[ic.naming]; !ok {
+		return fmt.Errorf("unsupported naming strategy: '%s', only %s, %s, %s and %s are supported",
+			ic.naming, namingName, namingID, namingNameIDHash, namingSlug)
+	}
 
 	info, err := os.Stat(ic.Directory)
-	if os.IsNotExist(err) {
+	if ic.dryRun {
+		log.Print("[INFO] Dry run: only listing is performed, and no files are written")
+	} else if os.IsNotExist(err) {
 		err = os.MkdirAll(ic.Directory, 0755)
 		if err != nil {
 			return fmt.Errorf("can't create directory %s", ic.Directory)
@@ -358,6 +458,9 @@ func (ic *importContext) Run() error {
 			log.Printf("[WARN] can't get current UC metastore: %v", err)
 		}
 	}
+	ic.progress = newExportProgress(startTime)
+	stopProgress := ic.progress.report(os.Stderr, ic.progressInterval)
+	defer stopProgress()
 	// Concurrent execution part
 	if ic.waitGroup == nil {
 		ic.waitGroup = &sync.WaitGroup{}
@@ -372,7 +475,7 @@ func (ic *importContext) Run() error {
 		if ir.List == nil {
 			continue
 		}
-		if !strings.Contains(ic.listing, ir.Service) {
+		if !ic.isServiceInListing(ir.Service) {
 			log.Printf("[DEBUG] %s (%s service) is not part of listing", resourceName, ir.Service)
 			continue
 		}
@@ -388,6 +491,8 @@ func (ic *importContext) Run() error {
 		go func() {
 			if err := ir.List(ic); err != nil {
 				log.Printf("[ERROR] %s (%s service) listing failed: %s", resourceName, ir.Service, err)
+				ic.addIgnoredResource(ignoredResource{Resource: resourceName, Attribute: "service",
+					Value: ir.Service, Reason: ignoreReasonForError(err), Message: err.Error()})
 			}
 			log.Printf("[DEBUG] Finished listing for service %s", resourceName)
 			ic.waitGroup.Done()
@@ -397,35 +502,53 @@ func (ic *importContext) Run() error {
 	ic.waitGroup.Wait()
 	// close channels
 	ic.closeImportChannels()
+	if ic.dryRun {
+		ic.progress.writeInventory(os.Stdout, ic.apiCalls.Load(), ic.servicesDiscoveredOnImport())
+		return nil
+	}
 
 	// This should be single threaded...
 	if ic.Scope.Len() == 0 {
 		return fmt.Errorf("no resources to import")
 	}
 	shFileName := fmt.Sprintf("%s/import.sh", ic.Directory)
+	if ic.nativeImport {
+		shFileName = fmt.Sprintf("%s/imports.tf", ic.Directory)
+	}
 	if ic.incremental {
-		shFile, err := os.Open(shFileName)
-		if err == nil {
-			defer shFile.Close()
-			fileScanner := bufio.NewScanner(shFile)
-			fileScanner.Split(bufio.ScanLines)
-			for fileScanner.Scan() {
-				line := fileScanner.Text()
-				if strings.HasPrefix(line, "terraform import ") {
-					ic.shImports[strings.TrimRight(line, "\n")] = true
+		if ic.nativeImport {
+			ic.loadImportBlocks(shFileName)
+		} else {
+			shFile, err := os.Open(shFileName)
+			if err == nil {
+				defer shFile.Close()
+				fileScanner := bufio.NewScanner(shFile)
+				fileScanner.Split(bufio.ScanLines)
+				for fileScanner.Scan() {
+					line := fileScanner.Text()
+					if strings.HasPrefix(line, "terraform import ") {
+						ic.shImports[strings.TrimRight(line, "\n")] = true
+					}
 				}
+			} else {
+				log.Printf("[ERROR] opening %s: %v", shFileName, err)
 			}
-		} else {
-			log.Printf("[ERROR] opening %s: %v", shFileName, err)
 		}
 	}
-	sh, err := os.OpenFile(shFileName, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0755)
+	var sh *os.File
+	if ic.nativeImport {
+		sh, err = os.Create(shFileName)
+	} else {
+		sh, err = os.OpenFile(shFileName, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0755)
+	}
 	if err != nil {
 		return err
 	}
 	defer sh.Close()
-	// nolint
-	sh.WriteString("#!/bin/sh\n\nset -e\n\n")
+	if !ic.nativeImport {
+		// nolint
+		sh.WriteString("#!/bin/sh\n\nset -e\n\n")
+	}
 
 	if ic.generateDeclaration {
 		dcfile, err := os.Create(fmt.Sprintf("%s/databricks.tf", ic.Directory))
@@ -459,6 +582,22 @@ func (ic *importContext) Run() error {
 	if err != nil {
 		return err
 	}
+	err = ic.generateTfvars()
+	if err != nil {
+		return err
+	}
+	err = ic.generateAliases()
+	if err != nil {
+		return err
+	}
+	err = ic.writeMappings()
+	if err != nil {
+		return err
+	}
+	err = ic.writeDependencyGraph()
+	if err != nil {
+		return err
+	}
 
 	//
 	if stats, err := os.Create(statsFileName); err == nil {
@@ -467,6 +606,7 @@ func (ic *importContext) Run() error {
 			"startTime":       startTime.UTC().Format(time.RFC3339),
 			"duration":        fmt.Sprintf("%f sec", time.Since(startTime).Seconds()),
 			"exportedObjects": ic.Scope.Len(),
+			"concurrency":     ic.concurrencyStats(),
 		}
 		statsBytes, _ := json.Marshal(statsData)
 		if _, err = stats.Write(statsBytes); err != nil {
@@ -474,43 +614,59 @@ func (ic *importContext) Run() error {
 		}
 	}
 
-	// output ignored resources...
-	if ignored, err := os.Create(fmt.Sprintf("%s/ignored_resources.txt", ic.Directory)); err == nil {
-		defer ignored.Close()
-		ic.ignoredResourcesMutex.Lock()
-		keys := maps.Keys(ic.ignoredResources)
-		sort.Strings(keys)
-		for _, s := range keys {
-			ignored.WriteString(s + "\n")
+	if ic.generateReadme {
+		if err = ic.writeReadme(startTime, time.Since(startTime)); err != nil {
+			log.Printf("[ERROR] can't write README.md: %v", err)
 		}
 	}
 
-	if !ic.noFormat {
-		// format generated source code
-		cmd := exec.CommandContext(context.Background(), "terraform", "fmt")
-		cmd.Dir = ic.Directory
-		err = cmd.Run()
-		if err != nil {
+	// output ignored resources...
+	if err = ic.writeIgnoredResources(); err != nil {
+		log.Printf("[ERROR] can't write ignored resources: %v", err)
+	}
+	if err = ic.writeBundleManagedResources(); err != nil {
+		log.Printf("[ERROR] can't write bundle-managed resources: %v", err)
+	}
+	if err = ic.writeStateOps(); err != nil {
+		return err
+	}
+
+	if ic.outputFormat == "json" {
+		if err = ic.convertFilesToJSON(); err != nil {
+			return err
+		}
+	} else if !ic.noFormat {
+		if err = ic.formatGeneratedFiles(); err != nil {
 			log.Printf("[ERROR] problems when formatting the generated code: %v", err)
 			return err
 		}
 	}
+	if ic.runImports {
+		if err = ic.executeImports(); err != nil {
+			return err
+		}
+	}
 	log.Printf("[INFO] Done. Please edit the files and roll out new environment.")
 	return nil
 }
 
 func (ic *importContext) resourceHandler(num int, resourceType string, ch resourceChannel) {
 	log.Printf("[DEBUG] Starting goroutine %d for resource %s", num, resourceType)
+	limiter := ic.limiters[resourceType]
 	for r := range ch {
 		log.Printf("[DEBUG] channel for %s, channel size=%d got %v", resourceType, len(ch), r)
 		if r != nil {
+			limiter.Acquire()
 			r.ImportResource(ic)
+			limiter.Release()
+			ic.progress.objectImported(ic.Importables[r.Resource].Service)
 			log.Printf("[DEBUG] Finished importing %s, %v", resourceType, r)
 		}
 	}
 }
 
 func (ic *importContext) startImportChannels() {
+	ic.limiters = make(map[string]*adaptiveLimiter, len(ic.channels)+1)
 	for rt, c := range ic.channels {
 		ch := c
 		resourceType := rt
@@ -519,6 +675,7 @@ func (ic *importContext) startImportChannels() {
 			numRoutines = defaultNumRoutines
 		}
 		numRoutines = getEnvAsInt(envVariablePrefix+resourceType, numRoutines)
+		ic.limiters[resourceType] = newAdaptiveLimiter(numRoutines)
 
 		for i := 0; i < numRoutines; i++ {
 			num := i
@@ -529,6 +686,7 @@ func (ic *importContext) startImportChannels() {
 	}
 
 	numRoutines := getEnvAsInt(envVariablePrefix+"default", 15)
+	ic.limiters["default"] = newAdaptiveLimiter(numRoutines)
 	for i := 0; i < numRoutines; i++ {
 		num := i
 		go func() {
@@ -546,6 +704,12 @@ func (ic *importContext) closeImportChannels() {
 }
 
 func generateBlockFullName(block *hclwrite.Block) string {
+	if block.Type() == "locals" {
+		// locals don't have labels, so they are identified by names of their attributes
+		attributes := maps.Keys(block.Body().Attributes())
+		sort.Strings(attributes)
+		return block.Type() + "_" + strings.Join(attributes, "_")
+	}
 	return block.Type() + "_" + strings.Join(block.Labels(), "_")
 }
 
@@ -594,9 +758,15 @@ func (ic *importContext) handleResourceWrite(generatedFile string, ch dataWriteC
 			_, err = tf.WriteString(f.ResourceBody)
 			if err == nil {
 				newResources[f.BlockName] = struct{}{}
-				if f.ImportCommand != "" {
+				if f.ImportCommand != "" && ic.nativeImport {
+					// import blocks are split by the writer
 					ic.waitGroup.Add(1)
 					importChan <- f.ImportCommand
+				} else if f.ImportCommand != "" {
+					for _, importCommand := range strings.Split(f.ImportCommand, "\n") {
+						ic.waitGroup.Add(1)
+						importChan <- importCommand
+					}
 				}
 				log.Printf("[DEBUG] finished writing resource body for %s", f.BlockName)
 			} else {
@@ -638,7 +808,13 @@ func (ic *importContext) handleResourceWrite(generatedFile string, ch dataWriteC
 
 func (ic *importContext) writeImports(sh *os.File, importChan importWriteChannel) {
 	for importCommand := range importChan {
-		if importCommand != "" && sh != nil {
+		if importCommand != "" && sh != nil && ic.nativeImport {
+			for _, block := range parseImportBlocks(importCommand) {
+				log.Printf("[DEBUG] writing import block %s", block)
+				sh.WriteString(block + "\n")
+				delete(ic.shImports, block)
+			}
+		} else if importCommand != "" && sh != nil {
 			log.Printf("[DEBUG] writing import command %s", importCommand)
 			sh.WriteString(importCommand + "\n")
 			delete(ic.shImports, importCommand)
@@ -667,17 +843,24 @@ func (ic *importContext) processSingleResource(resourcesChan resourceChannel, wr
 			continue
 		}
 		ir := ic.Importables[r.Resource]
-		if ir.Ignore != nil && ir.Ignore(ic, r) {
+		if !r.External && ir.Ignore != nil && ir.Ignore(ic, r) {
 			log.Printf("[WARN] Ignoring resource %s: %s", r.Resource, r.Name)
 			ignored = ignored + 1
 			ic.waitGroup.Done()
 			continue
 		}
+		if r.Resource == "databricks_group_member" && ic.groupMembers != nil {
+			ic.collectGroupMember(r)
+			ic.waitGroup.Done()
+			continue
+		}
 		var err error
 		f := hclwrite.NewEmptyFile()
 		log.Printf("[TRACE] Generating %s: %s", r.Resource, r.Name)
 		body := f.Body()
-		if ir.Body != nil {
+		if r.External {
+			generateDataSourceBody(ir, body, r)
+		} else if ir.Body != nil {
 			err = ir.Body(ic, body, r)
 			if err != nil {
 				log.Printf("[ERROR] error calling ir.Body for %v: %s", r, err.Error())
@@ -698,18 +881,22 @@ func (ic *importContext) processSingleResource(resourcesChan resourceChannel, wr
 				ResourceBody: string(formatted),
 				BlockName:    generateBlockFullName(body.Blocks()[0]),
 			}
-			if r.Mode != "data" && ic.Resources[r.Resource].Importer != nil {
-				writeData.ImportCommand = r.ImportCommand(ic)
+			writeData.ImportCommand = ic.generatedImportCommand(r, ir)
+			service := ic.resourceService(ir, r)
+			if ic.separateAcls && aclResourceTypes[r.Resource] {
+				service = aclsFilePrefix + aclService(r.ID, service)
 			}
-			ch, exists := writerChannels[ir.Service]
+			ch, exists := writerChannels[service]
 			if exists {
+				ic.addMapping(r, ic.generatedFileName(service))
 				ic.waitGroup.Add(1)
 				ch <- writeData
 			} else {
-				log.Printf("[WARN] can't find a channel for service: %s, resource: %s", ir.Service, r.Resource)
+				log.Printf("[WARN] can't find a channel for service: %s, resource: %s", service, r.Resource)
 			}
 			log.Printf("[TRACE] Finished generating %s: %s", r.Resource, r.Name)
 			generated = generated + 1
+			ic.progress.objectGenerated()
 		} else {
 			log.Printf("[WARN] error generating resource body: %v, or body blocks len is 0", err)
 		}
@@ -718,11 +905,26 @@ func (ic *importContext) processSingleResource(resourcesChan resourceChannel, wr
 	log.Printf("[DEBUG] processed resources: %d, generated: %d, ignored: %d", processed, generated, ignored)
 }
 
+// generatedImportCommand returns the command to import the generated resource, or records state operations
+// instead when the resource is already managed in the existing state
+func (ic *importContext) generatedImportCommand(r *resource, ir importable) string {
+	if r.Mode == "data" || ir.CreateOnly || ic.Resources[r.Resource].Importer == nil {
+		return ""
+	}
+	if address, ok := ic.existingResources[r.Resource+"/"+r.ID]; ok {
+		// importing it as is would make two states manage the same object
+		ic.addStateOp(r, address)
+		return ""
+	}
+	return r.ImportCommand(ic)
+}
+
 func (ic *importContext) generateAndWriteResources(sh *os.File) {
 	resources := ic.Scope.Sorted()
 	scopeSize := ic.Scope.Len()
 	t1 := time.Now()
 	log.Printf("[INFO] Generating configuration for %d resources", scopeSize)
+	ic.progress.startGeneration(t1, scopeSize)
 
 	// make configurable via environment variables
 	resourceHandlersNumber := getEnvAsInt("EXPORTER_RESOURCE_GENERATORS", 50)
@@ -731,8 +933,12 @@ func (ic *importContext) generateAndWriteResources(sh *os.File) {
 	resourceWriters := make(map[string]dataWriteChannel, len(ic.Resources))
 	for _, imp := range ic.Importables {
 		resourceWriters[imp.Service] = make(dataWriteChannel, defaultChannelSize)
+		if ic.separateAcls {
+			resourceWriters[aclsFilePrefix+imp.Service] = make(dataWriteChannel, defaultChannelSize)
+		}
 	}
 	importChan := make(importWriteChannel, defaultChannelSize)
+	ic.groupMembers = map[string]map[string]struct{}{}
 	//
 	go func() {
 		ic.writeImports(sh, importChan)
@@ -763,6 +969,8 @@ func (ic *importContext) generateAndWriteResources(sh *os.File) {
 		}
 	}
 	ic.waitGroup.Wait()
+	ic.generateGroupMembers(resourceWriters)
+	ic.waitGroup.Wait()
 	// close all channels
 	close(importChan)
 	close(resourcesChan)
@@ -816,6 +1024,9 @@ func (ic *importContext) generateVariables() error {
 	for k, v := range ic.variables {
 		b := body.AppendNewBlock("variable", []string{k}).Body()
 		b.SetAttributeValue("description", cty.StringVal(v))
+		if _, ok := ic.sensitiveVariables[k]; ok {
+			b.SetAttributeValue("sensitive", cty.True)
+		}
 	}
 	// nolint
 	vf.Write(f.Bytes())
@@ -823,18 +1034,41 @@ func (ic *importContext) generateVariables() error {
 	return nil
 }
 
-func (ic *importContext) MatchesName(n string) bool {
-	if ic.match == "" {
+// MatchesName checks the name of the listed object of the given resource type against `-match` and against
+// the expression of the resource's service from `-filter`
+func (ic *importContext) MatchesName(resourceType, n string) bool {
+	if ic.match != "" && !strings.Contains(strings.ToLower(n), strings.ToLower(ic.match)) {
+		return false
+	}
+	if re, ok := ic.filters[ic.Importables[resourceType].Service]; ok && !re.MatchString(n) {
+		return false
+	}
+	return true
+}
+
+// MatchesOwner returns true if `-owner` isn't specified, or if any of the given creators or owners of the object
+// is in the `-owner` list
+func (ic *importContext) MatchesOwner(owners ...string) bool {
+	if len(ic.owners) == 0 {
 		return true
 	}
-	return strings.Contains(strings.ToLower(n), strings.ToLower(ic.match))
+	for _, owner := range owners {
+		if _, ok := ic.owners[strings.ToLower(owner)]; ok {
+			return true
+		}
+	}
+	return false
 }
 
 func genTraversalTokens(sr *resourceApproximation, pick string) hcl.Traversal {
 	if sr.Mode == "data" {
+		dataType := sr.Type
+		if sr.DataSourceType != "" {
+			dataType = sr.DataSourceType
+		}
 		return hcl.Traversal{
 			hcl.TraverseRoot{Name: "data"},
-			hcl.TraverseAttr{Name: sr.Type},
+			hcl.TraverseAttr{Name: dataType},
 			hcl.TraverseAttr{Name: sr.Name},
 			hcl.TraverseAttr{Name: pick},
 		}
@@ -961,13 +1195,17 @@ func (ic *importContext) Add(r *resource) {
 		r.Mode = "managed"
 	}
 	inst.Attributes["id"] = r.ID
-	ic.State.Append(resourceApproximation{
+	ra := resourceApproximation{
 		Mode:      r.Mode,
 		Module:    ic.Module,
 		Type:      r.Resource,
 		Name:      r.Name,
 		Instances: []instanceApproximation{inst},
-	})
+	}
+	if r.External {
+		ra.DataSourceType = ic.Importables[r.Resource].DataSource.typeOf(r.Resource)
+	}
+	ic.State.Append(ra)
 	// in single-threaded scenario scope is toposorted
 	ic.Scope.Append(r)
 }
@@ -980,25 +1218,14 @@ func (ic *importContext) regexFix(s string, fixes []regexFix) string {
 }
 
 func (ic *importContext) ResourceName(r *resource) string {
-	name := r.Name
-	if name == "" && ic.Importables[r.Resource].Name != nil {
-		name = ic.Importables[r.Resource].Name(ic, r.Data)
+	if name, ok := ic.aliasedName(r); ok {
+		return name
 	}
-	if name == "" {
-		name = r.ID
-	}
-	name = ic.prefix + name
-	origCaseName := name
-	name = strings.ToLower(name)
-	name = ic.regexFix(name, ic.nameFixes)
-	// this is either numeric id or all-non-ascii
-	if regexp.MustCompile(`^\d`).MatchString(name) || name == "" {
-		if name == "" {
-			origCaseName = r.ID
-		}
-		name = fmt.Sprintf("r%x", md5.Sum([]byte(origCaseName)))[0:12]
+	naming, ok := namingStrategies[ic.naming]
+	if !ok {
+		naming = namingStrategies[namingName]
 	}
-	return name
+	return naming(ic, r)
 }
 
 func (ic *importContext) isServiceEnabled(service string) bool {
@@ -1006,6 +1233,45 @@ func (ic *importContext) isServiceEnabled(service string) bool {
 	return exists
 }
 
+// servicesDiscoveredOnImport returns enabled services with resources that aren't listed, but are only emitted
+// while importing other resources, like UC schemas and tables of catalogs, so the dry run doesn't count them
+func (ic *importContext) servicesDiscoveredOnImport() []string {
+	services := map[string]struct{}{}
+	for _, ir := range ic.Importables {
+		if ir.List != nil || !ic.isServiceEnabled(ir.Service) {
+			continue
+		}
+		if (ic.accountLevel && !ir.AccountLevel) || (!ic.accountLevel && !ir.WorkspaceLevel) {
+			continue
+		}
+		services[ir.Service] = struct{}{}
+	}
+	result := maps.Keys(services)
+	sort.Strings(result)
+	return result
+}
+
+// isServiceInListing checks for the exact service name, as some services are prefixes
+// or suffixes of others, like `dashboards` and `sql-dashboards`
+func (ic *importContext) isServiceInListing(service string) bool {
+	for _, s := range strings.Split(ic.listing, ",") {
+		if strings.TrimSpace(s) == service {
+			return true
+		}
+	}
+	return false
+}
+
+// resourceService returns the logical (file) group for a given resource
+func (ic *importContext) resourceService(ir importable, r *resource) string {
+	if ir.ResourceService != nil {
+		if service := ir.ResourceService(ic, r); service != "" {
+			return service
+		}
+	}
+	return ir.Service
+}
+
 func (ic *importContext) Emit(r *resource) {
 	// TODO: change into channels, if stack trace depth issues would surface
 	_, v := r.MatchPair()
@@ -1018,9 +1284,14 @@ func (ic *importContext) Emit(r *resource) {
 		log.Printf("[ERROR] %s is not available for import", r)
 		return
 	}
-	if !ic.isServiceEnabled(ir.Service) {
-		log.Printf("[DEBUG] %s (%s service) is not part of the import", r.Resource, ir.Service)
-		return
+	service := ic.resourceService(ir, r)
+	if !ic.isServiceEnabled(service) {
+		if !ic.emitDataSources || ir.DataSource == nil {
+			log.Printf("[DEBUG] %s (%s service) is not part of the import", r.Resource, service)
+			return
+		}
+		log.Printf("[DEBUG] %s (%s service) is referenced with the data source", r.Resource, service)
+		r.External = true
 	}
 	if ic.Has(r) {
 		log.Printf("[DEBUG] %s already imported", r)
@@ -1047,6 +1318,11 @@ func (ic *importContext) Emit(r *resource) {
 	// TODO: add similar condition for checking workspace-level objects only. After new ACLs import is merged
 
 	// from here, it should be done by the goroutine...  send resource into the channel
+	ic.progress.objectFound(ir.Service)
+	if ic.dryRun {
+		// only listing is performed, so found objects are counted, but not imported
+		return
+	}
 	ch, exists := ic.channels[r.Resource]
 	if exists {
 		log.Printf("[TRACE] increasing counter & sending to the channel for resource %s", r.Resource)
@@ -1110,14 +1386,15 @@ func (ic *importContext) getTraversalTokens(ref reference, value string) hclwrit
 // TODO: move to IC
 var dependsRe = regexp.MustCompile(`(\.[\d]+)`)
 
-func (ic *importContext) reference(i importable, path []string, value string, ctyValue cty.Value) hclwrite.Tokens {
+func (ic *importContext) reference(i importable, path []string, d *schema.ResourceData,
+	value string, ctyValue cty.Value) hclwrite.Tokens {
 	match := dependsRe.ReplaceAllString(strings.Join(path, "."), "")
 	// TODO: get reference candidate, but if it's a `data`, then look for another non-data reference if possible..
-	for _, d := range i.Depends {
-		if d.Path != match {
+	for _, dep := range i.Depends {
+		if dep.Path != match {
 			continue
 		}
-		if d.File {
+		if dep.File {
 			relativeFile := fmt.Sprintf("${path.module}/%s", value)
 			return hclwrite.Tokens{
 				&hclwrite.Token{Type: hclsyntax.TokenOQuote, Bytes: []byte{'"'}},
@@ -1125,11 +1402,20 @@ func (ic *importContext) reference(i importable, path []string, value string, ct
 				&hclwrite.Token{Type: hclsyntax.TokenCQuote, Bytes: []byte{'"'}},
 			}
 		}
-		if d.Variable {
-			return ic.variable(fmt.Sprintf("%s_%s", path[0], value), "")
+		if dep.Variable {
+			return ic.sensitiveVariable(i.variableName(dep, value), "")
 		}
 
-		if tokens := ic.getTraversalTokens(d, value); tokens != nil {
+		if dep.ResourceID != nil {
+			if d == nil {
+				continue
+			}
+			if sr := ic.State.Get(dep.Resource, "id", dep.ResourceID(d, value)); sr != nil {
+				return hclwrite.TokensForTraversal(genTraversalTokens(sr, dep.MatchAttribute()))
+			}
+			continue
+		}
+		if tokens := ic.getTraversalTokens(dep, value); tokens != nil {
 			return tokens
 		}
 	}
@@ -1137,6 +1423,8 @@ func (ic *importContext) reference(i importable, path []string, value string, ct
 }
 
 func (ic *importContext) variable(name, desc string) hclwrite.Tokens {
+	ic.variablesMutex.Lock()
+	defer ic.variablesMutex.Unlock()
 	ic.variables[name] = desc
 	return hclwrite.TokensForTraversal(hcl.Traversal{
 		hcl.TraverseRoot{Name: "var"},
@@ -1144,6 +1432,17 @@ func (ic *importContext) variable(name, desc string) hclwrite.Tokens {
 	})
 }
 
+// sensitiveVariable is like variable, but for values of secrets, that shouldn't be shown in Terraform output
+func (ic *importContext) sensitiveVariable(name, desc string) hclwrite.Tokens {
+	ic.variablesMutex.Lock()
+	if ic.sensitiveVariables == nil {
+		ic.sensitiveVariables = map[string]struct{}{}
+	}
+	ic.sensitiveVariables[name] = struct{}{}
+	ic.variablesMutex.Unlock()
+	return ic.variable(name, desc)
+}
+
 type fieldTuple struct {
 	Field  string
 	Schema *schema.Schema
@@ -1165,6 +1464,10 @@ func (ic *importContext) dataToHcl(i importable, path []string,
 		pathString := strings.Join(append(path, a), ".")
 		raw, nonZero := d.GetOk(pathString)
 		// log.Printf("[DEBUG] path=%s, raw='%v'", pathString, raw)
+		// values that the backend generates by itself are omitted, as the provider suppresses diffs for them
+		if v, ok := raw.(string); ok && common.IsServerDefault(i.resourceType, dependsRe.ReplaceAllString(pathString, ""), v) {
+			continue
+		}
 		if i.ShouldOmitField == nil { // we don't have custom function, so skip computed & default fields
 			if defaultShouldOmitFieldFunc(ic, pathString, as, d) {
 				continue
@@ -1194,7 +1497,7 @@ func (ic *importContext) dataToHcl(i importable, path []string,
 		switch as.Type {
 		case schema.TypeString:
 			value := raw.(string)
-			tokens := ic.reference(i, append(path, a), value, cty.StringVal(value))
+			tokens := ic.reference(i, append(path, a), d, value, cty.StringVal(value))
 			body.SetAttributeRaw(a, tokens)
 		case schema.TypeBool:
 			body.SetAttributeValue(a, cty.BoolVal(raw.(bool)))
@@ -1208,7 +1511,7 @@ func (ic *importContext) dataToHcl(i importable, path []string,
 			case int64:
 				num = iv
 			}
-			body.SetAttributeRaw(a, ic.reference(i, append(path, a),
+			body.SetAttributeRaw(a, ic.reference(i, append(path, a), d,
 				strconv.FormatInt(num, 10), cty.NumberIntVal(num)))
 		case schema.TypeFloat:
 			body.SetAttributeValue(a, cty.NumberFloatVal(raw.(float64)))
@@ -1282,7 +1585,7 @@ func (ic *importContext) readListFromData(i importable, path []string, d *schema
 			switch x := raw.(type) {
 			case string:
 				value := raw.(string)
-				toks = append(toks, ic.reference(i, path, value, cty.StringVal(value))...)
+				toks = append(toks, ic.reference(i, path, d, value, cty.StringVal(value))...)
 			case int:
 				// probably we don't even use integer lists?...
 				toks = append(toks, hclwrite.TokensForValue(
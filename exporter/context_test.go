@@ -1,14 +1,23 @@
 package exporter
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"os"
+	"regexp"
+	"strings"
 	"sync"
 	"testing"
+	"time"
 
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/qa"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNoServicesSkipsRun(t *testing.T) {
@@ -16,7 +25,23 @@ func TestNoServicesSkipsRun(t *testing.T) {
 }
 
 func TestMatchesName(t *testing.T) {
-	assert.False(t, (&importContext{match: "x"}).MatchesName("y"))
+	assert.False(t, (&importContext{match: "x"}).MatchesName("databricks_job", "y"))
+	assert.True(t, (&importContext{match: "X"}).MatchesName("databricks_job", "axb"))
+	ic := &importContext{Importables: resourcesMap, filters: map[string]*regexp.Regexp{
+		"jobs": regexp.MustCompile("^prod-"),
+	}}
+	assert.True(t, ic.MatchesName("databricks_job", "prod-etl"))
+	assert.False(t, ic.MatchesName("databricks_job", "dev-etl"))
+	assert.True(t, ic.MatchesName("databricks_pipeline", "dev-etl"))
+}
+
+func TestMatchesOwner(t *testing.T) {
+	assert.True(t, (&importContext{}).MatchesOwner("user@domain.com"))
+	ic := &importContext{owners: parseOwners("user@domain.com, 9f0621ee-b52b-11ea-b3de-0242ac130004")}
+	assert.True(t, ic.MatchesOwner("", "USER@domain.com"))
+	assert.True(t, ic.MatchesOwner("9f0621ee-b52b-11ea-b3de-0242ac130004"))
+	assert.False(t, ic.MatchesOwner("other@domain.com", ""))
+	assert.False(t, ic.MatchesOwner())
 }
 
 func TestImportContextFindSkips(t *testing.T) {
@@ -105,7 +130,7 @@ func TestEmitNoSearchAvail(t *testing.T) {
 		channels: map[string]resourceChannel{
 			"a": ch,
 		},
-		ignoredResources: map[string]struct{}{},
+		ignoredResources: map[string]ignoredResource{},
 		State:            state,
 	}
 	ic.enableServices("e")
@@ -144,7 +169,8 @@ func TestEmitNoSearchFails(t *testing.T) {
 		channels: map[string]resourceChannel{
 			"a": ch,
 		},
-		State: state,
+		ignoredResources: map[string]ignoredResource{},
+		State:            state,
 	}
 	ic.enableServices("e")
 	go func() {
@@ -160,6 +186,9 @@ func TestEmitNoSearchFails(t *testing.T) {
 	})
 	ic.waitGroup.Wait()
 	close(ch)
+	assert.Equal(t, map[string]ignoredResource{
+		"a. b=d": {Resource: "a", Attribute: "b", Value: "d", Reason: "api error", Message: "just fails"},
+	}, ic.ignoredResources)
 }
 
 func TestEmitNoSearchNoId(t *testing.T) {
@@ -182,7 +211,7 @@ func TestEmitNoSearchNoId(t *testing.T) {
 		channels: map[string]resourceChannel{
 			"a": ch,
 		},
-		ignoredResources: map[string]struct{}{},
+		ignoredResources: map[string]ignoredResource{},
 		State:            state,
 	}
 	ic.enableServices("e")
@@ -226,7 +255,7 @@ func TestEmitNoSearchNoIdWithRetry(t *testing.T) {
 		channels: map[string]resourceChannel{
 			"a": ch,
 		},
-		ignoredResources: map[string]struct{}{},
+		ignoredResources: map[string]ignoredResource{},
 		State:            state,
 	}
 	ic.enableServices("e")
@@ -269,7 +298,8 @@ func TestEmitNoSearchSucceedsImportFails(t *testing.T) {
 		channels: map[string]resourceChannel{
 			"a": ch,
 		},
-		State: state,
+		ignoredResources: map[string]ignoredResource{},
+		State:            state,
 	}
 	ic.enableServices("e")
 	go func() {
@@ -286,6 +316,9 @@ func TestEmitNoSearchSucceedsImportFails(t *testing.T) {
 	})
 	ic.waitGroup.Wait()
 	close(ch)
+	assert.Equal(t, map[string]ignoredResource{
+		"a. id=some": {Resource: "a", Attribute: "id", Value: "some", Reason: "api error", Message: "fails"},
+	}, ic.ignoredResources)
 }
 
 func TestLoadingLastRun(t *testing.T) {
@@ -310,3 +343,182 @@ func TestLoadingLastRun(t *testing.T) {
 	s = getLastRunString(fname)
 	assert.Equal(t, "2023-07-24T00:00:00Z", s)
 }
+
+func TestIgnoreReasonForError(t *testing.T) {
+	assert.Equal(t, "not found", ignoreReasonForError(apierr.NotFound("nope")))
+	assert.Equal(t, "permission denied", ignoreReasonForError(&apierr.APIError{
+		ErrorCode:  "PERMISSION_DENIED",
+		StatusCode: 403,
+		Message:    "denied",
+	}))
+	assert.Equal(t, "unsupported feature", ignoreReasonForError(&apierr.APIError{
+		ErrorCode:  "NOT_IMPLEMENTED",
+		StatusCode: 501,
+		Message:    "nope",
+	}))
+	assert.Equal(t, "not found", ignoreReasonForError(fmt.Errorf("Cluster abc does not exist")))
+	assert.Equal(t, "unsupported feature", ignoreReasonForError(fmt.Errorf("Feature is not enabled")))
+	assert.Equal(t, "api error", ignoreReasonForError(fmt.Errorf("i'm a teapot")))
+}
+
+func TestWriteIgnoredResources(t *testing.T) {
+	tmpDir := fmt.Sprintf("/tmp/tf-%s", qa.RandomName())
+	require.NoError(t, os.MkdirAll(tmpDir, 0755))
+	defer os.RemoveAll(tmpDir)
+	ic := importContextForTest()
+	ic.Directory = tmpDir
+	ic.addIgnoredResource(ignoredResource{Resource: "databricks_repo", Attribute: "path",
+		Value: "/Repos/a", Reason: ignoreReasonNoGitProvider})
+	ic.addIgnoredResource(ignoredResource{Resource: "databricks_job", Attribute: "id",
+		Value: "123", Reason: ignoreReasonPermissionDenied, Message: "denied"})
+	require.NoError(t, ic.writeIgnoredResources())
+
+	text, err := os.ReadFile(tmpDir + "/ignored_resources.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "databricks_job. id=123\ndatabricks_repo. path=/Repos/a\n", string(text))
+
+	content, err := os.ReadFile(tmpDir + "/ignored_resources.json")
+	require.NoError(t, err)
+	var ignored []ignoredResource
+	require.NoError(t, json.Unmarshal(content, &ignored))
+	assert.Equal(t, []ignoredResource{
+		{Resource: "databricks_job", Attribute: "id", Value: "123", Reason: "permission denied", Message: "denied"},
+		{Resource: "databricks_repo", Attribute: "path", Value: "/Repos/a", Reason: "no Git provider"},
+	}, ignored)
+}
+
+func TestWriteReadme(t *testing.T) {
+	qa.MockWorkspaceApply(t, func(w *mocks.MockWorkspaceClient) {}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTestWithClient(ctx, client)
+		ic.Directory = t.TempDir()
+		ic.Scope.Append(&resource{Resource: "databricks_job", ID: "1", Name: "a"})
+		ic.Scope.Append(&resource{Resource: "databricks_job", ID: "2", Name: "b"})
+		ic.Scope.Append(&resource{Resource: "databricks_cluster", ID: "abc", Name: "c"})
+		ic.variables = map[string]string{"string_scope_key": "Secret key in scope"}
+		ic.addIgnoredResource(ignoredResource{Resource: "databricks_repo", Attribute: "path",
+			Value: "/Repos/a", Reason: ignoreReasonNoGitProvider})
+		require.NoError(t, ic.writeReadme(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), 83*time.Second))
+
+		content, err := os.ReadFile(ic.Directory + "/README.md")
+		require.NoError(t, err)
+		readme := string(content)
+		assert.Contains(t, readme, "Exported 3 objects from workspace ")
+		assert.Contains(t, readme, "The export started at 2024-01-02T03:04:05Z and took 1m23s.")
+		assert.Contains(t, readme, "| `compute` | 1 |\n| `jobs` | 2 |\n")
+		assert.Contains(t, readme, "* `string_scope_key` - Secret key in scope\n")
+		assert.Contains(t, readme, "1 objects weren't exported")
+		assert.Contains(t, readme, "./import.sh")
+
+		ic.nativeImport = true
+		require.NoError(t, ic.writeReadme(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), 83*time.Second))
+		content, err = os.ReadFile(ic.Directory + "/README.md")
+		require.NoError(t, err)
+		readme = string(content)
+		assert.NotContains(t, readme, "./import.sh")
+		assert.Contains(t, readme, "`imports.tf`")
+	})
+}
+
+func TestNativeImportBlocks(t *testing.T) {
+	ic := importContextForTest()
+	r := &resource{Resource: "databricks_job", ID: "123", Name: "abc"}
+	assert.Equal(t, `terraform import databricks_job.abc "123"`, r.ImportCommand(ic))
+
+	ic.nativeImport = true
+	assert.Equal(t, "import {\n  to = databricks_job.abc\n  id = \"123\"\n}", r.ImportCommand(ic))
+
+	ic.Module = "module.workspace"
+	memberBlock := groupMemberResource("admins", "1", "u2").ImportCommand(ic)
+	assert.Equal(t, "import {\n  to = module.workspace.databricks_group_member.admins[\"u2\"]\n  id = \"1|u2\"\n}",
+		memberBlock)
+
+	blocks := parseImportBlocks(r.ImportCommand(ic) + "\n" + memberBlock)
+	assert.Equal(t, []string{
+		"import {\n  to = module.workspace.databricks_job.abc\n  id = \"123\"\n}",
+		memberBlock,
+	}, blocks)
+}
+
+func TestWriteNativeImports(t *testing.T) {
+	ic := importContextForTest()
+	ic.nativeImport = true
+	ic.shImports = map[string]bool{}
+	fileName := t.TempDir() + "/imports.tf"
+	require.NoError(t, os.WriteFile(fileName, []byte(`import {
+  to = databricks_job.old
+  id = "1"
+}
+
+import {
+  to = databricks_job.abc
+  id = "123"
+}
+`), 0644))
+	// import blocks of the previous run are kept in incremental mode
+	ic.loadImportBlocks(fileName)
+	assert.Len(t, ic.shImports, 2)
+
+	sh, err := os.Create(fileName)
+	require.NoError(t, err)
+	importChan := make(importWriteChannel, 1)
+	ic.waitGroup.Add(1)
+	importChan <- (&resource{Resource: "databricks_job", ID: "123", Name: "abc"}).ImportCommand(ic)
+	close(importChan)
+	ic.writeImports(sh, importChan)
+	sh.Close()
+
+	content, err := os.ReadFile(fileName)
+	require.NoError(t, err)
+	assert.Equal(t, `import {
+  to = databricks_job.abc
+  id = "123"
+}
+import {
+  to = databricks_job.old
+  id = "1"
+}
+`, string(content))
+}
+
+func TestExistingStateOps(t *testing.T) {
+	ic := importContextForTest()
+	ic.Directory = t.TempDir()
+	ic.existingStateFile = ic.Directory + "/old.tfstate"
+	require.NoError(t, os.WriteFile(ic.existingStateFile, []byte(`{
+  "version": 4,
+  "resources": [
+    {"mode": "managed", "type": "databricks_job", "name": "etl",
+     "instances": [{"attributes": {"id": "123"}}]},
+    {"mode": "managed", "type": "databricks_group", "name": "teams", "module": "module.identity",
+     "instances": [{"index_key": "a", "attributes": {"id": "1"}},
+                   {"index_key": 1, "attributes": {"id": "2"}}]},
+    {"mode": "data", "type": "databricks_job", "name": "lookup",
+     "instances": [{"attributes": {"id": "456"}}]}
+  ]
+}`), 0644))
+	existing, err := loadExistingState(ic.existingStateFile)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"databricks_job/123": "databricks_job.etl",
+		"databricks_group/1": `module.identity.databricks_group.teams["a"]`,
+		"databricks_group/2": "module.identity.databricks_group.teams[1]",
+	}, existing)
+
+	ic.addStateOp(&resource{Resource: "databricks_job", ID: "123", Name: "etl_123"}, existing["databricks_job/123"])
+	ic.addStateOp(&resource{Resource: "databricks_group", ID: "1", Name: "a"}, existing["databricks_group/1"])
+	require.NoError(t, ic.writeStateOps())
+	content, err := os.ReadFile(ic.Directory + "/state-ops.sh")
+	require.NoError(t, err)
+	script := string(content)
+	assert.True(t, strings.HasPrefix(script, "#!/bin/sh\n\nset -e\n\n"))
+	assert.Contains(t, script, fmt.Sprintf(`# databricks_job.etl (id: 123)
+terraform state rm -state="%s" databricks_job.etl
+terraform import databricks_job.etl_123 "123"
+`, ic.existingStateFile))
+	assert.Contains(t, script, fmt.Sprintf(`terraform state rm -state="%s" 'module.identity.databricks_group.teams["a"]'
+terraform import databricks_group.a "1"
+`, ic.existingStateFile))
+
+	_, err = loadExistingState(ic.Directory + "/missing.tfstate")
+	assert.Error(t, err)
+}
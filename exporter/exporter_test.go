@@ -20,6 +20,7 @@ import (
 	"github.com/databricks/databricks-sdk-go/service/serving"
 	"github.com/databricks/databricks-sdk-go/service/settings"
 	"github.com/databricks/databricks-sdk-go/service/sql"
+	"github.com/databricks/databricks-sdk-go/service/vectorsearch"
 	workspaceApi "github.com/databricks/databricks-sdk-go/service/workspace"
 	"github.com/databricks/terraform-provider-databricks/aws"
 	"github.com/databricks/terraform-provider-databricks/clusters"
@@ -32,6 +33,7 @@ import (
 	"github.com/databricks/terraform-provider-databricks/repos"
 	"github.com/databricks/terraform-provider-databricks/scim"
 	"github.com/databricks/terraform-provider-databricks/secrets"
+	tfsettings "github.com/databricks/terraform-provider-databricks/settings"
 	tfsql "github.com/databricks/terraform-provider-databricks/sql"
 	"github.com/databricks/terraform-provider-databricks/workspace"
 	"github.com/hashicorp/hcl/v2/hclwrite"
@@ -335,6 +337,41 @@ var emptySqlDashboards = qa.HTTPFixture{
 	ReuseRequest: true,
 }
 
+var emptyLakeviewDashboards = qa.HTTPFixture{
+	Method:       "GET",
+	Resource:     "/api/2.0/lakeview/dashboards?page_size=100",
+	Response:     map[string]any{},
+	ReuseRequest: true,
+}
+
+var emptyVectorSearchEndpoints = qa.HTTPFixture{
+	Method:       "GET",
+	Resource:     "/api/2.0/vector-search/endpoints?",
+	Response:     vectorsearch.ListEndpointResponse{},
+	ReuseRequest: true,
+}
+
+var emptyMlflowExperiments = qa.HTTPFixture{
+	Method:       "GET",
+	Resource:     "/api/2.0/mlflow/experiments/list?",
+	Response:     ml.ListExperimentsResponse{},
+	ReuseRequest: true,
+}
+
+var emptyMlflowModels = qa.HTTPFixture{
+	Method:       "GET",
+	Resource:     "/api/2.0/mlflow/registered-models/list?",
+	Response:     ml.ListModelsResponse{},
+	ReuseRequest: true,
+}
+
+var emptyNotificationDestinations = qa.HTTPFixture{
+	Method:       "GET",
+	Resource:     "/api/2.0/notification-destinations?",
+	Response:     tfsettings.NotificationDestinationsList{},
+	ReuseRequest: true,
+}
+
 var emptySqlQueries = qa.HTTPFixture{
 	Method:       "GET",
 	Resource:     "/api/2.0/preview/sql/queries?page_size=100",
@@ -362,6 +399,17 @@ var dummyWorkspaceConf = qa.HTTPFixture{
 	Response: map[string]any{},
 }
 
+var defaultNamespaceSettingNotSet = qa.HTTPFixture{
+	Method:   "GET",
+	Resource: "/api/2.0/settings/types/default_namespace_ws/names/default?etag=",
+	Status:   404,
+	Response: apierr.APIErrorBody{
+		ErrorCode: "NOT_FOUND",
+		Message:   "Setting was not found",
+	},
+	ReuseRequest: true,
+}
+
 var allKnownWorkspaceConfs = qa.HTTPFixture{
 	Method:       "GET",
 	Resource:     fmt.Sprintf("/api/2.0/workspace-conf?keys=%s", workspaceConfKeysToURL()),
@@ -426,6 +474,11 @@ func TestImportingUsersGroupsSecretScopes(t *testing.T) {
 			emptyModelServing,
 			emptyMlflowWebhooks,
 			emptySqlDashboards,
+			emptyLakeviewDashboards,
+			emptyVectorSearchEndpoints,
+			emptyMlflowExperiments,
+			emptyMlflowModels,
+			emptyNotificationDestinations,
 			emptySqlEndpoints,
 			emptySqlQueries,
 			emptySqlAlerts,
@@ -435,6 +488,7 @@ func TestImportingUsersGroupsSecretScopes(t *testing.T) {
 			emptyWorkspaceConf,
 			allKnownWorkspaceConfs,
 			dummyWorkspaceConf,
+			defaultNamespaceSettingNotSet,
 			emptyGlobalSQLConfig,
 			listSpFixtures[0],
 			listSpFixtures[1],
@@ -681,6 +735,7 @@ func TestImportingNoResourcesError(t *testing.T) {
 			emptyInstancePools,
 			emptyClusterPolicies,
 			dummyWorkspaceConf,
+			defaultNamespaceSettingNotSet,
 			qa.ListGroupsFixtures([]iam.Group{})[0],
 			emptyGitCredentials,
 			emptyIpAccessLIst,
@@ -688,6 +743,11 @@ func TestImportingNoResourcesError(t *testing.T) {
 			emptySqlEndpoints,
 			emptySqlQueries,
 			emptySqlDashboards,
+			emptyLakeviewDashboards,
+			emptyVectorSearchEndpoints,
+			emptyMlflowExperiments,
+			emptyMlflowModels,
+			emptyNotificationDestinations,
 			emptySqlAlerts,
 			emptyPipelines,
 			emptyPolicyFamilies,
@@ -741,7 +801,7 @@ func TestImportingClusters(t *testing.T) {
 			emptyRepos,
 			{
 				Method:   "GET",
-				Resource: "/api/2.0/preview/scim/v2/Groups?",
+				Resource: "/api/2.0/preview/scim/v2/Groups?count=100&startIndex=1",
 				Response: scim.GroupList{Resources: []scim.Group{}},
 			},
 			{
@@ -1427,6 +1487,14 @@ func TestImportingWithError(t *testing.T) {
 	assert.EqualError(t, err, "can't create directory /bin/abcd")
 }
 
+func TestImportingIncrementalJSONError(t *testing.T) {
+	t.Setenv("DATABRICKS_HOST", "https://localhost")
+	t.Setenv("DATABRICKS_TOKEN", "x")
+	err := Run("-directory", t.TempDir(), "-services", "groups", "-skip-interactive",
+		"-format", "json", "-incremental", "-updated-since", "2023-07-24T00:00:00Z")
+	assert.EqualError(t, err, "-incremental can't be used together with -format json")
+}
+
 func TestImportingSecrets(t *testing.T) {
 	qa.HTTPFixturesApply(t,
 		[]qa.HTTPFixture{
@@ -1435,7 +1503,7 @@ func TestImportingSecrets(t *testing.T) {
 			emptyRepos,
 			{
 				Method:   "GET",
-				Resource: "/api/2.0/preview/scim/v2/Groups?",
+				Resource: "/api/2.0/preview/scim/v2/Groups?count=100&startIndex=1",
 				Response: scim.GroupList{Resources: []scim.Group{}},
 			},
 			{
@@ -1519,6 +1587,7 @@ func TestImportingGlobalInitScripts(t *testing.T) {
 			emptyRepos,
 			emptyWorkspaceConf,
 			dummyWorkspaceConf,
+			defaultNamespaceSettingNotSet,
 			allKnownWorkspaceConfs,
 			{
 				Method:       "GET",
@@ -1677,6 +1746,7 @@ func TestImportingIPAccessLists(t *testing.T) {
 			emptyRepos,
 			emptyWorkspaceConf,
 			dummyWorkspaceConf,
+			defaultNamespaceSettingNotSet,
 			allKnownWorkspaceConfs,
 			{
 				Method:   "GET",
@@ -1735,6 +1805,8 @@ func TestImportingSqlObjects(t *testing.T) {
 			meAdminFixture,
 			noCurrentMetastoreAttached,
 			emptyRepos,
+			userListIdUsernameFixture,
+			userListIdUsernameFixture2,
 			emptyIpAccessLIst,
 			emptyGlobalSQLConfig,
 			{
@@ -1826,12 +1898,12 @@ func TestImportingSqlObjects(t *testing.T) {
 			},
 			{
 				Method:   "GET",
-				Resource: "/api/2.0/preview/sql/permissions/queries/16c4f969-eea0-4aad-8f82-03d79b078dcc",
+				Resource: "/api/2.0/permissions/queries/16c4f969-eea0-4aad-8f82-03d79b078dcc",
 				Response: getJSONObject("test-data/get-sql-query-permissions.json"),
 			},
 			{
 				Method:   "GET",
-				Resource: "/api/2.0/preview/sql/permissions/dashboards/9cb0c8f5-6262-4a1f-a741-2181de76028f",
+				Resource: "/api/2.0/permissions/dbsql-dashboards/9cb0c8f5-6262-4a1f-a741-2181de76028f",
 				Response: getJSONObject("test-data/get-sql-dashboard-permissions.json"),
 			},
 			{
@@ -1847,7 +1919,7 @@ func TestImportingSqlObjects(t *testing.T) {
 			},
 			{
 				Method:   "GET",
-				Resource: "/api/2.0/preview/sql/permissions/alerts/3cf91a42-6217-4f3c-a6f0-345d489051b9",
+				Resource: "/api/2.0/permissions/alerts/3cf91a42-6217-4f3c-a6f0-345d489051b9",
 				Response: getJSONObject("test-data/get-sql-alert-permissions.json"),
 			},
 		},
@@ -1969,7 +2041,7 @@ func TestImportingDLTPipelines(t *testing.T) {
 			},
 			{
 				Method:   "GET",
-				Resource: "/api/2.0/preview/scim/v2/Users?attributes=userName%2Cid",
+				Resource: "/api/2.0/preview/scim/v2/Users?attributes=userName%2Cid&count=100&startIndex=1",
 				Response: scim.UserList{
 					Resources: []scim.User{
 						{
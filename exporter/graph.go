@@ -0,0 +1,214 @@
+package exporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"golang.org/x/exp/maps"
+)
+
+const (
+	graphDotFileName  = "dependencies.dot"
+	graphJSONFileName = "dependencies.json"
+)
+
+// graphNode is a generated resource or data source together with resources it refers to
+type graphNode struct {
+	Address   string   `json:"address"`
+	File      string   `json:"file"`
+	DependsOn []string `json:"depends_on"`
+	// number of resources that refer to this one, to spot unexpectedly big fans of dependencies
+	Dependents int `json:"dependents"`
+}
+
+// referencedAddress returns the address of the resource or data source the traversal refers to, if any
+func referencedAddress(traversal hcl.Traversal) (string, bool) {
+	parts := []string{traversal.RootName()}
+	for _, step := range traversal[1:] {
+		attr, ok := step.(hcl.TraverseAttr)
+		if !ok {
+			break
+		}
+		parts = append(parts, attr.Name)
+	}
+	switch parts[0] {
+	case "var", "local", "path", "module", "each", "count", "self", "terraform":
+		return "", false
+	case "data":
+		if len(parts) < 3 {
+			return "", false
+		}
+		return strings.Join(parts[:3], "."), true
+	}
+	if len(parts) < 2 {
+		return "", false
+	}
+	return strings.Join(parts[:2], "."), true
+}
+
+// localName returns the name of the local value the traversal refers to, if any
+func localName(traversal hcl.Traversal) (string, bool) {
+	if traversal.RootName() != "local" || len(traversal) < 2 {
+		return "", false
+	}
+	attr, ok := traversal[1].(hcl.TraverseAttr)
+	if !ok {
+		return "", false
+	}
+	return attr.Name, true
+}
+
+// references of a generated block or a local value: addresses of resources and names of local values
+type references struct {
+	addresses map[string]struct{}
+	locals    map[string]struct{}
+}
+
+func newReferences() *references {
+	return &references{
+		addresses: map[string]struct{}{},
+		locals:    map[string]struct{}{},
+	}
+}
+
+func (refs *references) collectExpression(expr hclsyntax.Expression) {
+	for _, traversal := range expr.Variables() {
+		if name, ok := localName(traversal); ok {
+			refs.locals[name] = struct{}{}
+		} else if address, ok := referencedAddress(traversal); ok {
+			refs.addresses[address] = struct{}{}
+		}
+	}
+}
+
+func (refs *references) collectBody(body *hclsyntax.Body) {
+	for _, attr := range body.Attributes {
+		refs.collectExpression(attr.Expr)
+	}
+	for _, block := range body.Blocks {
+		refs.collectBody(block.Body)
+	}
+}
+
+// resolve returns addresses referenced directly or through (possibly nested) local values
+func (refs *references) resolve(locals map[string]*references) map[string]struct{} {
+	addresses := maps.Clone(refs.addresses)
+	visited := map[string]bool{}
+	pending := maps.Keys(refs.locals)
+	for len(pending) > 0 {
+		name := pending[0]
+		pending = pending[1:]
+		if visited[name] {
+			continue
+		}
+		visited[name] = true
+		local, ok := locals[name]
+		if !ok {
+			continue
+		}
+		for address := range local.addresses {
+			addresses[address] = struct{}{}
+		}
+		pending = append(pending, maps.Keys(local.locals)...)
+	}
+	return addresses
+}
+
+// dependencyGraph parses generated files and returns resources sorted by address, with their references.
+// References to local values are resolved to resources they refer to, i.e. for members of groups
+func (ic *importContext) dependencyGraph() ([]*graphNode, error) {
+	files, err := filepath.Glob(filepath.Join(ic.Directory, "*.tf"))
+	if err != nil {
+		return nil, err
+	}
+	nodes := map[string]*graphNode{}
+	blockRefs := map[string]*references{}
+	locals := map[string]*references{}
+	for _, fileName := range files {
+		content, err := os.ReadFile(fileName)
+		if err != nil {
+			return nil, err
+		}
+		file, diags := hclsyntax.ParseConfig(content, fileName, hcl.Pos{Line: 1, Column: 1})
+		if diags.HasErrors() {
+			return nil, fmt.Errorf("can't parse %s: %s", fileName, diags.Error())
+		}
+		for _, block := range file.Body.(*hclsyntax.Body).Blocks {
+			var address string
+			switch {
+			case block.Type == "locals":
+				for name, attr := range block.Body.Attributes {
+					refs := newReferences()
+					refs.collectExpression(attr.Expr)
+					locals[name] = refs
+				}
+				continue
+			case block.Type == "resource" && len(block.Labels) == 2:
+				address = strings.Join(block.Labels, ".")
+			case block.Type == "data" && len(block.Labels) == 2:
+				address = "data." + strings.Join(block.Labels, ".")
+			default:
+				continue
+			}
+			refs := newReferences()
+			refs.collectBody(block.Body)
+			blockRefs[address] = refs
+			nodes[address] = &graphNode{
+				Address: address,
+				File:    filepath.Base(fileName),
+			}
+		}
+	}
+	graph := make([]*graphNode, 0, len(nodes))
+	for address, node := range nodes {
+		refs := blockRefs[address].resolve(locals)
+		delete(refs, address)
+		node.DependsOn = maps.Keys(refs)
+		sort.Strings(node.DependsOn)
+		graph = append(graph, node)
+	}
+	for _, node := range graph {
+		for _, ref := range node.DependsOn {
+			if dependency, ok := nodes[ref]; ok {
+				dependency.Dependents++
+			}
+		}
+	}
+	sort.Slice(graph, func(i, j int) bool {
+		return graph[i].Address < graph[j].Address
+	})
+	return graph, nil
+}
+
+// writeDependencyGraph writes references between generated resources as DOT and JSON files, so the order
+// of creation could be visualized before applying the code
+func (ic *importContext) writeDependencyGraph() error {
+	graph, err := ic.dependencyGraph()
+	if err != nil {
+		return err
+	}
+	content, err := json.MarshalIndent(graph, "", "  ")
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(filepath.Join(ic.Directory, graphJSONFileName), content, 0644)
+	if err != nil {
+		return err
+	}
+	var sb strings.Builder
+	sb.WriteString("digraph dependencies {\n  rankdir = \"RL\";\n")
+	for _, node := range graph {
+		sb.WriteString(fmt.Sprintf("  %q;\n", node.Address))
+		for _, ref := range node.DependsOn {
+			sb.WriteString(fmt.Sprintf("  %q -> %q;\n", node.Address, ref))
+		}
+	}
+	sb.WriteString("}\n")
+	return os.WriteFile(filepath.Join(ic.Directory, graphDotFileName), []byte(sb.String()), 0644)
+}
@@ -0,0 +1,128 @@
+package exporter
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteDependencyGraph(t *testing.T) {
+	ic := importContextForTest()
+	ic.Directory = t.TempDir()
+	err := os.WriteFile(filepath.Join(ic.Directory, "compute.tf"), []byte(`
+resource "databricks_cluster" "a" {
+  policy_id = databricks_cluster_policy.p.id
+  library {
+    jar = databricks_dbfs_file.lib.dbfs_path
+  }
+}
+
+resource "databricks_cluster_policy" "p" {
+  name = var.policy_name
+}
+`), 0644)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(ic.Directory, "storage.tf"), []byte(`
+resource "databricks_dbfs_file" "lib" {
+  source = "${path.module}/files/lib.jar"
+  path   = "/${data.databricks_current_user.me.alphanumeric}/lib.jar"
+}
+
+data "databricks_current_user" "me" {
+}
+`), 0644)
+	require.NoError(t, err)
+
+	require.NoError(t, ic.writeDependencyGraph())
+
+	content, err := os.ReadFile(filepath.Join(ic.Directory, graphJSONFileName))
+	require.NoError(t, err)
+	var graph []graphNode
+	require.NoError(t, json.Unmarshal(content, &graph))
+	assert.Equal(t, []graphNode{
+		{
+			Address:    "data.databricks_current_user.me",
+			File:       "storage.tf",
+			DependsOn:  []string{},
+			Dependents: 1,
+		},
+		{
+			Address:   "databricks_cluster.a",
+			File:      "compute.tf",
+			DependsOn: []string{"databricks_cluster_policy.p", "databricks_dbfs_file.lib"},
+		},
+		{
+			Address:    "databricks_cluster_policy.p",
+			File:       "compute.tf",
+			DependsOn:  []string{},
+			Dependents: 1,
+		},
+		{
+			Address:    "databricks_dbfs_file.lib",
+			File:       "storage.tf",
+			DependsOn:  []string{"data.databricks_current_user.me"},
+			Dependents: 1,
+		},
+	}, graph)
+
+	dot, err := os.ReadFile(filepath.Join(ic.Directory, graphDotFileName))
+	require.NoError(t, err)
+	assert.Contains(t, string(dot), `"databricks_cluster.a" -> "databricks_cluster_policy.p";`)
+	assert.Contains(t, string(dot), `"databricks_dbfs_file.lib" -> "data.databricks_current_user.me";`)
+	assert.NotContains(t, string(dot), "var.policy_name")
+}
+
+func TestWriteDependencyGraphInvalidFile(t *testing.T) {
+	ic := importContextForTest()
+	ic.Directory = t.TempDir()
+	err := os.WriteFile(filepath.Join(ic.Directory, "jobs.tf"), []byte(`resource "databricks_job" {`), 0644)
+	require.NoError(t, err)
+	err = ic.writeDependencyGraph()
+	assert.ErrorContains(t, err, "can't parse")
+}
+
+func TestDependencyGraphResolvesLocals(t *testing.T) {
+	ic := importContextForTest()
+	ic.Directory = t.TempDir()
+	err := os.WriteFile(filepath.Join(ic.Directory, "users.tf"), []byte(`
+locals {
+  admins_members = {
+    "u1" = databricks_user.jane_u1.id
+    "g2" = local.nested_id
+    "u3" = "u3"
+  }
+  nested_id = databricks_group.nested.id
+}
+
+resource "databricks_group_member" "admins" {
+  for_each  = local.admins_members
+  group_id  = databricks_group.admins.id
+  member_id = each.value
+}
+
+resource "databricks_group" "admins" {
+  display_name = "admins"
+}
+
+resource "databricks_group" "nested" {
+  display_name = "nested"
+}
+
+resource "databricks_user" "jane_u1" {
+  user_name = "jane@example.com"
+}
+`), 0644)
+	require.NoError(t, err)
+
+	graph, err := ic.dependencyGraph()
+	require.NoError(t, err)
+	require.Len(t, graph, 4)
+	assert.Equal(t, "databricks_group_member.admins", graph[2].Address)
+	assert.Equal(t, []string{"databricks_group.admins", "databricks_group.nested", "databricks_user.jane_u1"},
+		graph[2].DependsOn)
+	assert.Equal(t, 1, graph[3].Dependents)
+}
@@ -0,0 +1,113 @@
+package exporter
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+	"golang.org/x/exp/maps"
+)
+
+// collectGroupMember remembers membership instead of generating a separate `databricks_group_member`
+// resource for it. Memberships are generated per group by generateGroupMembers.
+func (ic *importContext) collectGroupMember(r *resource) {
+	groupID, memberID, found := strings.Cut(r.ID, "|")
+	if !found {
+		log.Printf("[WARN] Incorrect ID of group member: %s", r.ID)
+		return
+	}
+	ic.groupMembersMutex.Lock()
+	defer ic.groupMembersMutex.Unlock()
+	members, ok := ic.groupMembers[groupID]
+	if !ok {
+		members = map[string]struct{}{}
+		ic.groupMembers[groupID] = members
+	}
+	members[memberID] = struct{}{}
+}
+
+// generateGroupMembers writes a map of members into `locals` and a single `databricks_group_member`
+// resource with `for_each` over this map for each group, instead of a resource per membership
+func (ic *importContext) generateGroupMembers(writerChannels map[string]dataWriteChannel) {
+	ir := ic.Importables["databricks_group_member"]
+	ch, exists := writerChannels[ir.Service]
+	if !exists {
+		log.Printf("[WARN] can't find a channel for service: %s, resource: databricks_group_member", ir.Service)
+		return
+	}
+	groupNames := map[string]string{}
+	for _, r := range ic.Scope.Sorted() {
+		if r.Resource == "databricks_group" {
+			groupNames[r.ID] = r.Name
+		}
+	}
+	ic.groupMembersMutex.Lock()
+	defer ic.groupMembersMutex.Unlock()
+	groupIDs := maps.Keys(ic.groupMembers)
+	sort.Strings(groupIDs)
+	for _, groupID := range groupIDs {
+		name, ok := groupNames[groupID]
+		if !ok {
+			name = ic.regexFix("group_"+groupID, ic.nameFixes)
+		}
+		localName := name + "_members"
+		memberIDs := maps.Keys(ic.groupMembers[groupID])
+		sort.Strings(memberIDs)
+
+		members := make([]hclwrite.ObjectAttrTokens, 0, len(memberIDs))
+		importCommands := make([]string, 0, len(memberIDs))
+		for _, memberID := range memberIDs {
+			members = append(members, hclwrite.ObjectAttrTokens{
+				Name:  hclwrite.TokensForValue(cty.StringVal(memberID)),
+				Value: ic.reference(ir, []string{"member_id"}, nil, memberID, cty.StringVal(memberID)),
+			})
+			member := groupMemberResource(name, groupID, memberID)
+			if command := ic.generatedImportCommand(member, ir); command != "" {
+				importCommands = append(importCommands, command)
+			}
+			ic.addMapping(member, ic.generatedFileName(ir.Service))
+		}
+		f := hclwrite.NewEmptyFile()
+		locals := f.Body().AppendNewBlock("locals", []string{})
+		locals.Body().SetAttributeRaw(localName, hclwrite.TokensForObject(members))
+		ic.writeGroupMembersBlock(ch, f, generateBlockFullName(locals), "")
+		ic.addLocalMapping(localName, groupID, ic.generatedFileName(ir.Service))
+
+		f = hclwrite.NewEmptyFile()
+		block := f.Body().AppendNewBlock("resource", []string{"databricks_group_member", name})
+		block.Body().SetAttributeTraversal("for_each", hcl.Traversal{
+			hcl.TraverseRoot{Name: "local"},
+			hcl.TraverseAttr{Name: localName},
+		})
+		block.Body().SetAttributeRaw("group_id",
+			ic.reference(ir, []string{"group_id"}, nil, groupID, cty.StringVal(groupID)))
+		block.Body().SetAttributeTraversal("member_id", hcl.Traversal{
+			hcl.TraverseRoot{Name: "each"},
+			hcl.TraverseAttr{Name: "value"},
+		})
+		ic.writeGroupMembersBlock(ch, f, generateBlockFullName(block), strings.Join(importCommands, "\n"))
+	}
+	log.Printf("[INFO] Generated members of %d groups", len(groupIDs))
+}
+
+func (ic *importContext) writeGroupMembersBlock(ch dataWriteChannel, f *hclwrite.File, blockName, importCommand string) {
+	ic.waitGroup.Add(1)
+	ch <- &resourceWriteData{
+		ResourceBody:  string(hclwrite.Format(f.Bytes())),
+		BlockName:     blockName,
+		ImportCommand: importCommand,
+	}
+}
+
+// groupMemberResource returns the membership as an instance of the `for_each` resource generated for the group
+func groupMemberResource(name, groupID, memberID string) *resource {
+	return &resource{
+		Resource: "databricks_group_member",
+		ID:       groupID + "|" + memberID,
+		Name:     fmt.Sprintf(`%s["%s"]`, name, memberID),
+	}
+}
@@ -0,0 +1,89 @@
+package exporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateGroupMembers(t *testing.T) {
+	ic := importContextForTest()
+	ic.Module = "identity"
+	ic.State.Append(resourceApproximation{
+		Type:      "databricks_group",
+		Name:      "data_platform_1",
+		Mode:      "managed",
+		Instances: []instanceApproximation{{Attributes: map[string]any{"id": "1"}}},
+	})
+	ic.State.Append(resourceApproximation{
+		Type:      "databricks_user",
+		Name:      "jane_u1",
+		Mode:      "managed",
+		Instances: []instanceApproximation{{Attributes: map[string]any{"id": "u1"}}},
+	})
+	ic.Scope.Append(&resource{Resource: "databricks_group", ID: "1", Name: "data_platform_1"})
+	ic.groupMembers = map[string]map[string]struct{}{}
+	ic.collectGroupMember(&resource{ID: "1|u1"})
+	ic.collectGroupMember(&resource{ID: "1|u2"})
+	// the same membership could be emitted from group & from member
+	ic.collectGroupMember(&resource{ID: "1|u1"})
+	ic.collectGroupMember(&resource{ID: "incorrect"})
+
+	ch := make(dataWriteChannel, 10)
+	ic.generateGroupMembers(map[string]dataWriteChannel{"groups": ch})
+	require.Len(t, ch, 2)
+
+	locals := <-ch
+	assert.Equal(t, "locals_data_platform_1_members", locals.BlockName)
+	assert.Equal(t, `locals {
+  data_platform_1_members = {
+    "u1" = databricks_user.jane_u1.id
+    "u2" = "u2"
+  }
+}
+`, locals.ResourceBody)
+	assert.Equal(t, "", locals.ImportCommand)
+
+	members := <-ch
+	assert.Equal(t, "resource_databricks_group_member_data_platform_1", members.BlockName)
+	assert.Equal(t, `resource "databricks_group_member" "data_platform_1" {
+  for_each  = local.data_platform_1_members
+  group_id  = databricks_group.data_platform_1.id
+  member_id = each.value
+}
+`, members.ResourceBody)
+	assert.Equal(t, `terraform import 'identity.databricks_group_member.data_platform_1["u1"]' "1|u1"
+terraform import 'identity.databricks_group_member.data_platform_1["u2"]' "1|u2"`, members.ImportCommand)
+
+	assert.Equal(t, map[string]addressMapping{
+		"local.data_platform_1_members": {
+			Type: "locals", ID: "1", Address: "local.data_platform_1_members", File: "groups.tf"},
+		`databricks_group_member.data_platform_1["u1"]`: {Type: "databricks_group_member", ID: "1|u1",
+			Address: `databricks_group_member.data_platform_1["u1"]`, File: "groups.tf"},
+		`databricks_group_member.data_platform_1["u2"]`: {Type: "databricks_group_member", ID: "1|u2",
+			Address: `databricks_group_member.data_platform_1["u2"]`, File: "groups.tf"},
+	}, ic.mappings)
+}
+
+func TestGenerateGroupMembersExistingState(t *testing.T) {
+	ic := importContextForTest()
+	ic.existingStateFile = "existing.tfstate"
+	ic.existingResources = map[string]string{
+		"databricks_group_member/1|u1": `databricks_group_member.admins["u1"]`,
+	}
+	ic.groupMembers = map[string]map[string]struct{}{}
+	ic.collectGroupMember(&resource{ID: "1|u1"})
+	ic.collectGroupMember(&resource{ID: "1|u2"})
+
+	ch := make(dataWriteChannel, 10)
+	ic.generateGroupMembers(map[string]dataWriteChannel{"groups": ch})
+	require.Len(t, ch, 2)
+	<-ch
+	members := <-ch
+	assert.Equal(t, `terraform import 'databricks_group_member.group_1["u2"]' "1|u2"`, members.ImportCommand)
+	assert.Equal(t, []string{`# databricks_group_member.admins["u1"] (id: 1|u1)
+terraform state rm -state="existing.tfstate" 'databricks_group_member.admins["u1"]'
+terraform import 'databricks_group_member.group_1["u1"]' "1|u1"
+`}, ic.stateOps)
+}
@@ -2,30 +2,42 @@ package exporter
 
 import (
 	"crypto/md5"
+	"crypto/sha256"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log"
+	"path"
 	"reflect"
 	"regexp"
 	"sort"
 	"strconv"
 	"strings"
 
+	"github.com/databricks/databricks-sdk-go/apierr"
 	"github.com/databricks/databricks-sdk-go/service/catalog"
 	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/databricks-sdk-go/service/files"
 	"github.com/databricks/databricks-sdk-go/service/iam"
 	sdk_jobs "github.com/databricks/databricks-sdk-go/service/jobs"
 	"github.com/databricks/databricks-sdk-go/service/ml"
+	"github.com/databricks/databricks-sdk-go/service/provisioning"
 	"github.com/databricks/databricks-sdk-go/service/settings"
 	"github.com/databricks/databricks-sdk-go/service/sql"
+	"github.com/databricks/databricks-sdk-go/service/vectorsearch"
+	tfcatalog "github.com/databricks/terraform-provider-databricks/catalog"
 	"github.com/databricks/terraform-provider-databricks/clusters"
 	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/dashboards"
 	"github.com/databricks/terraform-provider-databricks/jobs"
+	"github.com/databricks/terraform-provider-databricks/mws"
 	"github.com/databricks/terraform-provider-databricks/permissions"
 	"github.com/databricks/terraform-provider-databricks/pipelines"
 	"github.com/databricks/terraform-provider-databricks/repos"
 	"github.com/databricks/terraform-provider-databricks/secrets"
+	tfsettings "github.com/databricks/terraform-provider-databricks/settings"
 	tfsql "github.com/databricks/terraform-provider-databricks/sql"
 	sql_api "github.com/databricks/terraform-provider-databricks/sql/api"
 	"github.com/databricks/terraform-provider-databricks/storage"
@@ -34,23 +46,25 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/zclconf/go-cty/cty"
+	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
 )
 
 var (
-	adlsGen2Regex                = regexp.MustCompile(`^(abfss?)://([^@]+)@([^.]+)\.(?:[^/]+)(/.*)?$`)
-	adlsGen1Regex                = regexp.MustCompile(`^(adls?)://([^.]+)\.(?:[^/]+)(/.*)?$`)
-	wasbsRegex                   = regexp.MustCompile(`^(wasbs?)://([^@]+)@([^.]+)\.(?:[^/]+)(/.*)?$`)
-	s3Regex                      = regexp.MustCompile(`^(s3a?)://([^/]+)(/.*)?$`)
-	gsRegex                      = regexp.MustCompile(`^gs://([^/]+)(/.*)?$`)
-	globalWorkspaceConfName      = "global_workspace_conf"
-	nameNormalizationRegex       = regexp.MustCompile(`\W+`)
-	fileNameNormalizationRegex   = regexp.MustCompile(`[^-_\w/.@]`)
+	adlsGen2Regex              = regexp.MustCompile(`^(abfss?)://([^@]+)@([^.]+)\.(?:[^/]+)(/.*)?$`)
+	adlsGen1Regex              = regexp.MustCompile(`^(adls?)://([^.]+)\.(?:[^/]+)(/.*)?$`)
+	wasbsRegex                 = regexp.MustCompile(`^(wasbs?)://([^@]+)@([^.]+)\.(?:[^/]+)(/.*)?$`)
+	s3Regex                    = regexp.MustCompile(`^(s3a?)://([^/]+)(/.*)?$`)
+	gsRegex                    = regexp.MustCompile(`^gs://([^/]+)(/.*)?$`)
+	globalWorkspaceConfName    = "global_workspace_conf"
+	nameNormalizationRegex     = regexp.MustCompile(`\W+`)
+	fileNameNormalizationRegex = regexp.MustCompile(`[^-_\w/.@]`)
+	// characters that aren't allowed in file names on common file systems
+	unsafeFileNameRegex          = regexp.MustCompile(`[<>:"\\|?*\x00-\x1f]`)
 	jobClustersRegex             = regexp.MustCompile(`^((job_cluster|task)\.\d+\.new_cluster\.\d+\.)`)
 	dltClusterRegex              = regexp.MustCompile(`^(cluster\.\d+\.)`)
 	secretPathRegex              = regexp.MustCompile(`^\{\{secrets\/([^\/]+)\/([^}]+)\}\}$`)
 	sqlParentRegexp              = regexp.MustCompile(`^folders/(\d+)$`)
-	dltDefaultStorageRegex       = regexp.MustCompile(`^dbfs:/pipelines/[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
 	ignoreIdeFolderRegex         = regexp.MustCompile(`^/Users/[^/]+/\.ide/.*$`)
 	fileExtensionLanguageMapping = map[string]string{
 		"SCALA":  ".scala",
@@ -64,6 +78,9 @@ var (
 		"DBC":        ".dbc",
 		"R_MARKDOWN": ".Rmd",
 	}
+	// account-level settings exported as databricks_generic_setting: defaults of enhanced security monitoring
+	// and compliance security profile for new workspaces, and enablement of Personal Compute policy
+	accountSettingTypes = []string{"shield_esm_enablement_ac", "shield_csp_enablement_ac", "dcp_acct_enable"}
 )
 
 func generateMountBody(ic *importContext, body *hclwrite.Body, r *resource) error {
@@ -171,6 +188,11 @@ var resourcesMap map[string]importable = map[string]importable{
 			if err != nil {
 				return err
 			}
+			// libraries could be much bigger than a single chunk of the read API
+			if size := r.Data.Get("file_size").(int); size > 0 && len(content) != size {
+				return fmt.Errorf("incomplete read of %s: got %d bytes out of %d", r.ID, len(content), size)
+			}
+			log.Printf("[INFO] Downloaded %s: %d bytes, sha256 %x", r.ID, len(content), sha256.Sum256(content))
 			name := ic.Importables["databricks_dbfs_file"].Name(ic, r.Data)
 			fileName, err := ic.createFile(name, content)
 			log.Printf("Creating %s for %s", fileName, r)
@@ -188,6 +210,8 @@ var resourcesMap map[string]importable = map[string]importable{
 	"databricks_instance_pool": {
 		WorkspaceLevel: true,
 		Service:        "pools",
+		Filterable:     true,
+		DataSource:     &dataSource{Attributes: map[string]string{"name": "instance_pool_name"}},
 		Name: func(ic *importContext, d *schema.ResourceData) string {
 			raw, ok := d.GetOk("instance_pool_name")
 			if !ok || raw.(string) == "" {
@@ -201,7 +225,7 @@ var resourcesMap map[string]importable = map[string]importable{
 				return err
 			}
 			for i, pool := range pools {
-				if !ic.MatchesName(pool.InstancePoolName) {
+				if !ic.MatchesName("databricks_instance_pool", pool.InstancePoolName) {
 					continue
 				}
 				ic.Emit(&resource{
@@ -261,6 +285,7 @@ var resourcesMap map[string]importable = map[string]importable{
 	"databricks_cluster": {
 		WorkspaceLevel: true,
 		Service:        "compute",
+		Filterable:     true,
 		Name: func(ic *importContext, d *schema.ResourceData) string {
 			name := d.Get("cluster_name").(string)
 			if name == "" {
@@ -293,7 +318,6 @@ var resourcesMap map[string]importable = map[string]importable{
 			if err != nil {
 				return err
 			}
-			lastActiveMs := ic.getLastActiveMs()
 			nonInteractiveClusters := []string{"JOB", "PIPELINE_MAINTENANCE", "PIPELINE", "SQL"}
 			for offset, c := range clusters {
 				if slices.Contains(nonInteractiveClusters, string(c.ClusterSource)) {
@@ -307,11 +331,11 @@ var resourcesMap map[string]importable = map[string]importable{
 					log.Printf("[INFO] Skipping terraform-specific cluster %s", c.ClusterName)
 					continue
 				}
-				if !ic.MatchesName(c.ClusterName) {
-					log.Printf("[INFO] Skipping %s because it doesn't match %s", c.ClusterName, ic.match)
+				if !ic.MatchesName("databricks_cluster", c.ClusterName) {
+					log.Printf("[INFO] Skipping %s because it doesn't match selection", c.ClusterName)
 					continue
 				}
-				if c.LastActivityTime > 0 && c.LastActivityTime < lastActiveMs {
+				if ic.isInactive(c.LastActivityTime) {
 					log.Printf("[INFO] Older inactive cluster %s", c.ClusterName)
 					continue
 				}
@@ -343,6 +367,7 @@ var resourcesMap map[string]importable = map[string]importable{
 		ApiVersion:     common.API_2_1,
 		WorkspaceLevel: true,
 		Service:        "jobs",
+		Filterable:     true,
 		Name: func(ic *importContext, d *schema.ResourceData) string {
 			name := d.Get("name").(string)
 			if name == "" {
@@ -401,6 +426,14 @@ var resourcesMap map[string]importable = map[string]importable{
 			{Path: "email_notifications.on_failure", Resource: "databricks_user", Match: "user_name", MatchType: MatchCaseInsensitive},
 			{Path: "email_notifications.on_start", Resource: "databricks_user", Match: "user_name", MatchType: MatchCaseInsensitive},
 			{Path: "email_notifications.on_success", Resource: "databricks_user", Match: "user_name", MatchType: MatchCaseInsensitive},
+			{Path: "webhook_notifications.on_duration_warning_threshold_exceeded.id", Resource: "databricks_notification_destination"},
+			{Path: "webhook_notifications.on_failure.id", Resource: "databricks_notification_destination"},
+			{Path: "webhook_notifications.on_start.id", Resource: "databricks_notification_destination"},
+			{Path: "webhook_notifications.on_success.id", Resource: "databricks_notification_destination"},
+			{Path: "task.webhook_notifications.on_duration_warning_threshold_exceeded.id", Resource: "databricks_notification_destination"},
+			{Path: "task.webhook_notifications.on_failure.id", Resource: "databricks_notification_destination"},
+			{Path: "task.webhook_notifications.on_start.id", Resource: "databricks_notification_destination"},
+			{Path: "task.webhook_notifications.on_success.id", Resource: "databricks_notification_destination"},
 			{Path: "task.library.whl", Resource: "databricks_repo", Match: "workspace_path", MatchType: MatchPrefix},
 			{Path: "task.new_cluster.init_scripts.workspace.destination", Resource: "databricks_repo", Match: "workspace_path", MatchType: MatchPrefix},
 			{Path: "task.notebook_task.base_parameters", Resource: "databricks_repo", Match: "workspace_path", MatchType: MatchPrefix},
@@ -513,6 +546,7 @@ var resourcesMap map[string]importable = map[string]importable{
 					ID:       task.ExistingClusterID,
 				})
 				ic.emitLibraries(task.Libraries)
+				ic.emitNotificationDestinations(task.WebhookNotifications)
 			}
 			for _, jc := range job.JobClusters {
 				ic.importCluster(jc.NewCluster)
@@ -531,6 +565,7 @@ var resourcesMap map[string]importable = map[string]importable{
 						Attribute: "application_id",
 						Value:     job.RunAs.ServicePrincipalName,
 					})
+					ic.emitServicePrincipalSecret(job.RunAs.ServicePrincipalName)
 				}
 			}
 			if job.EmailNotifications != nil {
@@ -539,6 +574,7 @@ var resourcesMap map[string]importable = map[string]importable{
 				ic.emitListOfUsers(job.EmailNotifications.OnStart)
 				ic.emitListOfUsers(job.EmailNotifications.OnSuccess)
 			}
+			ic.emitNotificationDestinations(job.WebhookNotifications)
 
 			return ic.importLibraries(r.Data, s)
 		},
@@ -607,7 +643,8 @@ var resourcesMap map[string]importable = map[string]importable{
 			numTasks := r.Data.Get("task.#").(int)
 			if numTasks == 0 {
 				log.Printf("[WARN] Ignoring job with ID %s", r.ID)
-				ic.addIgnoredResource(fmt.Sprintf("databricks_job. id=%s", r.ID))
+				ic.addIgnoredResource(ignoredResource{Resource: "databricks_job", Attribute: "id", Value: r.ID,
+					Reason: ignoreReasonEmpty, Message: "job doesn't have tasks"})
 			}
 			return numTasks == 0
 		},
@@ -615,6 +652,8 @@ var resourcesMap map[string]importable = map[string]importable{
 	"databricks_cluster_policy": {
 		WorkspaceLevel: true,
 		Service:        "policies",
+		Filterable:     true,
+		DataSource:     &dataSource{Attributes: map[string]string{"name": "name"}},
 		Name: func(ic *importContext, d *schema.ResourceData) string {
 			return d.Get("name").(string)
 		},
@@ -637,8 +676,8 @@ var resourcesMap map[string]importable = map[string]importable{
 					log.Printf("[DEBUG] Skipping builtin cluster policy '%s' without overrides", policy.Name)
 					continue
 				}
-				if !ic.MatchesName(policy.Name) {
-					log.Printf("[DEBUG] Policy %s doesn't match %s filter", policy.Name, ic.match)
+				if !ic.MatchesName("databricks_cluster_policy", policy.Name) {
+					log.Printf("[DEBUG] Policy %s doesn't match selection", policy.Name)
 					continue
 				}
 				ic.Emit(&resource{
@@ -755,6 +794,8 @@ var resourcesMap map[string]importable = map[string]importable{
 	},
 	"databricks_group": {
 		Service:        "groups",
+		Filterable:     true,
+		DataSource:     &dataSource{Attributes: map[string]string{"display_name": "display_name"}},
 		WorkspaceLevel: true,
 		AccountLevel:   true,
 		Name: func(ic *importContext, d *schema.ResourceData) string {
@@ -765,8 +806,8 @@ var resourcesMap map[string]importable = map[string]importable{
 				return err
 			}
 			for offset, g := range ic.allGroups {
-				if !ic.MatchesName(g.DisplayName) {
-					log.Printf("[INFO] Group %s doesn't match %s filter", g.DisplayName, ic.match)
+				if !ic.MatchesName("databricks_group", g.DisplayName) {
+					log.Printf("[INFO] Group %s doesn't match selection", g.DisplayName)
 					continue
 				}
 				ic.Emit(&resource{
@@ -791,8 +832,8 @@ var resourcesMap map[string]importable = map[string]importable{
 		},
 		Import: func(ic *importContext, r *resource) error {
 			groupName := r.Data.Get("display_name").(string)
-			if (!ic.accountLevel && (groupName == "admins" || groupName == "users")) ||
-				(ic.accountLevel && groupName == "account users") {
+			ic.emitEntitlements("group", r.ID, ic.Importables["databricks_group"].Name(ic, r.Data), r.Data)
+			if ic.isBuiltInGroup(groupName) {
 				// Workspace admins & users or Account users are to be imported through "data block"
 				r.Mode = "data"
 				r.Data.Set("workspace_access", false)
@@ -839,6 +880,11 @@ var resourcesMap map[string]importable = map[string]importable{
 					}
 				}
 				for i, x := range g.Members {
+					if (strings.HasPrefix(x.Ref, "Users/") || strings.HasPrefix(x.Ref, "ServicePrincipals/")) &&
+						!ic.isInGroupsFilter(x.Value) {
+						log.Printf("[DEBUG] Skipping member %s of %s that isn't in groups filter", x.Display, g.DisplayName)
+						continue
+					}
 					if strings.HasPrefix(x.Ref, "Users/") {
 						ic.Emit(&resource{
 							Resource: "databricks_user",
@@ -893,7 +939,13 @@ var resourcesMap map[string]importable = map[string]importable{
 
 			return nil
 		},
-		Body: resourceOrDataBlockBody,
+		ShouldOmitField: func(ic *importContext, pathString string, as *schema.Schema, d *schema.ResourceData) bool {
+			if ic.isEntitlementExportedSeparately(pathString) {
+				return true
+			}
+			return defaultShouldOmitFieldFunc(ic, pathString, as, d)
+		},
+		Body: identityBlockBody,
 	},
 	"databricks_group_member": {
 		Service:        "groups",
@@ -908,6 +960,8 @@ var resourcesMap map[string]importable = map[string]importable{
 	},
 	"databricks_user": {
 		Service:        "users",
+		Filterable:     true,
+		DataSource:     &dataSource{Attributes: map[string]string{"user_name": "user_name"}},
 		AccountLevel:   true,
 		WorkspaceLevel: true,
 		Name: func(ic *importContext, d *schema.ResourceData) string {
@@ -918,7 +972,31 @@ var resourcesMap map[string]importable = map[string]importable{
 			}
 			return nameNormalizationRegex.ReplaceAllString(strings.Split(s, "@")[0], "_") + "_" + d.Id()
 		},
-		// TODO: we need to add List operation here as well
+		List: func(ic *importContext) error {
+			// workspace-level export emits only users that are referenced from other objects
+			if !ic.accountLevel {
+				return nil
+			}
+			ic.getUsersMapping()
+			ic.allUsersMutex.RLocker().Lock()
+			users := maps.Clone(ic.allUsersMapping)
+			ic.allUsersMutex.RLocker().Unlock()
+			userNames := maps.Keys(users)
+			sort.Strings(userNames)
+			for i, userName := range userNames {
+				if !ic.MatchesName("databricks_user", userName) {
+					continue
+				}
+				ic.Emit(&resource{
+					Resource: "databricks_user",
+					ID:       users[userName],
+				})
+				if i%50 == 0 {
+					log.Printf("[INFO] Scanned %d of %d account users", i+1, len(userNames))
+				}
+			}
+			return nil
+		},
 		Search: func(ic *importContext, r *resource) error {
 			u, err := ic.findUserByName(r.Value, false)
 			if err != nil {
@@ -927,7 +1005,11 @@ var resourcesMap map[string]importable = map[string]importable{
 			r.ID = u.ID
 			return nil
 		},
+		Ignore: ignoreNotInGroupsFilter,
 		Import: func(ic *importContext, r *resource) error {
+			if !ic.isInGroupsFilter(r.ID) {
+				return nil
+			}
 			username := r.Data.Get("user_name").(string)
 			r.Data.Set("force", true)
 			u, err := ic.findUserByName(username, false)
@@ -936,6 +1018,7 @@ var resourcesMap map[string]importable = map[string]importable{
 			}
 			ic.emitGroups(u)
 			ic.emitRoles("user", u.ID, u.Roles)
+			ic.emitEntitlements("user", u.ID, ic.Importables["databricks_user"].Name(ic, r.Data), r.Data)
 			return nil
 		},
 		ShouldOmitField: func(ic *importContext, pathString string, as *schema.Schema, d *schema.ResourceData) bool {
@@ -944,11 +1027,17 @@ var resourcesMap map[string]importable = map[string]importable{
 				displayName := d.Get("display_name").(string)
 				return displayName == "" || userName == displayName
 			}
+			if ic.isEntitlementExportedSeparately(pathString) {
+				return true
+			}
 			return defaultShouldOmitFieldFunc(ic, pathString, as, d)
 		},
+		Body: identityBlockBody,
 	},
 	"databricks_service_principal": {
 		Service:        "users",
+		Filterable:     true,
+		DataSource:     &dataSource{Attributes: map[string]string{"application_id": "application_id"}},
 		AccountLevel:   true,
 		WorkspaceLevel: true,
 		Name: func(ic *importContext, d *schema.ResourceData) string {
@@ -961,7 +1050,31 @@ var resourcesMap map[string]importable = map[string]importable{
 			}
 			return name + "_" + d.Id()
 		},
-		// TODO: we need to add List operation here as well
+		List: func(ic *importContext) error {
+			// workspace-level export emits only service principals that are referenced from other objects
+			if !ic.accountLevel {
+				return nil
+			}
+			ic.getSpsMapping()
+			ic.spsMutex.RLocker().Lock()
+			sps := maps.Clone(ic.allSpsMapping)
+			ic.spsMutex.RLocker().Unlock()
+			applicationIDs := maps.Keys(sps)
+			sort.Strings(applicationIDs)
+			for i, applicationID := range applicationIDs {
+				if !ic.MatchesName("databricks_service_principal", applicationID) {
+					continue
+				}
+				ic.Emit(&resource{
+					Resource: "databricks_service_principal",
+					ID:       sps[applicationID],
+				})
+				if i%50 == 0 {
+					log.Printf("[INFO] Scanned %d of %d account service principals", i+1, len(applicationIDs))
+				}
+			}
+			return nil
+		},
 		Search: func(ic *importContext, r *resource) error {
 			u, err := ic.findSpnByAppID(r.Value, false)
 			if err != nil {
@@ -971,6 +1084,9 @@ var resourcesMap map[string]importable = map[string]importable{
 			return nil
 		},
 		ShouldOmitField: func(ic *importContext, pathString string, as *schema.Schema, d *schema.ResourceData) bool {
+			if ic.isEntitlementExportedSeparately(pathString) {
+				return true
+			}
 			if pathString == "display_name" {
 				if ic.Client.IsAzure() {
 					applicationID := d.Get("application_id").(string)
@@ -985,7 +1101,11 @@ var resourcesMap map[string]importable = map[string]importable{
 			}
 			return defaultShouldOmitFieldFunc(ic, pathString, as, d)
 		},
+		Ignore: ignoreNotInGroupsFilter,
 		Import: func(ic *importContext, r *resource) error {
+			if !ic.isInGroupsFilter(r.ID) {
+				return nil
+			}
 			applicationID := r.Data.Get("application_id").(string)
 			r.Data.Set("force", true)
 			u, err := ic.findSpnByAppID(applicationID, false)
@@ -994,6 +1114,7 @@ var resourcesMap map[string]importable = map[string]importable{
 			}
 			ic.emitGroups(u)
 			ic.emitRoles("service_principal", u.ID, u.Roles)
+			ic.emitEntitlements("spn", u.ID, ic.Importables["databricks_service_principal"].Name(ic, r.Data), r.Data)
 			if ic.accountLevel {
 				ic.Emit(&resource{
 					Resource: "databricks_access_control_rule_set",
@@ -1003,10 +1124,46 @@ var resourcesMap map[string]importable = map[string]importable{
 			}
 			return nil
 		},
+		Body: identityBlockBody,
+	},
+	"databricks_service_principal_secret": {
+		Service:        "users",
+		WorkspaceLevel: true,
+		CreateOnly:     true,
+		Name: func(ic *importContext, d *schema.ResourceData) string {
+			return "spn_" + d.Get("service_principal_id").(string)
+		},
+		Depends: []reference{
+			{Path: "service_principal_id", Resource: "databricks_service_principal"},
+		},
+		Body: generateServicePrincipalSecretBody,
+	},
+	"databricks_entitlements": {
+		Service:        "users",
+		WorkspaceLevel: true,
+		ResourceService: func(ic *importContext, r *resource) string {
+			// entitlements of groups are exported together with groups
+			if strings.HasPrefix(r.ID, "group/") {
+				return "groups"
+			}
+			return ""
+		},
+		Depends: []reference{
+			{Path: "user_id", Resource: "databricks_user"},
+			{Path: "service_principal_id", Resource: "databricks_service_principal"},
+			{Path: "group_id", Resource: "databricks_group"},
+		},
 	},
 	"databricks_permissions": {
 		Service:        "access",
 		WorkspaceLevel: true,
+		ResourceService: func(ic *importContext, r *resource) string {
+			// permissions of SQL warehouses are exported together with warehouses & global SQL config
+			if strings.HasPrefix(r.ID, "/sql/warehouses/") {
+				return "sql-endpoints"
+			}
+			return ""
+		},
 		Name: func(ic *importContext, d *schema.ResourceData) string {
 			s := strings.Split(d.Id(), "/")
 			return s[len(s)-1]
@@ -1020,8 +1177,9 @@ var resourcesMap map[string]importable = map[string]importable{
 			{Path: "sql_query_id", Resource: "databricks_sql_query"},
 			{Path: "sql_alert_id", Resource: "databricks_sql_alert"},
 			{Path: "sql_dashboard_id", Resource: "databricks_sql_dashboard"},
+			{Path: "dashboard_id", Resource: "databricks_dashboard"},
 			{Path: "sql_endpoint_id", Resource: "databricks_sql_endpoint"},
-			{Path: "registered_model_id", Resource: "databricks_mlflow_model"},
+			{Path: "registered_model_id", Resource: "databricks_mlflow_model", Match: "registered_model_id"},
 			{Path: "experiment_id", Resource: "databricks_mlflow_experiment"},
 			{Path: "repo_id", Resource: "databricks_repo"},
 			// TODO: can we fill _path component for it, and then match on user/SP home instead?
@@ -1035,7 +1193,16 @@ var resourcesMap map[string]importable = map[string]importable{
 			var permissions permissions.PermissionsEntity
 			s := ic.Resources["databricks_permissions"].Schema
 			common.DataToStructPointer(r.Data, s, &permissions)
-			return (len(permissions.AccessControlList) == 0)
+			if len(permissions.AccessControlList) == 0 {
+				return true
+			}
+			// permissions of notebooks & directories are often the same as of their parents
+			if ic.hasOnlyInheritedPermissions(r.ID, permissions.AccessControlList) {
+				ic.addIgnoredResource(ignoredResource{Resource: "databricks_permissions", Attribute: "id",
+					Value: r.ID, Reason: ignoreReasonInherited})
+				return true
+			}
+			return false
 		},
 		Import: func(ic *importContext, r *resource) error {
 			var permissions permissions.PermissionsEntity
@@ -1063,6 +1230,7 @@ var resourcesMap map[string]importable = map[string]importable{
 	},
 	"databricks_secret_scope": {
 		Service:        "secrets",
+		Filterable:     true,
 		WorkspaceLevel: true,
 		Name: func(ic *importContext, d *schema.ResourceData) string {
 			name := d.Get("name").(string)
@@ -1072,9 +1240,8 @@ var resourcesMap map[string]importable = map[string]importable{
 			ssAPI := secrets.NewSecretScopesAPI(ic.Context, ic.Client)
 			if scopes, err := ssAPI.List(); err == nil {
 				for i, scope := range scopes {
-					if !ic.MatchesName(scope.Name) {
-						log.Printf("[INFO] Secret scope %s doesn't match %s filter",
-							scope.Name, ic.match)
+					if !ic.MatchesName("databricks_secret_scope", scope.Name) {
+						log.Printf("[INFO] Secret scope %s doesn't match selection", scope.Name)
 						continue
 					}
 					ic.Emit(&resource{
@@ -1127,6 +1294,14 @@ var resourcesMap map[string]importable = map[string]importable{
 			name := fmt.Sprintf("%s_%s", d.Get("scope"), d.Get("key"))
 			return name + "_" + generateUniqueID(name)
 		},
+		ShouldOmitField: func(ic *importContext, pathString string, as *schema.Schema, d *schema.ResourceData) bool {
+			if pathString == "string_value" && ic.secretStore(d.Get("scope").(string)) != "" {
+				// value is referenced from the data source of the secret store
+				return true
+			}
+			return defaultShouldOmitFieldFunc(ic, pathString, as, d)
+		},
+		Body: generateSecretBody,
 	},
 	"databricks_secret_acl": {
 		WorkspaceLevel: true,
@@ -1141,6 +1316,7 @@ var resourcesMap map[string]importable = map[string]importable{
 	"databricks_mount": {
 		WorkspaceLevel: true,
 		Service:        "mounts",
+		Filterable:     true,
 		Body:           generateMountBody,
 		List: func(ic *importContext) error {
 			if !ic.mounts {
@@ -1150,7 +1326,7 @@ var resourcesMap map[string]importable = map[string]importable{
 				return err
 			}
 			for mountName, source := range ic.mountMap {
-				if !ic.MatchesName(mountName) {
+				if !ic.MatchesName("databricks_mount", mountName) {
 					continue
 				}
 				if strings.HasPrefix(source.URL, "s3a://") {
@@ -1285,6 +1461,10 @@ var resourcesMap map[string]importable = map[string]importable{
 				return err
 			}
 			for offset, repo := range objList {
+				if !ic.MatchesOwner(repoOwner(repo.Path)) {
+					log.Printf("[INFO] Repo %s isn't owned by any of -owner", repo.Path)
+					continue
+				}
 				if repo.Url != "" {
 					ic.Emit(&resource{
 						Resource: "databricks_repo",
@@ -1292,7 +1472,14 @@ var resourcesMap map[string]importable = map[string]importable{
 					})
 				} else {
 					log.Printf("[WARN] ignoring databricks_repo without Git provider. Path: %s", repo.Path)
-					ic.addIgnoredResource(fmt.Sprintf("databricks_repo. path=%s", repo.Path))
+					ic.addIgnoredResource(ignoredResource{Resource: "databricks_repo", Attribute: "path",
+						Value: repo.Path, Reason: ignoreReasonNoGitProvider})
+					// Git is the source of truth for other repos, so only content of these is exported
+					if ic.exportRepoContent {
+						if err := ic.emitRepoContent(repo.Path); err != nil {
+							log.Printf("[ERROR] can't export content of repo %s: %v", repo.Path, err)
+						}
+					}
 				}
 				log.Printf("[INFO] Scanned %d of %d repos", offset+1, len(objList))
 			}
@@ -1325,7 +1512,8 @@ var resourcesMap map[string]importable = map[string]importable{
 			if shouldIgnore {
 				path := r.Data.Get("path").(string)
 				log.Printf("[WARN] ignoring databricks_repo without Git provider. Path: %s", path)
-				ic.addIgnoredResource(fmt.Sprintf("databricks_repo. path=%s", r.Data.Get("path").(string)))
+				ic.addIgnoredResource(ignoredResource{Resource: "databricks_repo", Attribute: "path",
+					Value: path, Reason: ignoreReasonNoGitProvider})
 			}
 			return shouldIgnore
 		},
@@ -1376,25 +1564,154 @@ var resourcesMap map[string]importable = map[string]importable{
 				if v == "" {
 					continue
 				}
+				if !ic.includeDefaultConf && isDefaultWorkspaceConf(ic.workspaceConfKeys, k, v) {
+					log.Printf("[DEBUG] skipping workspace conf %s with default value %s", k, v)
+					continue
+				}
 				loaded[k] = v
 			}
 			r.Data.Set("custom_config", loaded)
 			return nil
 		},
 	},
+	"databricks_default_namespace_setting": {
+		WorkspaceLevel: true,
+		Service:        "workspace",
+		Name: func(ic *importContext, d *schema.ResourceData) string {
+			return "this"
+		},
+		List: func(ic *importContext) error {
+			// the setting is identified by its etag, so we need to read it first
+			setting, err := ic.workspaceClient.Settings.ReadDefaultWorkspaceNamespace(ic.Context,
+				settings.ReadDefaultWorkspaceNamespaceRequest{})
+			if errors.Is(err, apierr.ErrNotFound) {
+				ic.addIgnoredResource(ignoredResource{Resource: "databricks_default_namespace_setting",
+					Reason: ignoreReasonEmpty, Message: "setting was never changed from its default"})
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			ic.Emit(&resource{
+				Resource: "databricks_default_namespace_setting",
+				ID:       setting.Etag,
+			})
+			return nil
+		},
+	},
+	"databricks_token": {
+		WorkspaceLevel: true,
+		Service:        "tokens",
+		Filterable:     true,
+		// Read doesn't return lifetime_seconds, and import would plan replacement that revokes the token
+		CreateOnly: true,
+		Name:       tokenName,
+		List: func(ic *importContext) error {
+			if !ic.exportTokens {
+				return nil
+			}
+			me, err := ic.workspaceClient.CurrentUser.Me(ic.Context)
+			if err != nil {
+				return err
+			}
+			tokens, err := ic.workspaceClient.TokenManagement.ListAll(ic.Context, settings.ListTokenManagementRequest{})
+			if err != nil {
+				return err
+			}
+			for i, token := range tokens {
+				if !ic.MatchesName("databricks_token", token.Comment) {
+					continue
+				}
+				ownerID := strconv.FormatInt(token.OwnerId, 10)
+				switch {
+				case token.OwnerId != token.CreatedById:
+					// tokens created on behalf of service principals
+					ic.Emit(&resource{
+						Resource: "databricks_obo_token",
+						ID:       token.TokenId,
+					})
+				case ownerID == me.Id:
+					ic.Emit(&resource{
+						Resource: "databricks_token",
+						ID:       token.TokenId,
+					})
+				default:
+					// personal access tokens could be read only by their owners
+					ic.addIgnoredResource(ignoredResource{Resource: "databricks_token", Attribute: "token_id",
+						Value: token.TokenId, Reason: ignoreReasonNotFound,
+						Message: fmt.Sprintf("token belongs to %s", token.CreatedByUsername)})
+				}
+				log.Printf("[INFO] Scanned %d of %d tokens", i+1, len(tokens))
+			}
+			return nil
+		},
+		Import: func(ic *importContext, r *resource) error {
+			lifetime := tokenLifetimeSeconds(int64(r.Data.Get("creation_time").(int)),
+				int64(r.Data.Get("expiry_time").(int)))
+			if lifetime > 0 {
+				r.Data.Set("lifetime_seconds", lifetime)
+			}
+			return nil
+		},
+	},
+	"databricks_obo_token": {
+		WorkspaceLevel: true,
+		Service:        "tokens",
+		// Read doesn't return lifetime_seconds & application_id, and import would plan replacement
+		// that revokes the token
+		CreateOnly: true,
+		Name:       tokenName,
+		Import: func(ic *importContext, r *resource) error {
+			token, err := ic.workspaceClient.TokenManagement.GetByTokenId(ic.Context, r.ID)
+			if err != nil {
+				return err
+			}
+			if token.TokenInfo == nil {
+				return fmt.Errorf("no information about token %s", r.ID)
+			}
+			sp, err := ic.workspaceClient.ServicePrincipals.GetById(ic.Context,
+				strconv.FormatInt(token.TokenInfo.OwnerId, 10))
+			if err != nil {
+				return err
+			}
+			r.Data.Set("application_id", sp.ApplicationId)
+			ic.Emit(&resource{
+				Resource: "databricks_service_principal",
+				ID:       sp.Id,
+			})
+			lifetime := tokenLifetimeSeconds(token.TokenInfo.CreationTime, token.TokenInfo.ExpiryTime)
+			if lifetime > 0 {
+				r.Data.Set("lifetime_seconds", lifetime)
+			}
+			return nil
+		},
+		Depends: []reference{
+			{Path: "application_id", Resource: "databricks_service_principal", Match: "application_id"},
+		},
+		Body: generateOboTokenBody,
+	},
 	"databricks_ip_access_list": {
 		WorkspaceLevel: true,
+		AccountLevel:   true,
 		Service:        "access",
 		Name: func(ic *importContext, d *schema.ResourceData) string {
 			return d.Get("list_type").(string) + "_" + d.Get("label").(string)
 		},
 		List: func(ic *importContext) error {
-			ipListsResp, err := ic.workspaceClient.IpAccessLists.Impl().List(ic.Context)
-
+			var ipLists []settings.IpAccessListInfo
+			var err error
+			if ic.accountLevel {
+				ipLists, err = ic.accountClient.IpAccessLists.ListAll(ic.Context)
+			} else {
+				var ipListsResp *settings.ListIpAccessListResponse
+				ipListsResp, err = ic.workspaceClient.IpAccessLists.Impl().List(ic.Context)
+				if ipListsResp != nil {
+					ipLists = ipListsResp.IpAccessLists
+				}
+			}
 			if err != nil {
 				return err
 			}
-			ipLists := ipListsResp.IpAccessLists
 			updatedSinceMs := ic.getUpdatedSinceMs()
 			for offset, ipList := range ipLists {
 				modifiedAt := ipList.UpdatedAt
@@ -1409,7 +1726,8 @@ var resourcesMap map[string]importable = map[string]importable{
 				})
 				log.Printf("[INFO] Scanned %d of %d IP Access Lists", offset+1, len(ipLists))
 			}
-			if len(ipLists) > 0 {
+			// account console enforces IP access lists without additional configuration
+			if len(ipLists) > 0 && !ic.accountLevel {
 				ic.Emit(&resource{
 					Resource: "databricks_workspace_conf",
 					ID:       globalWorkspaceConfName,
@@ -1426,6 +1744,7 @@ var resourcesMap map[string]importable = map[string]importable{
 	"databricks_notebook": {
 		WorkspaceLevel: true,
 		Service:        "notebooks",
+		Filterable:     true,
 		Name:           workspaceObjectResouceName,
 		List:           listNotebooksAndWorkspaceFiles,
 		Import: func(ic *importContext, r *resource) error {
@@ -1446,12 +1765,17 @@ var resourcesMap map[string]importable = map[string]importable{
 			r.Data.Set("format", ic.notebooksFormat)
 			objectId := r.Data.Get("object_id").(int)
 			name := fileNameNormalizationRegex.ReplaceAllString(r.ID[1:], "_") + "_" + strconv.Itoa(objectId) + fileExtension
+			if ic.notebooksLayout == notebooksLayoutWorkspace {
+				name = workspacePathFileName(r.ID) + fileExtension
+			}
 			content, _ := base64.StdEncoding.DecodeString(contentB64)
 			fileName, err := ic.createFileIn("notebooks", name, []byte(content))
 			if err != nil {
 				return err
 			}
-			if ic.meAdmin {
+			// content of repos inherits permissions of the repo
+			isRepoContent := strings.HasPrefix(r.ID, "/Repos/")
+			if ic.meAdmin && !isRepoContent {
 				ic.Emit(&resource{
 					Resource: "databricks_permissions",
 					ID:       fmt.Sprintf("/notebooks/%d", objectId),
@@ -1461,7 +1785,7 @@ var resourcesMap map[string]importable = map[string]importable{
 
 			// TODO: it's not completely correct condition - we need to make emit smarter -
 			// emit only if permissions are different from their parent's permission.
-			if ic.meAdmin {
+			if ic.meAdmin && !isRepoContent {
 				directorySplits := strings.Split(r.ID, "/")
 				directorySplits = directorySplits[:len(directorySplits)-1]
 				directoryPath := strings.Join(directorySplits, "/")
@@ -1477,6 +1801,7 @@ var resourcesMap map[string]importable = map[string]importable{
 		ShouldOmitField: shouldOmitMd5Field,
 		Depends: []reference{
 			{Path: "source", File: true},
+			{Path: "path", Resource: "databricks_repo", Match: "path", MatchType: MatchPrefix},
 			// TODO: This should be the longest prefix, and avoid data source if possible - it should be done in the `reference` function
 			{Path: "path", Resource: "databricks_directory", MatchType: MatchPrefix},
 			{Path: "path", Resource: "databricks_user", Match: "home", MatchType: MatchPrefix},
@@ -1486,6 +1811,7 @@ var resourcesMap map[string]importable = map[string]importable{
 	"databricks_workspace_file": {
 		WorkspaceLevel: true,
 		Service:        "notebooks",
+		Filterable:     true,
 		Name:           workspaceObjectResouceName,
 		// We don't need list function for workspace files because it will be handled by the notebooks listing
 		// List: createListWorkspaceObjectsFunc(workspace.File, "databricks_workspace_file", "workspace_file"),
@@ -1505,13 +1831,18 @@ var resourcesMap map[string]importable = map[string]importable{
 				parts[plen-1] = parts[plen-1] + "_" + strconv.Itoa(objectId)
 			}
 			name := fileNameNormalizationRegex.ReplaceAllString(strings.Join(parts, "/")[1:], "_")
+			if ic.notebooksLayout == notebooksLayoutWorkspace {
+				name = workspacePathFileName(r.ID)
+			}
 			content, _ := base64.StdEncoding.DecodeString(contentB64)
 			fileName, err := ic.createFileIn("workspace_files", name, []byte(content))
 			if err != nil {
 				return err
 			}
 
-			if ic.meAdmin {
+			// content of repos inherits permissions of the repo
+			isRepoContent := strings.HasPrefix(r.ID, "/Repos/")
+			if ic.meAdmin && !isRepoContent {
 				ic.Emit(&resource{
 					Resource: "databricks_permissions",
 					ID:       fmt.Sprintf("/files/%d", objectId),
@@ -1521,7 +1852,7 @@ var resourcesMap map[string]importable = map[string]importable{
 
 			// TODO: it's not completely correct condition - we need to make emit smarter -
 			// emit only if permissions are different from their parent's permission.
-			if ic.meAdmin {
+			if ic.meAdmin && !isRepoContent {
 				directorySplits := strings.Split(r.ID, "/")
 				directorySplits = directorySplits[:len(directorySplits)-1]
 				directoryPath := strings.Join(directorySplits, "/")
@@ -1537,6 +1868,7 @@ var resourcesMap map[string]importable = map[string]importable{
 		ShouldOmitField: shouldOmitMd5Field,
 		Depends: []reference{
 			{Path: "source", File: true},
+			{Path: "path", Resource: "databricks_repo", Match: "path", MatchType: MatchPrefix},
 			// TODO: This should be the longest prefix, and avoid data source if possible - it should be done in the `reference` function
 			{Path: "path", Resource: "databricks_directory", MatchType: MatchPrefix},
 			{Path: "path", Resource: "databricks_user", Match: "home", MatchType: MatchPrefix},
@@ -1546,6 +1878,7 @@ var resourcesMap map[string]importable = map[string]importable{
 	"databricks_sql_query": {
 		WorkspaceLevel: true,
 		Service:        "sql-queries",
+		Filterable:     true,
 		Name: func(ic *importContext, d *schema.ResourceData) string {
 			return d.Get("name").(string) + "_" + d.Id()
 		},
@@ -1557,7 +1890,11 @@ var resourcesMap map[string]importable = map[string]importable{
 			updatedSinceStr := ic.getUpdatedSinceStr()
 			for i, q := range qs {
 				name := q["name"].(string)
-				if !ic.MatchesName(name) {
+				if !ic.MatchesName("databricks_sql_query", name) {
+					continue
+				}
+				if !ic.MatchesOwner(dbsqlObjectOwner(q)) {
+					log.Printf("[INFO] SQL query '%s' isn't owned by any of -owner", name)
 					continue
 				}
 				updatedAt := q["updated_at"].(string)
@@ -1566,6 +1903,11 @@ var resourcesMap map[string]importable = map[string]importable{
 						updatedAt, updatedSinceStr)
 					continue
 				}
+				if reason := ic.ownerSkipReason(dbsqlObjectOwner(q)); reason != "" {
+					ic.addIgnoredResource(ignoredResource{Resource: "databricks_sql_query", Attribute: "id",
+						Value: q["id"].(string), Reason: reason})
+					continue
+				}
 				log.Printf("[DEBUG] emitting query '%s' that was modified at %s (updatedSince=%s)", name,
 					updatedAt, updatedSinceStr)
 				ic.Emit(&resource{
@@ -1604,7 +1946,7 @@ var resourcesMap map[string]importable = map[string]importable{
 			if ic.meAdmin {
 				ic.Emit(&resource{
 					Resource: "databricks_permissions",
-					ID:       fmt.Sprintf("/sql/queries/%s", r.ID),
+					ID:       fmt.Sprintf("/queries/%s", r.ID),
 					Name:     "sql_query_" + ic.Importables["databricks_sql_query"].Name(ic, r.Data),
 				})
 			}
@@ -1620,6 +1962,8 @@ var resourcesMap map[string]importable = map[string]importable{
 	"databricks_sql_endpoint": {
 		WorkspaceLevel: true,
 		Service:        "sql-endpoints",
+		Filterable:     true,
+		DataSource:     &dataSource{Type: "databricks_sql_warehouse", Attributes: map[string]string{"name": "name"}},
 		Name: func(ic *importContext, d *schema.ResourceData) string {
 			name := d.Get("name").(string)
 			if name == "" {
@@ -1633,7 +1977,15 @@ var resourcesMap map[string]importable = map[string]importable{
 				return err
 			}
 			for i, q := range endpointsList {
-				if !ic.MatchesName(q.Name) {
+				if !ic.MatchesName("databricks_sql_endpoint", q.Name) {
+					continue
+				}
+				if ic.skipSystemObject(slices.Contains(starterWarehouseNames, q.Name),
+					"databricks_sql_endpoint", "name", q.Name) {
+					continue
+				}
+				if ic.isWarehouseInactive(q) {
+					log.Printf("[INFO] Skipping SQL warehouse %s without queries in the last %d days", q.Name, ic.lastActiveDays)
 					continue
 				}
 				ic.Emit(&resource{
@@ -1675,13 +2027,17 @@ var resourcesMap map[string]importable = map[string]importable{
 			return nil
 		},
 		Import: func(ic *importContext, r *resource) error {
-			arn := r.Data.Get("instance_profile_arn").(string)
-			if arn != "" {
+			var globalConfig tfsql.GlobalConfig
+			s := ic.Resources["databricks_sql_global_config"].Schema
+			common.DataToStructPointer(r.Data, s, &globalConfig)
+			if globalConfig.InstanceProfileARN != "" {
 				ic.Emit(&resource{
 					Resource: "databricks_instance_profile",
-					ID:       arn,
+					ID:       globalConfig.InstanceProfileARN,
 				})
 			}
+			ic.emitSecretsFromSecretsPath(globalConfig.DataAccessConfig)
+			ic.emitSecretsFromSecretsPath(globalConfig.SqlConfigParams)
 			return nil
 		},
 		Depends: []reference{
@@ -1691,6 +2047,7 @@ var resourcesMap map[string]importable = map[string]importable{
 	"databricks_sql_dashboard": {
 		WorkspaceLevel: true,
 		Service:        "sql-dashboards",
+		Filterable:     true,
 		Name: func(ic *importContext, d *schema.ResourceData) string {
 			return d.Get("name").(string) + "_" + d.Id()
 		},
@@ -1702,7 +2059,11 @@ var resourcesMap map[string]importable = map[string]importable{
 			updatedSinceStr := ic.getUpdatedSinceStr()
 			for i, q := range qs {
 				name := q["name"].(string)
-				if !ic.MatchesName(name) {
+				if !ic.MatchesName("databricks_sql_dashboard", name) {
+					continue
+				}
+				if !ic.MatchesOwner(dbsqlObjectOwner(q)) {
+					log.Printf("[INFO] SQL dashboard '%s' isn't owned by any of -owner", name)
 					continue
 				}
 				updatedAt := q["updated_at"].(string)
@@ -1711,6 +2072,11 @@ var resourcesMap map[string]importable = map[string]importable{
 						updatedAt, updatedSinceStr)
 					continue
 				}
+				if reason := ic.ownerSkipReason(dbsqlObjectOwner(q)); reason != "" {
+					ic.addIgnoredResource(ignoredResource{Resource: "databricks_sql_dashboard", Attribute: "id",
+						Value: q["id"].(string), Reason: reason})
+					continue
+				}
 				log.Printf("[DEBUG] emitting dashboard '%s' that was modified at %s (updatedSince=%s)", name,
 					updatedAt, updatedSinceStr)
 				ic.Emit(&resource{
@@ -1726,7 +2092,7 @@ var resourcesMap map[string]importable = map[string]importable{
 			if ic.meAdmin {
 				ic.Emit(&resource{
 					Resource: "databricks_permissions",
-					ID:       fmt.Sprintf("/sql/dashboards/%s", r.ID),
+					ID:       fmt.Sprintf("/dbsql-dashboards/%s", r.ID),
 					Name:     "sql_dashboard_" + ic.Importables["databricks_sql_dashboard"].Name(ic, r.Data),
 				})
 			}
@@ -1820,6 +2186,7 @@ var resourcesMap map[string]importable = map[string]importable{
 	"databricks_sql_alert": {
 		WorkspaceLevel: true,
 		Service:        "sql-alerts",
+		Filterable:     true,
 		Name: func(ic *importContext, d *schema.ResourceData) string {
 			return d.Get("name").(string) + "_" + d.Id()
 		},
@@ -1831,7 +2198,7 @@ var resourcesMap map[string]importable = map[string]importable{
 			}
 			for i, alert := range alerts {
 				name := alert.Name
-				if !ic.MatchesName(name) {
+				if !ic.MatchesName("databricks_sql_alert", name) {
 					continue
 				}
 				if ic.incremental && alert.UpdatedAt < updatedSinceStr {
@@ -1861,7 +2228,7 @@ var resourcesMap map[string]importable = map[string]importable{
 			if ic.meAdmin {
 				ic.Emit(&resource{
 					Resource: "databricks_permissions",
-					ID:       fmt.Sprintf("/sql/alerts/%s", r.ID),
+					ID:       fmt.Sprintf("/alerts/%s", r.ID),
 					Name:     "sql_alert_" + ic.Importables["databricks_sql_alert"].Name(ic, r.Data)})
 			}
 			return nil
@@ -1872,9 +2239,84 @@ var resourcesMap map[string]importable = map[string]importable{
 				MatchType: MatchRegexp, Regexp: sqlParentRegexp},
 		},
 	},
+	"databricks_dashboard": {
+		WorkspaceLevel: true,
+		Service:        "dashboards",
+		Filterable:     true,
+		Name: func(ic *importContext, d *schema.ResourceData) string {
+			return d.Get("display_name").(string) + "_" + d.Id()
+		},
+		List: func(ic *importContext) error {
+			dashboardsList, err := dashboards.NewDashboardAPI(ic.Context, ic.Client).List()
+			if err != nil {
+				return err
+			}
+			updatedSinceStr := ic.getUpdatedSinceStr()
+			for i, dashboard := range dashboardsList {
+				if dashboard.LifecycleState == "TRASHED" || !ic.MatchesName("databricks_dashboard", dashboard.DisplayName) {
+					continue
+				}
+				if ic.incremental && dashboard.UpdateTime < updatedSinceStr {
+					log.Printf("[DEBUG] skipping dashboard '%s' that was modified at %s (last active=%s)",
+						dashboard.DisplayName, dashboard.UpdateTime, updatedSinceStr)
+					continue
+				}
+				ic.Emit(&resource{
+					Resource:    "databricks_dashboard",
+					ID:          dashboard.DashboardID,
+					Incremental: ic.incremental,
+				})
+				log.Printf("[INFO] Imported %d of %d dashboards", i+1, len(dashboardsList))
+			}
+			return nil
+		},
+		Import: func(ic *importContext, r *resource) error {
+			dashboard, err := dashboards.NewDashboardAPI(ic.Context, ic.Client).Read(r.ID)
+			if err != nil {
+				return err
+			}
+			fileName, err := ic.createFileIn("dashboards", r.Name+".lvdash.json",
+				[]byte(dashboard.SerializedDashboard))
+			if err != nil {
+				return err
+			}
+			log.Printf("[INFO] Creating %s for %s", fileName, r)
+			if dashboard.WarehouseID != "" {
+				ic.Emit(&resource{
+					Resource: "databricks_sql_endpoint",
+					ID:       dashboard.WarehouseID,
+				})
+			}
+			parentPath := strings.TrimPrefix(dashboard.ParentPath, "/Workspace")
+			ic.emitUserOrServicePrincipalForPath(parentPath, "/Users")
+			if ic.meAdmin {
+				if parentPath != "" && parentPath != "/" {
+					ic.Emit(&resource{
+						Resource: "databricks_directory",
+						ID:       parentPath,
+					})
+				}
+				ic.Emit(&resource{
+					Resource: "databricks_permissions",
+					ID:       fmt.Sprintf("/dashboards/%s", r.ID),
+					Name:     "dashboard_" + ic.Importables["databricks_dashboard"].Name(ic, r.Data),
+				})
+			}
+			return r.Data.Set("serialized_dashboard_file", fileName)
+		},
+		ShouldOmitField: shouldOmitMd5Field,
+		Depends: []reference{
+			{Path: "serialized_dashboard_file", File: true},
+			{Path: "warehouse_id", Resource: "databricks_sql_endpoint"},
+			{Path: "parent_path", Resource: "databricks_directory"},
+			{Path: "parent_path", Resource: "databricks_user", Match: "home"},
+			{Path: "parent_path", Resource: "databricks_service_principal", Match: "home"},
+		},
+	},
 	"databricks_pipeline": {
 		WorkspaceLevel: true,
 		Service:        "dlt",
+		Filterable:     true,
 		Name: func(ic *importContext, d *schema.ResourceData) string {
 			name := d.Get("name").(string)
 			if name == "" {
@@ -1890,7 +2332,15 @@ var resourcesMap map[string]importable = map[string]importable{
 			}
 			updatedSinceMs := ic.getUpdatedSinceMs()
 			for i, q := range pipelinesList {
-				if !ic.MatchesName(q.Name) {
+				if !ic.MatchesName("databricks_pipeline", q.Name) {
+					continue
+				}
+				if ic.isInactive(pipelineLastActivityMs(q)) {
+					log.Printf("[INFO] Skipping DLT Pipeline %s without updates in the last %d days", q.Name, ic.lastActiveDays)
+					continue
+				}
+				if !ic.MatchesOwner(q.CreatorUserName, q.RunAsUserName) {
+					log.Printf("[INFO] DLT Pipeline %s isn't created by or running as any of -owner", q.Name)
 					continue
 				}
 				if ic.incremental {
@@ -1914,6 +2364,10 @@ var resourcesMap map[string]importable = map[string]importable{
 			return nil
 		},
 		Import: func(ic *importContext, r *resource) error {
+			if isBundlePipeline(r.Data) && !ic.includeBundleManaged {
+				// dependencies of bundle-managed pipelines are deployed by bundles as well
+				return nil
+			}
 			var pipeline pipelines.PipelineSpec
 			s := ic.Resources["databricks_pipeline"].Schema
 			common.DataToStructPointer(r.Data, s, &pipeline)
@@ -1959,6 +2413,16 @@ var resourcesMap map[string]importable = map[string]importable{
 			}
 			ic.emitFilesFromMap(pipeline.Configuration)
 			ic.emitSecretsFromSecretsPath(pipeline.Configuration)
+			if ic.Client.Config.AccountID != "" {
+				// run_as isn't a part of the pipeline specification, so it's checked only when secrets could be exported
+				p, err := ic.workspaceClient.Pipelines.GetByPipelineId(ic.Context, r.ID)
+				if err != nil {
+					return err
+				}
+				if common.StringIsUUID(p.RunAsUserName) {
+					ic.emitServicePrincipalSecret(p.RunAsUserName)
+				}
+			}
 
 			if ic.meAdmin {
 				ic.Emit(&resource{
@@ -1973,16 +2437,18 @@ var resourcesMap map[string]importable = map[string]importable{
 			if res := dltClusterRegex.FindStringSubmatch(pathString); res != nil { // analyze DLT clusters
 				return makeShouldOmitFieldForCluster(dltClusterRegex)(ic, pathString, as, d)
 			}
-			if pathString == "storage" {
-				return dltDefaultStorageRegex.FindStringSubmatch(d.Get("storage").(string)) != nil
-			}
 			return pathString == "creator_user_name" || defaultShouldOmitFieldFunc(ic, pathString, as, d)
 		},
 		Ignore: func(ic *importContext, r *resource) bool {
+			if ic.skipBundleManaged(isBundlePipeline(r.Data), "databricks_pipeline", "id", r.ID,
+				r.Data.Get("deployment.0.metadata_file_path").(string)) {
+				return true
+			}
 			numLibraries := r.Data.Get("library.#").(int)
 			if numLibraries == 0 {
 				log.Printf("[WARN] Ignoring DLT Pipeline with ID %s", r.ID)
-				ic.addIgnoredResource(fmt.Sprintf("databricks_pipeline. id=%s", r.ID))
+				ic.addIgnoredResource(ignoredResource{Resource: "databricks_pipeline", Attribute: "id", Value: r.ID,
+					Reason: ignoreReasonEmpty, Message: "pipeline doesn't have libraries"})
 			}
 			return numLibraries == 0
 		},
@@ -2107,6 +2573,17 @@ var resourcesMap map[string]importable = map[string]importable{
 					Name:     "serving_endpoint_" + ic.Importables["databricks_model_serving"].Name(ic, r.Data),
 				})
 			}
+			servedModels := r.Data.Get("config.0.served_models").([]any)
+			for _, sm := range servedModels {
+				modelName := sm.(map[string]any)["model_name"].(string)
+				// models in UC have three-level names, other models are in workspace model registry
+				if strings.Count(modelName, ".") == 2 {
+					ic.Emit(&resource{
+						Resource: "databricks_registered_model",
+						ID:       modelName,
+					})
+				}
+			}
 			return nil
 		},
 		ShouldOmitField: func(ic *importContext, pathString string, as *schema.Schema, d *schema.ResourceData) bool {
@@ -2117,54 +2594,302 @@ var resourcesMap map[string]importable = map[string]importable{
 			}
 			return defaultShouldOmitFieldFunc(ic, pathString, as, d)
 		},
+		Depends: []reference{
+			{Path: "config.served_models.model_name", Resource: "databricks_registered_model"},
+		},
 	},
-	"databricks_mlflow_webhook": {
+	"databricks_vector_search_endpoint": {
 		WorkspaceLevel: true,
-		Service:        "mlflow-webhooks",
-		Name: func(ic *importContext, d *schema.ResourceData) string {
-			return "webhook_" + d.Id()
-		},
+		Service:        "vector-search",
 		List: func(ic *importContext) error {
-			webhooks, err := ic.workspaceClient.ModelRegistry.ListWebhooksAll(ic.Context, ml.ListWebhooksRequest{})
+			endpoints, err := ic.workspaceClient.VectorSearchEndpoints.ListEndpointsAll(ic.Context,
+				vectorsearch.ListEndpointsRequest{})
 			if err != nil {
 				return err
 			}
-
 			updatedSinceMs := ic.getUpdatedSinceMs()
-			for offset, webhook := range webhooks {
-				modifiedAt := webhook.LastUpdatedTimestamp
-				if ic.incremental && modifiedAt < updatedSinceMs {
-					log.Printf("[DEBUG] skipping MLflow webhook '%s' that was modified at %d (last active=%d)",
-						webhook.Id, modifiedAt, updatedSinceMs)
+			for offset, endpoint := range endpoints {
+				if ic.incremental && endpoint.LastUpdatedTimestamp < updatedSinceMs {
+					log.Printf("[DEBUG] skipping vector search endpoint '%s' that was modified at %d (last active=%d)",
+						endpoint.Name, endpoint.LastUpdatedTimestamp, updatedSinceMs)
 					continue
 				}
-				log.Printf("[DEBUG] emitting MLflow webhook '%s' that was modified at %d (last active=%d)",
-					webhook.Id, modifiedAt, updatedSinceMs)
 				ic.Emit(&resource{
-					Resource: "databricks_mlflow_webhook",
-					ID:       webhook.Id,
+					Resource: "databricks_vector_search_endpoint",
+					ID:       endpoint.Name,
 				})
-				if webhook.JobSpec != nil && webhook.JobSpec.JobId != "" {
-					ic.Emit(&resource{
-						Resource: "databricks_job",
-						ID:       webhook.JobSpec.JobId,
-					})
-				}
 				if offset%50 == 0 {
-					log.Printf("[INFO] Scanned %d of %d MLflow webhooks", offset+1, len(webhooks))
+					log.Printf("[INFO] Scanned %d of %d Vector Search Endpoints", offset+1, len(endpoints))
 				}
 			}
 			return nil
 		},
-		Depends: []reference{
-			{Path: "job_spec.job_id", Resource: "databricks_job"},
-			{Path: "job_spec.access_token", Variable: true},
-			// We can enable it, but we don't know if authorization is set or not because API doesn't return it
-			// {Path: "http_url_spec.authorization", Variable: true},
+		Import: func(ic *importContext, r *resource) error {
+			indexes, err := ic.workspaceClient.VectorSearchIndexes.ListIndexesAll(ic.Context,
+				vectorsearch.ListIndexesRequest{EndpointName: r.ID})
+			if err != nil {
+				return err
+			}
+			for _, index := range indexes {
+				ic.Emit(&resource{
+					Resource: "databricks_vector_search_index",
+					ID:       index.Name,
+				})
+			}
+			return nil
 		},
 	},
-	"databricks_access_control_rule_set": {
-		AccountLevel: true,
+	"databricks_vector_search_index": {
+		WorkspaceLevel: true,
+		Service:        "vector-search",
+		Import: func(ic *importContext, r *resource) error {
+			ic.Emit(&resource{
+				Resource: "databricks_vector_search_endpoint",
+				ID:       r.Data.Get("endpoint_name").(string),
+			})
+			if sourceTable := r.Data.Get("delta_sync_index_spec.0.source_table").(string); sourceTable != "" {
+				ic.Emit(&resource{
+					Resource: "databricks_sql_table",
+					ID:       sourceTable,
+				})
+			}
+			columns := r.Data.Get("delta_sync_index_spec.0.embedding_source_columns").([]any)
+			for _, column := range columns {
+				configs := column.(map[string]any)["embedding_config"].([]any)
+				for _, config := range configs {
+					endpointName := config.(map[string]any)["embedding_model_endpoint_name"].(string)
+					if endpointName != "" {
+						ic.Emit(&resource{
+							Resource: "databricks_model_serving",
+							ID:       endpointName,
+						})
+					}
+				}
+			}
+			return nil
+		},
+		Depends: []reference{
+			{Path: "endpoint_name", Resource: "databricks_vector_search_endpoint"},
+			{Path: "delta_sync_index_spec.source_table", Resource: "databricks_sql_table"},
+			{Path: "delta_sync_index_spec.embedding_source_columns.embedding_config.embedding_model_endpoint_name",
+				Resource: "databricks_model_serving"},
+		},
+	},
+	"databricks_mlflow_experiment": {
+		WorkspaceLevel: true,
+		Service:        "mlflow",
+		Filterable:     true,
+		Name: func(ic *importContext, d *schema.ResourceData) string {
+			return path.Base(d.Get("name").(string)) + "_" + d.Id()
+		},
+		List: func(ic *importContext) error {
+			experiments, err := ic.workspaceClient.Experiments.ListExperimentsAll(ic.Context,
+				ml.ListExperimentsRequest{})
+			if err != nil {
+				return err
+			}
+			updatedSinceMs := ic.getUpdatedSinceMs()
+			for offset, experiment := range experiments {
+				if !ic.MatchesName("databricks_mlflow_experiment", experiment.Name) {
+					continue
+				}
+				if isNotebookExperiment(experiment) {
+					log.Printf("[DEBUG] skipping experiment '%s' of notebook", experiment.Name)
+					ic.addIgnoredResource(ignoredResource{Resource: "databricks_mlflow_experiment",
+						Attribute: "id", Value: experiment.ExperimentId, Reason: ignoreReasonNotebookBound})
+					continue
+				}
+				if ic.incremental && experiment.LastUpdateTime < updatedSinceMs {
+					log.Printf("[DEBUG] skipping MLflow experiment '%s' that was modified at %d (last active=%d)",
+						experiment.Name, experiment.LastUpdateTime, updatedSinceMs)
+					continue
+				}
+				if ic.isInactive(experiment.LastUpdateTime) {
+					log.Printf("[INFO] Skipping MLflow experiment %s not updated in the last %d days",
+						experiment.Name, ic.lastActiveDays)
+					continue
+				}
+				ic.Emit(&resource{
+					Resource: "databricks_mlflow_experiment",
+					ID:       experiment.ExperimentId,
+				})
+				if offset%50 == 0 {
+					log.Printf("[INFO] Scanned %d of %d MLflow experiments", offset+1, len(experiments))
+				}
+			}
+			return nil
+		},
+		Import: func(ic *importContext, r *resource) error {
+			ic.emitUserOrServicePrincipalForPath(r.Data.Get("name").(string), "/Users")
+			if ic.meAdmin {
+				ic.Emit(&resource{
+					Resource: "databricks_permissions",
+					ID:       fmt.Sprintf("/experiments/%s", r.ID),
+					Name:     "experiment_" + ic.Importables["databricks_mlflow_experiment"].Name(ic, r.Data),
+				})
+			}
+			return nil
+		},
+		ShouldOmitField: func(ic *importContext, pathString string, as *schema.Schema, d *schema.ResourceData) bool {
+			if pathString == "artifact_location" {
+				// default location is generated from the experiment ID
+				return strings.HasPrefix(d.Get(pathString).(string), "dbfs:/databricks/mlflow-tracking/")
+			}
+			return defaultShouldOmitFieldFunc(ic, pathString, as, d)
+		},
+		Depends: []reference{
+			{Path: "name", Resource: "databricks_user", Match: "home", MatchType: MatchPrefix},
+			{Path: "name", Resource: "databricks_service_principal", Match: "home", MatchType: MatchPrefix},
+		},
+	},
+	"databricks_mlflow_model": {
+		WorkspaceLevel: true,
+		Service:        "mlflow",
+		Filterable:     true,
+		Name: func(ic *importContext, d *schema.ResourceData) string {
+			return d.Id()
+		},
+		List: func(ic *importContext) error {
+			models, err := ic.workspaceClient.ModelRegistry.ListModelsAll(ic.Context, ml.ListModelsRequest{})
+			if err != nil {
+				return err
+			}
+			updatedSinceMs := ic.getUpdatedSinceMs()
+			for offset, model := range models {
+				if !ic.MatchesName("databricks_mlflow_model", model.Name) {
+					continue
+				}
+				if ic.incremental && model.LastUpdatedTimestamp < updatedSinceMs {
+					log.Printf("[DEBUG] skipping MLflow model '%s' that was modified at %d (last active=%d)",
+						model.Name, model.LastUpdatedTimestamp, updatedSinceMs)
+					continue
+				}
+				ic.Emit(&resource{
+					Resource: "databricks_mlflow_model",
+					ID:       model.Name,
+				})
+				if offset%50 == 0 {
+					log.Printf("[INFO] Scanned %d of %d MLflow models", offset+1, len(models))
+				}
+			}
+			return nil
+		},
+		Import: func(ic *importContext, r *resource) error {
+			if ic.meAdmin {
+				ic.Emit(&resource{
+					Resource: "databricks_permissions",
+					ID:       fmt.Sprintf("/registered-models/%s", r.Data.Get("registered_model_id").(string)),
+					Name:     "mlflow_model_" + ic.Importables["databricks_mlflow_model"].Name(ic, r.Data),
+				})
+			}
+			return nil
+		},
+	},
+	"databricks_notification_destination": {
+		WorkspaceLevel: true,
+		Service:        "notification-destinations",
+		Filterable:     true,
+		Name: func(ic *importContext, d *schema.ResourceData) string {
+			return d.Get("display_name").(string) + "_" + d.Id()
+		},
+		List: func(ic *importContext) error {
+			destinations, err := tfsettings.NewNotificationDestinationsAPI(ic.Context, ic.Client).List()
+			if err != nil {
+				return err
+			}
+			for i, destination := range destinations {
+				if !ic.MatchesName("databricks_notification_destination", destination.DisplayName) {
+					continue
+				}
+				ic.Emit(&resource{
+					Resource: "databricks_notification_destination",
+					ID:       destination.ID,
+				})
+				log.Printf("[INFO] Scanned %d of %d notification destinations", i+1, len(destinations))
+			}
+			return nil
+		},
+		Import: func(ic *importContext, r *resource) error {
+			var nd tfsettings.NotificationDestination
+			s := ic.Resources["databricks_notification_destination"].Schema
+			common.DataToStructPointer(r.Data, s, &nd)
+			if nd.Config != nil && nd.Config.Email != nil {
+				ic.emitListOfUsers(nd.Config.Email.Addresses)
+			}
+			return nil
+		},
+		ShouldOmitField: func(ic *importContext, pathString string, as *schema.Schema, d *schema.ResourceData) bool {
+			// secrets aren't returned by the API, so we generate variables only for secrets that are set
+			if as.Sensitive {
+				isSet, _ := d.Get(pathString + "_set").(bool)
+				return !isSet
+			}
+			return defaultShouldOmitFieldFunc(ic, pathString, as, d)
+		},
+		Depends: []reference{
+			{Path: "config.email.addresses", Resource: "databricks_user", Match: "user_name", MatchType: MatchCaseInsensitive},
+			{Path: "config.slack.url", Variable: true},
+			{Path: "config.generic_webhook.url", Variable: true},
+			{Path: "config.generic_webhook.username", Variable: true},
+			{Path: "config.generic_webhook.password", Variable: true},
+			{Path: "config.pagerduty.integration_key", Variable: true},
+			{Path: "config.microsoft_teams.url", Variable: true},
+		},
+	},
+	"databricks_mlflow_webhook": {
+		WorkspaceLevel: true,
+		Service:        "mlflow-webhooks",
+		Name: func(ic *importContext, d *schema.ResourceData) string {
+			return "webhook_" + d.Id()
+		},
+		List: func(ic *importContext) error {
+			webhooks, err := ic.workspaceClient.ModelRegistry.ListWebhooksAll(ic.Context, ml.ListWebhooksRequest{})
+			if err != nil {
+				return err
+			}
+
+			updatedSinceMs := ic.getUpdatedSinceMs()
+			for offset, webhook := range webhooks {
+				modifiedAt := webhook.LastUpdatedTimestamp
+				if ic.incremental && modifiedAt < updatedSinceMs {
+					log.Printf("[DEBUG] skipping MLflow webhook '%s' that was modified at %d (last active=%d)",
+						webhook.Id, modifiedAt, updatedSinceMs)
+					continue
+				}
+				log.Printf("[DEBUG] emitting MLflow webhook '%s' that was modified at %d (last active=%d)",
+					webhook.Id, modifiedAt, updatedSinceMs)
+				ic.Emit(&resource{
+					Resource: "databricks_mlflow_webhook",
+					ID:       webhook.Id,
+				})
+				if webhook.JobSpec != nil && webhook.JobSpec.JobId != "" {
+					ic.Emit(&resource{
+						Resource: "databricks_job",
+						ID:       webhook.JobSpec.JobId,
+					})
+				}
+				if webhook.ModelName != "" {
+					ic.Emit(&resource{
+						Resource: "databricks_mlflow_model",
+						ID:       webhook.ModelName,
+					})
+				}
+				if offset%50 == 0 {
+					log.Printf("[INFO] Scanned %d of %d MLflow webhooks", offset+1, len(webhooks))
+				}
+			}
+			return nil
+		},
+		Depends: []reference{
+			{Path: "job_spec.job_id", Resource: "databricks_job"},
+			{Path: "job_spec.access_token", Variable: true},
+			{Path: "model_name", Resource: "databricks_mlflow_model"},
+			// We can enable it, but we don't know if authorization is set or not because API doesn't return it
+			// {Path: "http_url_spec.authorization", Variable: true},
+		},
+	},
+	"databricks_access_control_rule_set": {
+		AccountLevel: true,
 		Service:      "access",
 		List: func(ic *importContext) error {
 			accountId := ic.Client.Config.AccountID
@@ -2231,67 +2956,768 @@ var resourcesMap map[string]importable = map[string]importable{
 			shouldIgnore := len(rule.GrantRules) == 0
 			if shouldIgnore {
 				log.Printf("[WARN] ignoring databricks_access_control_rule_set without grant rules. ID: %s", r.ID)
-				ic.addIgnoredResource(fmt.Sprintf("databricks_access_control_rule_set. ID=%s", r.ID))
+				ic.addIgnoredResource(ignoredResource{Resource: "databricks_access_control_rule_set", Attribute: "ID",
+					Value: r.ID, Reason: ignoreReasonEmpty, Message: "rule set doesn't have grant rules"})
 			}
 			return shouldIgnore
 		},
 	},
-	"databricks_system_schema": {
-		WorkspaceLevel: true,
-		Service:        "uc-system-schemas",
+	"databricks_mws_permission_assignment": {
+		AccountLevel: true,
+		Service:      "idfed",
 		List: func(ic *importContext) error {
-			if ic.currentMetastore == nil {
-				return fmt.Errorf("there is no UC metastore information")
-			}
-			currentMetastore := ic.currentMetastore.MetastoreId
-			systemSchemas, err := ic.workspaceClient.SystemSchemas.ListAll(ic.Context,
-				catalog.ListSystemSchemasRequest{MetastoreId: currentMetastore})
+			workspaces, err := ic.accountClient.Workspaces.List(ic.Context)
 			if err != nil {
 				return err
 			}
-			for _, v := range systemSchemas {
-				if v.State == catalog.SystemSchemaInfoStateEnableCompleted || v.State == catalog.SystemSchemaInfoStateEnableInitialized {
-					id := fmt.Sprintf("%s|%s", currentMetastore, v.Schema)
-					data := ic.Resources["databricks_system_schema"].Data(
-						&terraform.InstanceState{
-							ID: id,
-							Attributes: map[string]string{
-								"metastore_id": currentMetastore,
-								"schema":       v.Schema,
-							},
+			for _, ws := range workspaces {
+				if ws.WorkspaceStatus != provisioning.WorkspaceStatusRunning {
+					log.Printf("[INFO] Skipping permission assignments of workspace %d with status %s",
+						ws.WorkspaceId, ws.WorkspaceStatus)
+					continue
+				}
+				assignments, err := ic.accountClient.WorkspaceAssignment.ListByWorkspaceId(ic.Context, ws.WorkspaceId)
+				if err != nil {
+					// permission assignments are available only for identity-federated workspaces
+					log.Printf("[WARN] Can't list permission assignments of workspace %d: %s", ws.WorkspaceId, err.Error())
+					continue
+				}
+				for _, pa := range assignments.PermissionAssignments {
+					if pa.Principal == nil || pa.Error != "" {
+						continue
+					}
+					principalId := strconv.FormatInt(pa.Principal.PrincipalId, 10)
+					principalName := pa.Principal.DisplayName
+					switch {
+					case pa.Principal.UserName != "":
+						principalName = pa.Principal.UserName
+						ic.Emit(&resource{
+							Resource: "databricks_user",
+							ID:       principalId,
+						})
+					case pa.Principal.ServicePrincipalName != "":
+						principalName = pa.Principal.ServicePrincipalName
+						ic.Emit(&resource{
+							Resource: "databricks_service_principal",
+							ID:       principalId,
 						})
+					case pa.Principal.GroupName != "":
+						principalName = pa.Principal.GroupName
+						ic.Emit(&resource{
+							Resource: "databricks_group",
+							ID:       principalId,
+						})
+					}
 					ic.Emit(&resource{
-						Resource: "databricks_system_schema",
-						ID:       id,
-						Data:     data,
-						Name:     nameNormalizationRegex.ReplaceAllString(id, "_"),
+						Resource: "databricks_mws_permission_assignment",
+						ID:       fmt.Sprintf("%d|%s", ws.WorkspaceId, principalId),
+						Name:     fmt.Sprintf("%d_%s", ws.WorkspaceId, principalName),
 					})
-				} else {
-					log.Printf("[DEBUG] Skipping system schema %s with state %s", v.Schema, v.State)
 				}
 			}
 			return nil
 		},
+		Depends: []reference{
+			{Path: "principal_id", Resource: "databricks_user"},
+			{Path: "principal_id", Resource: "databricks_service_principal"},
+			{Path: "principal_id", Resource: "databricks_group"},
+		},
 	},
-	"databricks_artifact_allowlist": {
-		WorkspaceLevel: true,
-		Service:        "uc-artifact-allowlist",
+	"databricks_generic_setting": {
+		AccountLevel: true,
+		Service:      "settings",
 		List: func(ic *importContext) error {
-			if ic.currentMetastore == nil {
-				return fmt.Errorf("there is no UC metastore information")
+			for _, settingType := range accountSettingTypes {
+				ic.Emit(&resource{
+					Resource: "databricks_generic_setting",
+					ID:       settingType,
+				})
 			}
-			artifactTypes := []string{"INIT_SCRIPT", "LIBRARY_JAR", "LIBRARY_MAVEN"}
-			for _, v := range artifactTypes {
-				id := fmt.Sprintf("%s|%s", ic.currentMetastore.MetastoreId, v)
-				name := fmt.Sprintf("%s_%s_%s", v, ic.currentMetastore.Name, ic.currentMetastore.MetastoreId[:8])
+			return nil
+		},
+		Name: func(ic *importContext, d *schema.ResourceData) string {
+			return d.Id()
+		},
+		Ignore: func(ic *importContext, r *resource) bool {
+			// settings that were never written have no value
+			shouldIgnore := r.Data.Get("value").(string) == "{}"
+			if shouldIgnore {
+				ic.addIgnoredResource(ignoredResource{Resource: "databricks_generic_setting", Attribute: "ID",
+					Value: r.ID, Reason: ignoreReasonEmpty, Message: "setting was never changed from its default"})
+			}
+			return shouldIgnore
+		},
+	},
+	"databricks_budget": {
+		AccountLevel: true,
+		Service:      "billing",
+		Filterable:   true,
+		Name: func(ic *importContext, d *schema.ResourceData) string {
+			return d.Get("name").(string) + "_" + d.Id()
+		},
+		List: func(ic *importContext) error {
+			budgets, err := ic.accountClient.Budgets.ListAll(ic.Context)
+			if err != nil {
+				return err
+			}
+			for i, budget := range budgets {
+				if !ic.MatchesName("databricks_budget", budget.Name) {
+					continue
+				}
 				ic.Emit(&resource{
-					Resource: "databricks_artifact_allowlist",
-					ID:       id,
-					Name:     nameNormalizationRegex.ReplaceAllString(name, "_"),
+					Resource: "databricks_budget",
+					ID:       budget.BudgetId,
 				})
+				log.Printf("[INFO] Scanned %d of %d budgets", i+1, len(budgets))
 			}
 			return nil
 		},
-		// TODO: add Depends & Import to emit corresponding UC Volumes when support for them is added
+		Import: func(ic *importContext, r *resource) error {
+			var budget mws.Budget
+			common.DataToStructPointer(r.Data, ic.Resources["databricks_budget"].Schema, &budget)
+			for _, alert := range budget.Alerts {
+				ic.emitListOfUsers(alert.EmailNotifications)
+			}
+			return nil
+		},
+		Depends: []reference{
+			{Path: "alerts.email_notifications", Resource: "databricks_user", Match: "user_name",
+				MatchType: MatchCaseInsensitive},
+		},
 	},
+	"databricks_mws_workspaces": {
+		AccountLevel: true,
+		Service:      "mws",
+		Filterable:   true,
+		Name: func(ic *importContext, d *schema.ResourceData) string {
+			return d.Get("workspace_name").(string)
+		},
+		List: func(ic *importContext) error {
+			workspaces, err := ic.accountClient.Workspaces.List(ic.Context)
+			if err != nil {
+				return err
+			}
+			for i, ws := range workspaces {
+				if !ic.MatchesName("databricks_mws_workspaces", ws.WorkspaceName) {
+					continue
+				}
+				if ws.WorkspaceStatus != provisioning.WorkspaceStatusRunning {
+					// reading of the workspace waits until it's running, so it can't be exported
+					log.Printf("[INFO] Skipping workspace %d with status %s", ws.WorkspaceId, ws.WorkspaceStatus)
+					continue
+				}
+				ic.Emit(&resource{
+					Resource: "databricks_mws_workspaces",
+					ID:       fmt.Sprintf("%s/%d", ic.Client.Config.AccountID, ws.WorkspaceId),
+				})
+				log.Printf("[INFO] Scanned %d of %d workspaces", i+1, len(workspaces))
+			}
+			return nil
+		},
+		Import: func(ic *importContext, r *resource) error {
+			accountID := ic.Client.Config.AccountID
+			for _, dep := range []struct {
+				resource  string
+				attribute string
+			}{
+				{"databricks_mws_credentials", "credentials_id"},
+				{"databricks_mws_storage_configurations", "storage_configuration_id"},
+				{"databricks_mws_networks", "network_id"},
+				{"databricks_mws_private_access_settings", "private_access_settings_id"},
+			} {
+				id := r.Data.Get(dep.attribute).(string)
+				if id == "" {
+					continue
+				}
+				ic.Emit(&resource{
+					Resource: dep.resource,
+					ID:       fmt.Sprintf("%s/%s", accountID, id),
+				})
+			}
+			return nil
+		},
+		Depends: []reference{
+			{Path: "credentials_id", Resource: "databricks_mws_credentials", Match: "credentials_id"},
+			{Path: "storage_configuration_id", Resource: "databricks_mws_storage_configurations",
+				Match: "storage_configuration_id"},
+			{Path: "network_id", Resource: "databricks_mws_networks", Match: "network_id"},
+			{Path: "private_access_settings_id", Resource: "databricks_mws_private_access_settings",
+				Match: "private_access_settings_id"},
+		},
+	},
+	"databricks_mws_credentials": {
+		AccountLevel: true,
+		Service:      "mws",
+		Filterable:   true,
+		Name: func(ic *importContext, d *schema.ResourceData) string {
+			return d.Get("credentials_name").(string)
+		},
+		List: func(ic *importContext) error {
+			credentials, err := ic.accountClient.Credentials.List(ic.Context)
+			if err != nil {
+				return err
+			}
+			for _, cred := range credentials {
+				if !ic.MatchesName("databricks_mws_credentials", cred.CredentialsName) {
+					continue
+				}
+				ic.Emit(&resource{
+					Resource: "databricks_mws_credentials",
+					ID:       fmt.Sprintf("%s/%s", ic.Client.Config.AccountID, cred.CredentialsId),
+				})
+			}
+			return nil
+		},
+	},
+	"databricks_mws_storage_configurations": {
+		AccountLevel: true,
+		Service:      "mws",
+		Filterable:   true,
+		Name: func(ic *importContext, d *schema.ResourceData) string {
+			return d.Get("storage_configuration_name").(string)
+		},
+		List: func(ic *importContext) error {
+			configurations, err := ic.accountClient.Storage.List(ic.Context)
+			if err != nil {
+				return err
+			}
+			for _, sc := range configurations {
+				if !ic.MatchesName("databricks_mws_storage_configurations", sc.StorageConfigurationName) {
+					continue
+				}
+				ic.Emit(&resource{
+					Resource: "databricks_mws_storage_configurations",
+					ID:       fmt.Sprintf("%s/%s", ic.Client.Config.AccountID, sc.StorageConfigurationId),
+				})
+			}
+			return nil
+		},
+	},
+	"databricks_mws_networks": {
+		AccountLevel: true,
+		Service:      "mws",
+		Filterable:   true,
+		Name: func(ic *importContext, d *schema.ResourceData) string {
+			return d.Get("network_name").(string)
+		},
+		List: func(ic *importContext) error {
+			networks, err := ic.accountClient.Networks.List(ic.Context)
+			if err != nil {
+				return err
+			}
+			for _, network := range networks {
+				if !ic.MatchesName("databricks_mws_networks", network.NetworkName) {
+					continue
+				}
+				ic.Emit(&resource{
+					Resource: "databricks_mws_networks",
+					ID:       fmt.Sprintf("%s/%s", ic.Client.Config.AccountID, network.NetworkId),
+				})
+			}
+			return nil
+		},
+	},
+	"databricks_mws_private_access_settings": {
+		AccountLevel: true,
+		Service:      "mws",
+		Filterable:   true,
+		Name: func(ic *importContext, d *schema.ResourceData) string {
+			return d.Get("private_access_settings_name").(string)
+		},
+		List: func(ic *importContext) error {
+			settings, err := ic.accountClient.PrivateAccess.List(ic.Context)
+			if err != nil {
+				return err
+			}
+			for _, pas := range settings {
+				if !ic.MatchesName("databricks_mws_private_access_settings", pas.PrivateAccessSettingsName) {
+					continue
+				}
+				ic.Emit(&resource{
+					Resource: "databricks_mws_private_access_settings",
+					ID:       fmt.Sprintf("%s/%s", ic.Client.Config.AccountID, pas.PrivateAccessSettingsId),
+				})
+			}
+			return nil
+		},
+	},
+	"databricks_system_schema": {
+		WorkspaceLevel: true,
+		Service:        "uc-system-schemas",
+		List: func(ic *importContext) error {
+			if ic.currentMetastore == nil {
+				return fmt.Errorf("there is no UC metastore information")
+			}
+			currentMetastore := ic.currentMetastore.MetastoreId
+			systemSchemas, err := ic.workspaceClient.SystemSchemas.ListAll(ic.Context,
+				catalog.ListSystemSchemasRequest{MetastoreId: currentMetastore})
+			if err != nil {
+				return err
+			}
+			for _, v := range systemSchemas {
+				if v.State == catalog.SystemSchemaInfoStateEnableCompleted || v.State == catalog.SystemSchemaInfoStateEnableInitialized {
+					id := fmt.Sprintf("%s|%s", currentMetastore, v.Schema)
+					data := ic.Resources["databricks_system_schema"].Data(
+						&terraform.InstanceState{
+							ID: id,
+							Attributes: map[string]string{
+								"metastore_id": currentMetastore,
+								"schema":       v.Schema,
+							},
+						})
+					ic.Emit(&resource{
+						Resource: "databricks_system_schema",
+						ID:       id,
+						Data:     data,
+						Name:     nameNormalizationRegex.ReplaceAllString(id, "_"),
+					})
+				} else {
+					log.Printf("[DEBUG] Skipping system schema %s with state %s", v.Schema, v.State)
+				}
+			}
+			return nil
+		},
+	},
+	"databricks_artifact_allowlist": {
+		WorkspaceLevel: true,
+		Service:        "uc-artifact-allowlist",
+		List: func(ic *importContext) error {
+			if ic.currentMetastore == nil {
+				return fmt.Errorf("there is no UC metastore information")
+			}
+			artifactTypes := []string{"INIT_SCRIPT", "LIBRARY_JAR", "LIBRARY_MAVEN"}
+			for _, v := range artifactTypes {
+				id := fmt.Sprintf("%s|%s", ic.currentMetastore.MetastoreId, v)
+				name := fmt.Sprintf("%s_%s_%s", v, ic.currentMetastore.Name, ic.currentMetastore.MetastoreId[:8])
+				ic.Emit(&resource{
+					Resource: "databricks_artifact_allowlist",
+					ID:       id,
+					Name:     nameNormalizationRegex.ReplaceAllString(name, "_"),
+				})
+			}
+			return nil
+		},
+		// TODO: add Depends & Import to emit corresponding UC Volumes when support for them is added
+	},
+	"databricks_catalog": {
+		WorkspaceLevel: true,
+		Service:        "uc-catalogs",
+		Filterable:     true,
+		List: func(ic *importContext) error {
+			if ic.currentMetastore == nil {
+				return fmt.Errorf("there is no UC metastore information")
+			}
+			catalogs, err := ic.workspaceClient.Catalogs.ListAll(ic.Context)
+			if err != nil {
+				return err
+			}
+			for i, v := range catalogs {
+				if ic.skipSystemObject(isSystemUcCatalog(v), "databricks_catalog", "name", v.Name) {
+					continue
+				}
+				if !ic.MatchesName("databricks_catalog", v.Name) {
+					continue
+				}
+				ic.Emit(&resource{
+					Resource: "databricks_catalog",
+					ID:       v.Name,
+				})
+				log.Printf("[INFO] Imported %d of %d UC catalogs", i+1, len(catalogs))
+			}
+			return nil
+		},
+		Import: func(ic *importContext, r *resource) error {
+			ic.emitUCGrants("catalog", r.ID)
+			if r.Data.Get("isolation_mode").(string) == "ISOLATED" {
+				ic.emitWorkspaceBindings("catalog", r.ID)
+			}
+			// schemas of Delta Sharing & foreign catalogs aren't managed by users
+			if r.Data.Get("share_name").(string) != "" || r.Data.Get("connection_name").(string) != "" {
+				return nil
+			}
+			schemas, err := ic.workspaceClient.Schemas.ListAll(ic.Context,
+				catalog.ListSchemasRequest{CatalogName: r.ID})
+			if err != nil {
+				return err
+			}
+			for _, v := range schemas {
+				if v.Name == "information_schema" {
+					continue
+				}
+				ic.Emit(&resource{
+					Resource: "databricks_schema",
+					ID:       v.FullName,
+				})
+			}
+			return nil
+		},
+	},
+	"databricks_catalog_workspace_binding": {
+		WorkspaceLevel: true,
+		Service:        "uc-catalogs",
+		Name: func(ic *importContext, d *schema.ResourceData) string {
+			return fmt.Sprintf("%s_%s_%d", d.Get("securable_type").(string), d.Get("securable_name").(string),
+				d.Get("workspace_id").(int))
+		},
+		Depends: []reference{
+			{Path: "securable_name", Resource: "databricks_catalog", ResourceID: bindingSecurableOfType("catalog")},
+			{Path: "securable_name", Resource: "databricks_external_location",
+				ResourceID: bindingSecurableOfType("external_location")},
+		},
+	},
+	"databricks_schema": {
+		WorkspaceLevel: true,
+		Service:        "uc-catalogs",
+		Import: func(ic *importContext, r *resource) error {
+			ic.emitUCGrants("schema", r.ID)
+			catalogName := r.Data.Get("catalog_name").(string)
+			schemaName := r.Data.Get("name").(string)
+			tables, err := ic.workspaceClient.Tables.ListAll(ic.Context, catalog.ListTablesRequest{
+				CatalogName: catalogName,
+				SchemaName:  schemaName,
+			})
+			if err != nil {
+				return err
+			}
+			for _, v := range tables {
+				switch v.TableType {
+				case catalog.TableTypeManaged, catalog.TableTypeExternal, catalog.TableTypeView:
+					ic.Emit(&resource{
+						Resource: "databricks_sql_table",
+						ID:       v.FullName,
+					})
+				default:
+					// materialized views & streaming tables are managed by DLT pipelines
+					log.Printf("[DEBUG] Skipping table %s of type %s", v.FullName, v.TableType)
+				}
+			}
+			volumes, err := ic.workspaceClient.Volumes.ListAll(ic.Context, catalog.ListVolumesRequest{
+				CatalogName: catalogName,
+				SchemaName:  schemaName,
+			})
+			if err != nil {
+				return err
+			}
+			for _, v := range volumes {
+				ic.Emit(&resource{
+					Resource: "databricks_volume",
+					ID:       v.FullName,
+				})
+			}
+			return nil
+		},
+		Depends: []reference{
+			{Path: "catalog_name", Resource: "databricks_catalog"},
+		},
+	},
+	"databricks_sql_table": {
+		WorkspaceLevel: true,
+		Service:        "uc-catalogs",
+		Import: func(ic *importContext, r *resource) error {
+			ic.emitUCGrants("table", r.ID)
+			ic.emitLakehouseMonitor(r.ID)
+			return nil
+		},
+		Ignore: func(ic *importContext, r *resource) bool {
+			if ic.isLakehouseMonitorOutputTable(r.ID) {
+				ic.addIgnoredResource(ignoredResource{Resource: "databricks_sql_table", Attribute: "id",
+					Value: r.ID, Reason: ignoreReasonMonitorOutput})
+				return true
+			}
+			return false
+		},
+		ShouldOmitField: func(ic *importContext, pathString string, as *schema.Schema, d *schema.ResourceData) bool {
+			switch pathString {
+			case "column":
+				// columns of views are defined by the view definition
+				return d.Get("table_type").(string) == string(catalog.TableTypeView)
+			case "storage_location":
+				return d.Get("table_type").(string) != string(catalog.TableTypeExternal)
+			}
+			return defaultShouldOmitFieldFunc(ic, pathString, as, d)
+		},
+		Depends: []reference{
+			{Path: "catalog_name", Resource: "databricks_catalog"},
+			{Path: "schema_name", Resource: "databricks_schema", Match: "name", ResourceID: schemaFullName},
+		},
+	},
+	"databricks_lakehouse_monitor": {
+		WorkspaceLevel: true,
+		Service:        "uc-monitors",
+		Import: func(ic *importContext, r *resource) error {
+			if outputSchema := r.Data.Get("output_schema_name").(string); outputSchema != "" {
+				ic.Emit(&resource{
+					Resource: "databricks_schema",
+					ID:       outputSchema,
+				})
+			}
+			if baselineTable := r.Data.Get("baseline_table_name").(string); baselineTable != "" {
+				ic.Emit(&resource{
+					Resource: "databricks_sql_table",
+					ID:       baselineTable,
+				})
+			}
+			return nil
+		},
+		Depends: []reference{
+			{Path: "table_name", Resource: "databricks_sql_table"},
+			{Path: "baseline_table_name", Resource: "databricks_sql_table"},
+			{Path: "output_schema_name", Resource: "databricks_schema"},
+			{Path: "assets_dir", Resource: "databricks_directory", Match: "path"},
+			{Path: "notifications.on_failure.email_addresses", Resource: "databricks_user", Match: "user_name",
+				MatchType: MatchCaseInsensitive},
+		},
+	},
+	"databricks_volume": {
+		WorkspaceLevel: true,
+		Service:        "uc-volumes",
+		Filterable:     true,
+		List: func(ic *importContext) error {
+			if ic.currentMetastore == nil {
+				return fmt.Errorf("there is no UC metastore information")
+			}
+			catalogs, err := ic.workspaceClient.Catalogs.ListAll(ic.Context)
+			if err != nil {
+				return err
+			}
+			for _, c := range catalogs {
+				if isSystemUcCatalog(c) || c.ShareName != "" || c.ConnectionName != "" {
+					continue
+				}
+				schemas, err := ic.workspaceClient.Schemas.ListAll(ic.Context,
+					catalog.ListSchemasRequest{CatalogName: c.Name})
+				if err != nil {
+					return err
+				}
+				for _, sc := range schemas {
+					if sc.Name == "information_schema" {
+						continue
+					}
+					volumes, err := ic.workspaceClient.Volumes.ListAll(ic.Context, catalog.ListVolumesRequest{
+						CatalogName: c.Name,
+						SchemaName:  sc.Name,
+					})
+					if err != nil {
+						return err
+					}
+					for _, v := range volumes {
+						if !ic.MatchesName("databricks_volume", v.Name) {
+							continue
+						}
+						ic.Emit(&resource{
+							Resource: "databricks_volume",
+							ID:       v.FullName,
+						})
+					}
+				}
+				log.Printf("[INFO] Listed UC volumes of catalog %s", c.Name)
+			}
+			return nil
+		},
+		Import: func(ic *importContext, r *resource) error {
+			ic.emitUCGrants("volume", r.ID)
+			if ic.exportVolumeContent {
+				return ic.emitVolumeFiles(r.Data.Get("volume_path").(string))
+			}
+			return nil
+		},
+		ShouldOmitField: func(ic *importContext, pathString string, as *schema.Schema, d *schema.ResourceData) bool {
+			if pathString == "storage_location" {
+				return d.Get("volume_type").(string) != string(catalog.VolumeTypeExternal)
+			}
+			return defaultShouldOmitFieldFunc(ic, pathString, as, d)
+		},
+		Depends: []reference{
+			{Path: "catalog_name", Resource: "databricks_catalog"},
+			{Path: "schema_name", Resource: "databricks_schema", Match: "name", ResourceID: schemaFullName},
+		},
+	},
+	"databricks_registered_model": {
+		WorkspaceLevel: true,
+		Service:        "uc-models",
+		Filterable:     true,
+		List: func(ic *importContext) error {
+			if ic.currentMetastore == nil {
+				return fmt.Errorf("there is no UC metastore information")
+			}
+			models, err := ic.workspaceClient.RegisteredModels.ListAll(ic.Context,
+				catalog.ListRegisteredModelsRequest{})
+			if err != nil {
+				return err
+			}
+			for offset, m := range models {
+				if !ic.MatchesName("databricks_registered_model", m.Name) {
+					continue
+				}
+				// models like `system.ai.*` are provided by Databricks
+				if ic.skipSystemObject(m.CatalogName == "system", "databricks_registered_model", "name", m.FullName) {
+					continue
+				}
+				ic.Emit(&resource{
+					Resource: "databricks_registered_model",
+					ID:       m.FullName,
+				})
+				if offset%50 == 0 {
+					log.Printf("[INFO] Scanned %d of %d UC registered models", offset+1, len(models))
+				}
+			}
+			return nil
+		},
+		Import: func(ic *importContext, r *resource) error {
+			ic.emitUCGrants("model", r.ID)
+			return nil
+		},
+		Depends: []reference{
+			{Path: "catalog_name", Resource: "databricks_catalog"},
+			{Path: "schema_name", Resource: "databricks_schema", Match: "name", ResourceID: schemaFullName},
+		},
+	},
+	"databricks_storage_credential": {
+		WorkspaceLevel: true,
+		Service:        "uc-storage",
+		Filterable:     true,
+		Name: func(ic *importContext, d *schema.ResourceData) string {
+			return d.Get("name").(string)
+		},
+		List: func(ic *importContext) error {
+			if ic.currentMetastore == nil {
+				return fmt.Errorf("there is no UC metastore information")
+			}
+			credentials, err := ic.workspaceClient.StorageCredentials.ListAll(ic.Context,
+				catalog.ListStorageCredentialsRequest{})
+			if err != nil {
+				return err
+			}
+			for i, v := range credentials {
+				if !ic.MatchesName("databricks_storage_credential", v.Name) {
+					continue
+				}
+				ic.Emit(&resource{
+					Resource: "databricks_storage_credential",
+					ID:       v.Name,
+				})
+				log.Printf("[INFO] Imported %d of %d UC storage credentials", i+1, len(credentials))
+			}
+			return nil
+		},
+		Import: func(ic *importContext, r *resource) error {
+			ic.emitUCGrants("storage_credential", r.ID)
+			return nil
+		},
+		Depends: []reference{
+			// secrets aren't returned by the API
+			{Path: "azure_service_principal.client_secret", Variable: true},
+			{Path: "gcp_service_account_key.private_key", Variable: true},
+			{Path: "gcp_service_account_key.private_key_id", Variable: true},
+		},
+	},
+	"databricks_external_location": {
+		WorkspaceLevel: true,
+		Service:        "uc-storage",
+		Filterable:     true,
+		List: func(ic *importContext) error {
+			if ic.currentMetastore == nil {
+				return fmt.Errorf("there is no UC metastore information")
+			}
+			locations, err := ic.workspaceClient.ExternalLocations.ListAll(ic.Context,
+				catalog.ListExternalLocationsRequest{})
+			if err != nil {
+				return err
+			}
+			for i, v := range locations {
+				if !ic.MatchesName("databricks_external_location", v.Name) {
+					continue
+				}
+				ic.Emit(&resource{
+					Resource: "databricks_external_location",
+					ID:       v.Name,
+				})
+				log.Printf("[INFO] Imported %d of %d UC external locations", i+1, len(locations))
+			}
+			return nil
+		},
+		Import: func(ic *importContext, r *resource) error {
+			ic.Emit(&resource{
+				Resource: "databricks_storage_credential",
+				ID:       r.Data.Get("credential_name").(string),
+			})
+			ic.emitUCGrants("external_location", r.ID)
+			// isolation mode of external locations isn't returned by the API yet, so bindings are checked directly.
+			// Locations that aren't isolated have no bindings
+			ic.emitWorkspaceBindings("external_location", r.ID)
+			return nil
+		},
+		Depends: []reference{
+			{Path: "credential_name", Resource: "databricks_storage_credential"},
+		},
+	},
+	"databricks_file": {
+		WorkspaceLevel: true,
+		Service:        "uc-volumes",
+		Name:           workspaceObjectResouceName,
+		Import: func(ic *importContext, r *resource) error {
+			resp, err := ic.workspaceClient.Files.Download(ic.Context, files.DownloadRequest{FilePath: r.ID})
+			if err != nil {
+				return err
+			}
+			defer resp.Contents.Close()
+			content, err := io.ReadAll(resp.Contents)
+			if err != nil {
+				return err
+			}
+			name := fileNameNormalizationRegex.ReplaceAllString(r.ID[1:], "_")
+			fileName, err := ic.createFileIn("uc_volume_files", name, content)
+			if err != nil {
+				return err
+			}
+			log.Printf("Creating %s for %s", fileName, r)
+			r.Data.Set("source", fileName)
+			return nil
+		},
+		ShouldOmitField: shouldOmitMd5Field,
+		Depends: []reference{
+			{Path: "source", File: true},
+			{Path: "path", Resource: "databricks_volume", Match: "volume_path", MatchType: MatchPrefix},
+		},
+	},
+	"databricks_grants": {
+		WorkspaceLevel: true,
+		Service:        "uc-grants",
+		Import: func(ic *importContext, r *resource) error {
+			var grants tfcatalog.PermissionsList
+			common.DataToStructPointer(r.Data, ic.Resources["databricks_grants"].Schema, &grants)
+			for _, v := range grants.Assignments {
+				// principals are user names, application IDs of service principals or group names
+				if common.StringIsUUID(v.Principal) || strings.Contains(v.Principal, "@") {
+					ic.emitUserOrServicePrincipal(v.Principal)
+				} else {
+					ic.Emit(&resource{
+						Resource:  "databricks_group",
+						Attribute: "display_name",
+						Value:     v.Principal,
+					})
+				}
+			}
+			return nil
+		},
+		Depends: []reference{
+			{Path: "catalog", Resource: "databricks_catalog"},
+			{Path: "schema", Resource: "databricks_schema"},
+			{Path: "table", Resource: "databricks_sql_table"},
+			{Path: "volume", Resource: "databricks_volume"},
+			{Path: "model", Resource: "databricks_registered_model"},
+			{Path: "storage_credential", Resource: "databricks_storage_credential"},
+			{Path: "external_location", Resource: "databricks_external_location"},
+			{Path: "grant.principal", Resource: "databricks_user", Match: "user_name", MatchType: MatchCaseInsensitive},
+			{Path: "grant.principal", Resource: "databricks_group", Match: "display_name"},
+			{Path: "grant.principal", Resource: "databricks_service_principal", Match: "application_id"},
+		},
+	},
+}
+
+func init() {
+	for resourceType, ir := range resourcesMap {
+		ir.resourceType = resourceType
+		resourcesMap[resourceType] = ir
+	}
 }
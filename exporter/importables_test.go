@@ -8,16 +8,30 @@ import (
 	"os"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/billing"
 	"github.com/databricks/databricks-sdk-go/service/catalog"
 	"github.com/databricks/databricks-sdk-go/service/compute"
 	"github.com/databricks/databricks-sdk-go/service/iam"
+	sdk_jobs "github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/databricks/databricks-sdk-go/service/ml"
+	sdk_pipelines "github.com/databricks/databricks-sdk-go/service/pipelines"
+	"github.com/databricks/databricks-sdk-go/service/provisioning"
+	"github.com/databricks/databricks-sdk-go/service/settings"
+	"github.com/databricks/databricks-sdk-go/service/sql"
+	"github.com/databricks/databricks-sdk-go/service/vectorsearch"
+	tfcatalog "github.com/databricks/terraform-provider-databricks/catalog"
 	"github.com/databricks/terraform-provider-databricks/clusters"
 	"github.com/databricks/terraform-provider-databricks/commands"
 	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/dashboards"
 	"github.com/databricks/terraform-provider-databricks/jobs"
 	"github.com/databricks/terraform-provider-databricks/libraries"
+	"github.com/databricks/terraform-provider-databricks/mlflow"
+	"github.com/databricks/terraform-provider-databricks/mws"
 	"github.com/databricks/terraform-provider-databricks/permissions"
 	"github.com/databricks/terraform-provider-databricks/pipelines"
 	"github.com/databricks/terraform-provider-databricks/policies"
@@ -27,10 +41,16 @@ import (
 	"github.com/databricks/terraform-provider-databricks/repos"
 	"github.com/databricks/terraform-provider-databricks/scim"
 	"github.com/databricks/terraform-provider-databricks/secrets"
+	"github.com/databricks/terraform-provider-databricks/serving"
+	tfsettings "github.com/databricks/terraform-provider-databricks/settings"
+	tfsql "github.com/databricks/terraform-provider-databricks/sql"
 	"github.com/databricks/terraform-provider-databricks/storage"
+	"github.com/databricks/terraform-provider-databricks/tokens"
+	tfvectorsearch "github.com/databricks/terraform-provider-databricks/vectorsearch"
 	"github.com/databricks/terraform-provider-databricks/workspace"
 	"github.com/hashicorp/hcl/v2/hclwrite"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"golang.org/x/exp/maps"
 )
 
@@ -47,7 +67,9 @@ func importContextForTest() *importContext {
 		allSps:                   map[string]scim.User{},
 		channels:                 makeResourcesChannels(),
 		exportDeletedUsersAssets: false,
-		ignoredResources:         map[string]struct{}{},
+		ignoredResources:         map[string]ignoredResource{},
+		bundleManaged:            map[string]ignoredResource{},
+		mappings:                 map[string]addressMapping{},
 		State:                    newStateApproximation(supportedResources),
 		emittedUsers:             map[string]struct{}{},
 		userOrSpDirectories:      map[string]bool{},
@@ -194,6 +216,83 @@ func TestGroup(t *testing.T) {
 	assert.True(t, ic.testEmits["databricks_group_member[_parent-group_foo] (id: parent-group|123)"])
 }
 
+func groupsForFilterTest() []scim.Group {
+	return []scim.Group{
+		{
+			DisplayName: "data-platform",
+			ID:          "1",
+			Members: []scim.ComplexValue{
+				{Value: "u1", Ref: "Users/u1"},
+				{Value: "2", Ref: "Groups/2"},
+			},
+		},
+		{
+			DisplayName: "nested",
+			ID:          "2",
+			Members: []scim.ComplexValue{
+				{Value: "sp1", Ref: "ServicePrincipals/sp1"},
+				// cycles in group membership shouldn't hang
+				{Value: "1", Ref: "Groups/1"},
+			},
+		},
+		{
+			DisplayName: "others",
+			ID:          "3",
+			Members: []scim.ComplexValue{
+				{Value: "u1", Ref: "Users/u1"},
+				{Value: "u2", Ref: "Users/u2"},
+				{Value: "sp2", Ref: "ServicePrincipals/sp2"},
+			},
+		},
+	}
+}
+
+func TestIsInGroupsFilter(t *testing.T) {
+	ic := importContextForTest()
+	ic.allGroups = groupsForFilterTest()
+	assert.True(t, ic.isInGroupsFilter("u2"))
+
+	ic.groupsFilter = "data-platform, analysts"
+	assert.True(t, ic.isInGroupsFilter("u1"))
+	assert.True(t, ic.isInGroupsFilter("sp1"))
+	assert.False(t, ic.isInGroupsFilter("u2"))
+	assert.False(t, ic.isInGroupsFilter("sp2"))
+}
+
+func TestGroupMembersWithGroupsFilter(t *testing.T) {
+	ic := importContextForTest()
+	ic.enableServices("groups,users")
+	ic.allGroups = groupsForFilterTest()
+	ic.groupsFilter = "data-platform"
+	d := scim.ResourceGroup().ToResource().TestResourceData()
+	d.Set("display_name", "others")
+	err := ic.Importables["databricks_group"].Import(ic, &resource{ID: "3", Data: d})
+	assert.NoError(t, err)
+	assert.Len(t, ic.testEmits, 2)
+	assert.True(t, ic.testEmits["databricks_user[<unknown>] (id: u1)"])
+	assert.True(t, ic.testEmits["databricks_group_member[others_3__u1] (id: 3|u1)"])
+}
+
+func TestUserIgnoredByGroupsFilter(t *testing.T) {
+	ic := importContextForTest()
+	ic.allGroups = groupsForFilterTest()
+	ic.groupsFilter = "data-platform"
+	assert.False(t, ic.Importables["databricks_user"].Ignore(ic, &resource{
+		Resource: "databricks_user", ID: "u1"}))
+	assert.True(t, ic.Importables["databricks_user"].Ignore(ic, &resource{
+		Resource: "databricks_user", ID: "u2"}))
+	assert.True(t, ic.Importables["databricks_service_principal"].Ignore(ic, &resource{
+		Resource: "databricks_service_principal", ID: "sp2"}))
+	assert.Len(t, ic.ignoredResources, 2)
+
+	// objects outside of filter aren't imported, so their groups aren't emitted
+	d := scim.ResourceUser().ToResource().TestResourceData()
+	d.Set("user_name", "u2@example.com")
+	err := ic.Importables["databricks_user"].Import(ic, &resource{ID: "u2", Data: d})
+	assert.NoError(t, err)
+	assert.Len(t, ic.testEmits, 0)
+}
+
 func TestPermissions(t *testing.T) {
 	p := permissions.ResourcePermissions()
 	d := p.ToResource().TestResourceData()
@@ -229,6 +328,60 @@ func TestPermissions(t *testing.T) {
 	assert.True(t, ic.testEmits["databricks_service_principal[<unknown>] (application_id: 123)"])
 }
 
+func TestPermissionsInheritedFromParent(t *testing.T) {
+	ic := importContextForTest()
+	addToState := func(resourceType, name string, attributes map[string]any) {
+		ic.State.Append(resourceApproximation{Type: resourceType, Name: name, Mode: "managed",
+			Instances: []instanceApproximation{{Attributes: attributes}}})
+	}
+	addToState("databricks_directory", "dir", map[string]any{
+		"id": "/dir", "path": "/dir", "object_id": "1"})
+	addToState("databricks_notebook", "nb", map[string]any{
+		"id": "/dir/nb", "path": "/dir/nb", "object_id": "123"})
+	addToState("databricks_directory", "sub", map[string]any{
+		"id": "/dir/sub", "path": "/dir/sub", "object_id": "456"})
+	addToState("databricks_notebook", "other", map[string]any{
+		"id": "/other/nb", "path": "/other/nb", "object_id": "789"})
+	addToState("databricks_permissions", "directory_dir", map[string]any{
+		"id":                                   "/directories/1",
+		"access_control.#":                     "2",
+		"access_control.1234.group_name":       "data-eng",
+		"access_control.1234.permission_level": "CAN_RUN",
+		"access_control.5678.user_name":        "user@example.com",
+		"access_control.5678.permission_level": "CAN_MANAGE",
+	})
+
+	inherited := []permissions.AccessControlChange{
+		{GroupName: "data-eng", PermissionLevel: "CAN_RUN"},
+		{UserName: "user@example.com", PermissionLevel: "CAN_MANAGE"},
+	}
+	assert.True(t, ic.hasOnlyInheritedPermissions("/notebooks/123", inherited))
+	assert.True(t, ic.hasOnlyInheritedPermissions("/directories/456", inherited[:1]))
+	assert.False(t, ic.hasOnlyInheritedPermissions("/directories/456", []permissions.AccessControlChange{
+		{GroupName: "data-eng", PermissionLevel: "CAN_MANAGE"},
+	}))
+	// permissions of the parent directory aren't exported
+	assert.False(t, ic.hasOnlyInheritedPermissions("/notebooks/789", inherited))
+	// the workspace object isn't exported
+	assert.False(t, ic.hasOnlyInheritedPermissions("/files/321", inherited))
+	// permissions of other objects aren't inherited from directories
+	assert.False(t, ic.hasOnlyInheritedPermissions("/jobs/789", inherited))
+
+	p := permissions.ResourcePermissions()
+	d := p.ToResource().TestResourceData()
+	d.SetId("/notebooks/123")
+	d.MarkNewResource()
+	err := common.StructToData(permissions.PermissionsEntity{
+		AccessControlList: inherited[:1],
+	}, p.Schema, d)
+	assert.NoError(t, err)
+	assert.True(t, ic.Importables["databricks_permissions"].Ignore(ic, &resource{
+		ID:   "/notebooks/123",
+		Data: d,
+	}))
+	assert.Equal(t, 1, len(ic.ignoredResources))
+}
+
 func TestSecretScope(t *testing.T) {
 	d := secrets.ResourceSecretScope().ToResource().TestResourceData()
 	d.Set("name", "abc")
@@ -612,6 +765,85 @@ func TestSpnSearchSuccess(t *testing.T) {
 	})
 }
 
+func TestEmitServicePrincipalSecret(t *testing.T) {
+	spFixture := qa.ListServicePrincipalsFixtures([]iam.ServicePrincipal{
+		{
+			Id: "321", DisplayName: "spn", ApplicationId: "dbc",
+		},
+		{
+			Id: "322", DisplayName: "spn2", ApplicationId: "dbd",
+		},
+	})
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		spFixture[0],
+		spFixture[1],
+		{
+			ReuseRequest: true,
+			Method:       "GET",
+			Resource:     "/api/2.0/preview/scim/v2/ServicePrincipals/321?attributes=userName,displayName,active,externalId,entitlements,groups,roles",
+			Response:     scim.User{ID: "321", DisplayName: "spn", ApplicationID: "dbc"},
+		},
+		{
+			ReuseRequest: true,
+			Method:       "GET",
+			Resource:     "/api/2.0/preview/scim/v2/ServicePrincipals/322?attributes=userName,displayName,active,externalId,entitlements,groups,roles",
+			Response:     scim.User{ID: "322", DisplayName: "spn2", ApplicationID: "dbd"},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/accounts/abc/servicePrincipals/321/credentials/secrets",
+			Response: tokens.ListServicePrincipalSecrets{
+				Secrets: []tokens.ServicePrincipalSecret{{ID: "s1", Status: "ACTIVE"}},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/accounts/abc/servicePrincipals/322/credentials/secrets",
+			Response: tokens.ListServicePrincipalSecrets{},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTestWithClient(ctx, client)
+		ic.enableServices("users")
+		// secrets are managed only with account_id in the provider configuration
+		ic.emitServicePrincipalSecret("dbc")
+		assert.Len(t, ic.testEmits, 0)
+
+		ic.Client.Config.AccountID = "abc"
+		ic.emitServicePrincipalSecret("dbc")
+		// service principal without OAuth secrets
+		ic.emitServicePrincipalSecret("dbd")
+		ic.emitServicePrincipalSecret("unknown")
+		assert.Len(t, ic.testEmits, 1)
+		assert.True(t, ic.testEmits["databricks_service_principal_secret[<unknown>] (id: 321)"])
+
+		d := tokens.ResourceServicePrincipalSecret().ToResource().TestResourceData()
+		d.SetId("321")
+		d.Set("service_principal_id", "321")
+		r := &resource{
+			Resource: "databricks_service_principal_secret",
+			ID:       "321",
+			Data:     d,
+		}
+		r.Name = resourcesMap["databricks_service_principal_secret"].Name(ic, d)
+		assert.Equal(t, "spn_321", r.Name)
+
+		ic.variables = map[string]string{}
+		f := hclwrite.NewEmptyFile()
+		err := generateServicePrincipalSecretBody(ic, f.Body(), r)
+		assert.NoError(t, err)
+		assert.Equal(t, `resource "databricks_service_principal_secret" "spn_321" {
+  count                = var.create_spn_321 ? 1 : 0
+  service_principal_id = "321"
+}
+output "spn_321_secret" {
+  value     = one(databricks_service_principal_secret.spn_321[*].secret)
+  sensitive = true
+}
+`, string(hclwrite.Format(f.Bytes())))
+		assert.Contains(t, ic.variables, "create_spn_321")
+	})
+}
+
 func TestShouldOmitForUsers(t *testing.T) {
 	d := scim.ResourceUser().ToResource().TestResourceData()
 	d.SetId("user1")
@@ -1089,6 +1321,57 @@ func TestNotebookGenerationJupyter(t *testing.T) {
 	})
 }
 
+func TestNotebookGenerationWorkspaceLayout(t *testing.T) {
+	testGenerate(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/workspace/list?path=%2F",
+			Response: workspace.ObjectList{
+				Objects: []workspace.ObjectStatus{
+					{
+						Path:       "/First/Sec:ond notebook",
+						ObjectType: "NOTEBOOK",
+					},
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/workspace/get-status?path=%2FFirst%2FSec%3Aond%20notebook",
+			Response: workspace.ObjectStatus{
+				ObjectID:   123,
+				ObjectType: "NOTEBOOK",
+				Path:       "/First/Sec:ond notebook",
+				Language:   "PYTHON",
+			},
+			ReuseRequest: true,
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/workspace/export?format=HTML&path=%2FFirst%2FSec%3Aond+notebook",
+			Response: workspace.ExportPath{
+				Content: "YWJj",
+			},
+			ReuseRequest: true,
+		},
+	}, "notebooks", false, func(ic *importContext) {
+		ic.notebooksFormat = "HTML"
+		ic.notebooksLayout = notebooksLayoutWorkspace
+		err := resourcesMap["databricks_notebook"].List(ic)
+		assert.NoError(t, err)
+		ic.waitGroup.Wait()
+		ic.closeImportChannels()
+		ic.generateAndWriteResources(nil)
+		assert.Equal(t, commands.TrimLeadingWhitespace(`
+		resource "databricks_notebook" "first_sec_ond_notebook_123" {
+		  source   = "${path.module}/notebooks/First/Sec_ond notebook.html"
+		  path     = "/First/Sec:ond notebook"
+		  language = "PYTHON"
+		  format   = "HTML"
+		}`), getGeneratedFile(ic, "notebooks"))
+	})
+}
+
 func TestNotebookGenerationBadCharacters(t *testing.T) {
 	testGenerate(t, []qa.HTTPFixture{
 		{
@@ -1259,6 +1542,92 @@ func TestSecretGeneration(t *testing.T) {
 		  scope        = "a"
 		  key          = "b"
 		}`), getGeneratedFile(ic, "secrets"))
+		assert.Equal(t, "Value of the b secret in the a secret scope", ic.variables["string_value_a_b_eb2980a5a2"])
+		assert.Contains(t, ic.sensitiveVariables, "string_value_a_b_eb2980a5a2")
+	})
+}
+
+func TestSecretAclGenerationSeparateAcls(t *testing.T) {
+	testGenerate(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/secrets/acls/get?principal=users&scope=a",
+			Response: secrets.ACLItem{
+				Principal:  "users",
+				Permission: "READ",
+			},
+		},
+	}, "secrets", false, func(ic *importContext) {
+		ic.separateAcls = true
+		ic.Emit(&resource{
+			Resource: "databricks_secret_acl",
+			ID:       "a|||users",
+		})
+
+		ic.waitGroup.Wait()
+		ic.closeImportChannels()
+		ic.generateAndWriteResources(nil)
+		assert.Contains(t, getGeneratedFile(ic, "acls_secrets"), `resource "databricks_secret_acl" "a_users"`)
+		assert.NotContains(t, getGeneratedFile(ic, "secrets"), "databricks_secret_acl")
+	})
+}
+
+func TestAclService(t *testing.T) {
+	assert.Equal(t, "jobs", aclService("/jobs/123", "access"))
+	assert.Equal(t, "sql-endpoints", aclService("/sql/warehouses/abc", "sql-endpoints"))
+	assert.Equal(t, "uc-catalogs", aclService("schema/main.default", "uc-grants"))
+	assert.Equal(t, "access", aclService("/authorization/tokens", "access"))
+	assert.Equal(t, "secrets", aclService("a|||users", "secrets"))
+}
+
+func TestSecretGenerationWithStoreStubs(t *testing.T) {
+	testGenerate(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/secrets/list?scope=prod-kv",
+			Response: secrets.SecretsList{
+				Secrets: []secrets.SecretMetadata{{Key: "db_password"}},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/secrets/list?scope=prod-secretsmanager",
+			Response: secrets.SecretsList{
+				Secrets: []secrets.SecretMetadata{{Key: "token"}},
+			},
+		},
+	}, "secrets", false, func(ic *importContext) {
+		ic.secretStoreStubs = true
+		ic.Emit(&resource{
+			Resource: "databricks_secret",
+			ID:       "prod-kv|||db_password",
+		})
+		ic.Emit(&resource{
+			Resource: "databricks_secret",
+			ID:       "prod-secretsmanager|||token",
+		})
+
+		ic.waitGroup.Wait()
+		ic.closeImportChannels()
+		ic.generateAndWriteResources(nil)
+		generated := getGeneratedFile(ic, "secrets")
+		assert.Contains(t, generated, commands.TrimLeadingWhitespace(`
+		resource "databricks_secret" "prod_kv_db_password_8b9f571877" {
+		  scope        = "prod-kv"
+		  key          = "db_password"
+		  string_value = data.azurerm_key_vault_secret.prod_kv_db_password_8b9f571877.value
+		}
+		data "azurerm_key_vault_secret" "prod_kv_db_password_8b9f571877" {
+		  name         = "db-password"
+		  key_vault_id = var.key_vault_id_prod_kv
+		}`))
+		assert.Contains(t, generated, commands.TrimLeadingWhitespace(`
+		data "aws_secretsmanager_secret_version" "prod_secretsmanager_token_d66c5fa2ee" {
+		  secret_id = "prod-secretsmanager/token"
+		}`))
+		assert.Equal(t, map[string]string{
+			"key_vault_id_prod_kv": "ID of Azure Key Vault with secrets of the prod-kv secret scope",
+		}, ic.variables)
 	})
 }
 
@@ -1296,6 +1665,85 @@ func TestDbfsFileGeneration(t *testing.T) {
 	})
 }
 
+func TestDbfsFileIncompleteRead(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/dbfs/read?length=1000000&path=a",
+			Response: storage.ReadResponse{
+				Data:      "YWJj",
+				BytesRead: 3,
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTestWithClient(ctx, client)
+		ic.Directory = t.TempDir()
+		d := storage.ResourceDbfsFile().ToResource().TestResourceData()
+		d.SetId("a")
+		d.Set("file_size", 1500000)
+		err := ic.Importables["databricks_dbfs_file"].Import(ic, &resource{ID: "a", Data: d})
+		assert.EqualError(t, err, "incomplete read of a: got 3 bytes out of 1500000")
+	})
+}
+
+func TestDataSourcesForExternalObjects(t *testing.T) {
+	testGenerate(t, []qa.HTTPFixture{
+		{
+			Method:       "GET",
+			ReuseRequest: true,
+			Resource:     "/api/2.0/instance-pools/get?instance_pool_id=pool1",
+			Response: map[string]any{
+				"instance_pool_id":   "pool1",
+				"instance_pool_name": "Shared Pool",
+				"node_type_id":       "i3.xlarge",
+			},
+		},
+	}, "jobs", false, func(ic *importContext) {
+		ic.emitDataSources = true
+		ic.Emit(&resource{
+			Resource: "databricks_instance_pool",
+			ID:       "pool1",
+		})
+		ic.waitGroup.Wait()
+		ic.closeImportChannels()
+
+		pools := *ic.State.Resources("databricks_instance_pool")
+		assert.Len(t, pools, 1)
+		ra := pools[0]
+		assert.Equal(t, "data", ra.Mode)
+		assert.Equal(t, "data.databricks_instance_pool.shared_pool.id",
+			string(hclwrite.TokensForTraversal(genTraversalTokens(ra, "id")).Bytes()))
+
+		ic.generateAndWriteResources(nil)
+		assert.Equal(t, commands.TrimLeadingWhitespace(`
+		data "databricks_instance_pool" "shared_pool" {
+		  name = "Shared Pool"
+		}`), getGeneratedFile(ic, "pools"))
+		content, _ := os.ReadFile(ic.Directory + "/import.sh")
+		assert.NotContains(t, string(content), "databricks_instance_pool")
+	})
+}
+
+func TestDataSourceTypeOf(t *testing.T) {
+	ds := resourcesMap["databricks_sql_endpoint"].DataSource
+	assert.Equal(t, "databricks_sql_warehouse", ds.typeOf("databricks_sql_endpoint"))
+	ds = resourcesMap["databricks_group"].DataSource
+	assert.Equal(t, "databricks_group", ds.typeOf("databricks_group"))
+}
+
+func TestDataSourcesForExternalObjectsRequireFlag(t *testing.T) {
+	ic := importContextForTest()
+	ic.enableServices("jobs")
+	ic.Emit(&resource{Resource: "databricks_instance_pool", ID: "pool1"})
+	// notebooks have no data source to refer to them
+	ic.emitDataSources = true
+	ic.Emit(&resource{Resource: "databricks_notebook", ID: "/a"})
+	assert.Len(t, ic.testEmits, 0)
+
+	ic.Emit(&resource{Resource: "databricks_instance_pool", ID: "pool1"})
+	assert.True(t, ic.testEmits["databricks_instance_pool[<unknown>] (id: pool1)"])
+}
+
 func TestSqlListObjects(t *testing.T) {
 	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
 		{
@@ -1434,38 +1882,1811 @@ func TestListUcAllowListSuccess(t *testing.T) {
 	assert.Equal(t, len(ic.testEmits), 3)
 }
 
-func TestEmitSqlParent(t *testing.T) {
-	ic := importContextForTest()
-	ic.enableServices("directories")
-	ic.emitSqlParentDirectory("")
-	assert.Equal(t, 0, len(ic.testEmits))
-	ic.emitSqlParentDirectory("folders/12345")
-	assert.Equal(t, 1, len(ic.testEmits))
-	assert.Contains(t, ic.testEmits, "databricks_directory[<unknown>] (object_id: 12345)")
+func TestListUcCatalogsSuccess(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/unity-catalog/catalogs",
+			Response: catalog.ListCatalogsResponse{
+				Catalogs: []catalog.CatalogInfo{
+					{Name: "main", CatalogType: catalog.CatalogTypeManagedCatalog},
+					{Name: "system", CatalogType: catalog.CatalogTypeSystemCatalog},
+					{Name: "hive_metastore"},
+					{Name: "__databricks_internal"},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTestWithClient(ctx, client)
+		ic.enableServices("uc-catalogs")
+		ic.currentMetastore = currentMetastoreResponse
+		err := resourcesMap["databricks_catalog"].List(ic)
+		assert.NoError(t, err)
+		assert.Len(t, ic.testEmits, 1)
+		assert.True(t, ic.testEmits["databricks_catalog[<unknown>] (id: main)"])
+	})
 }
 
-func TestEmitFilesFromSlice(t *testing.T) {
+func TestListUcCatalogsErrorGetMetastore(t *testing.T) {
 	ic := importContextForTest()
-	ic.enableServices("storage,notebooks")
-	ic.emitFilesFromSlice([]string{
-		"dbfs:/FileStore/test.txt",
-		"/Workspace/Shared/test.txt",
-		"nothing",
-	})
-	assert.Equal(t, 2, len(ic.testEmits))
-	assert.Contains(t, ic.testEmits, "databricks_dbfs_file[<unknown>] (id: dbfs:/FileStore/test.txt)")
-	assert.Contains(t, ic.testEmits, "databricks_workspace_file[<unknown>] (id: /Shared/test.txt)")
+	err := resourcesMap["databricks_catalog"].List(ic)
+	assert.EqualError(t, err, "there is no UC metastore information")
 }
 
-func TestEmitFilesFromMap(t *testing.T) {
-	ic := importContextForTest()
-	ic.enableServices("storage,notebooks")
-	ic.emitFilesFromMap(map[string]string{
-		"k1": "dbfs:/FileStore/test.txt",
-		"k2": "/Workspace/Shared/test.txt",
-		"k3": "nothing",
+func TestImportUcCatalogEmitsSchemas(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/unity-catalog/schemas?catalog_name=main",
+			Response: catalog.ListSchemasResponse{
+				Schemas: []catalog.SchemaInfo{
+					{Name: "sales", CatalogName: "main", FullName: "main.sales"},
+					{Name: "information_schema", CatalogName: "main", FullName: "main.information_schema"},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTestWithClient(ctx, client)
+		ic.enableServices("uc-catalogs")
+		d := tfcatalog.ResourceCatalog().ToResource().TestResourceData()
+		d.SetId("main")
+		d.Set("name", "main")
+		err := resourcesMap["databricks_catalog"].Import(ic, &resource{ID: "main", Data: d})
+		assert.NoError(t, err)
+		assert.Len(t, ic.testEmits, 1)
+		assert.True(t, ic.testEmits["databricks_schema[<unknown>] (id: main.sales)"])
+	})
+}
+
+func TestImportUcIsolatedCatalogEmitsBindings(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/unity-catalog/bindings/catalog/main?",
+			Response: catalog.WorkspaceBindingsResponse{
+				Bindings: []catalog.WorkspaceBinding{
+					{WorkspaceId: 123, BindingType: catalog.WorkspaceBindingBindingTypeBindingTypeReadWrite},
+					{WorkspaceId: 456, BindingType: catalog.WorkspaceBindingBindingTypeBindingTypeReadOnly},
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/unity-catalog/schemas?catalog_name=main",
+			Response: catalog.ListSchemasResponse{},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTestWithClient(ctx, client)
+		ic.enableServices("uc-catalogs")
+		d := tfcatalog.ResourceCatalog().ToResource().TestResourceData()
+		d.SetId("main")
+		d.Set("name", "main")
+		d.Set("isolation_mode", "ISOLATED")
+		err := resourcesMap["databricks_catalog"].Import(ic, &resource{ID: "main", Data: d})
+		assert.NoError(t, err)
+		assert.Len(t, ic.testEmits, 2)
+		assert.True(t, ic.testEmits["databricks_catalog_workspace_binding[<unknown>] (id: 123|catalog|main)"])
+		assert.True(t, ic.testEmits["databricks_catalog_workspace_binding[<unknown>] (id: 456|catalog|main)"])
+
+		b := tfcatalog.ResourceCatalogWorkspaceBinding().ToResource().TestResourceData()
+		b.Set("securable_type", "catalog")
+		b.Set("securable_name", "main")
+		b.Set("workspace_id", 123)
+		assert.Equal(t, "catalog_main_123", resourcesMap["databricks_catalog_workspace_binding"].Name(ic, b))
+	})
+}
+
+func TestImportUcSharedCatalogSkipsSchemas(t *testing.T) {
+	ic := importContextForTest()
+	ic.enableServices("uc-catalogs")
+	d := tfcatalog.ResourceCatalog().ToResource().TestResourceData()
+	d.SetId("shared")
+	d.Set("share_name", "share")
+	err := resourcesMap["databricks_catalog"].Import(ic, &resource{ID: "shared", Data: d})
+	assert.NoError(t, err)
+	assert.Len(t, ic.testEmits, 0)
+}
+
+func TestImportUcSchemaEmitsTables(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/unity-catalog/tables?catalog_name=main&schema_name=sales",
+			Response: catalog.ListTablesResponse{
+				Tables: []catalog.TableInfo{
+					{FullName: "main.sales.orders", TableType: catalog.TableTypeManaged},
+					{FullName: "main.sales.orders_view", TableType: catalog.TableTypeView},
+					{FullName: "main.sales.orders_mv", TableType: catalog.TableTypeMaterializedView},
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/unity-catalog/volumes?catalog_name=main&schema_name=sales",
+			Response: catalog.ListVolumesResponseContent{
+				Volumes: []catalog.VolumeInfo{
+					{FullName: "main.sales.raw", VolumeType: catalog.VolumeTypeManaged},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTestWithClient(ctx, client)
+		ic.enableServices("uc-catalogs,uc-volumes,uc-grants")
+		d := tfcatalog.ResourceSchema().ToResource().TestResourceData()
+		d.SetId("main.sales")
+		d.Set("name", "sales")
+		d.Set("catalog_name", "main")
+		err := resourcesMap["databricks_schema"].Import(ic, &resource{ID: "main.sales", Data: d})
+		assert.NoError(t, err)
+		assert.Len(t, ic.testEmits, 4)
+		assert.True(t, ic.testEmits["databricks_grants[<unknown>] (id: schema/main.sales)"])
+		assert.True(t, ic.testEmits["databricks_sql_table[<unknown>] (id: main.sales.orders)"])
+		assert.True(t, ic.testEmits["databricks_sql_table[<unknown>] (id: main.sales.orders_view)"])
+		assert.True(t, ic.testEmits["databricks_volume[<unknown>] (id: main.sales.raw)"])
+	})
+}
+
+func TestListUcStorageCredentialsAndExternalLocations(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/unity-catalog/storage-credentials?",
+			Response: catalog.ListStorageCredentialsResponse{
+				StorageCredentials: []catalog.StorageCredentialInfo{
+					{Name: "creds"},
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/unity-catalog/external-locations?",
+			Response: catalog.ListExternalLocationsResponse{
+				ExternalLocations: []catalog.ExternalLocationInfo{
+					{Name: "landing", CredentialName: "creds"},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTestWithClient(ctx, client)
+		ic.enableServices("uc-storage")
+		ic.currentMetastore = currentMetastoreResponse
+		err := resourcesMap["databricks_storage_credential"].List(ic)
+		assert.NoError(t, err)
+		err = resourcesMap["databricks_external_location"].List(ic)
+		assert.NoError(t, err)
+		assert.Len(t, ic.testEmits, 2)
+		assert.True(t, ic.testEmits["databricks_storage_credential[<unknown>] (id: creds)"])
+		assert.True(t, ic.testEmits["databricks_external_location[<unknown>] (id: landing)"])
+	})
+}
+
+func TestListUcExternalLocationsErrorGetMetastore(t *testing.T) {
+	ic := importContextForTest()
+	err := resourcesMap["databricks_external_location"].List(ic)
+	assert.EqualError(t, err, "there is no UC metastore information")
+	err = resourcesMap["databricks_storage_credential"].List(ic)
+	assert.EqualError(t, err, "there is no UC metastore information")
+}
+
+func TestImportUcExternalLocationEmitsCredential(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/unity-catalog/bindings/external_location/landing?",
+			Response: catalog.WorkspaceBindingsResponse{},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTestWithClient(ctx, client)
+		ic.enableServices("uc-storage,uc-grants")
+		d := tfcatalog.ResourceExternalLocation().ToResource().TestResourceData()
+		d.SetId("landing")
+		d.Set("name", "landing")
+		d.Set("credential_name", "creds")
+		err := resourcesMap["databricks_external_location"].Import(ic, &resource{ID: "landing", Data: d})
+		assert.NoError(t, err)
+		assert.Len(t, ic.testEmits, 2)
+		assert.True(t, ic.testEmits["databricks_storage_credential[<unknown>] (id: creds)"])
+		assert.True(t, ic.testEmits["databricks_grants[<unknown>] (id: external_location/landing)"])
+	})
+}
+
+func TestImportUcIsolatedExternalLocationEmitsBindings(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/unity-catalog/bindings/external_location/landing?",
+			Response: catalog.WorkspaceBindingsResponse{
+				Bindings: []catalog.WorkspaceBinding{
+					{WorkspaceId: 123, BindingType: catalog.WorkspaceBindingBindingTypeBindingTypeReadOnly},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTestWithClient(ctx, client)
+		ic.enableServices("uc-storage,uc-catalogs")
+		d := tfcatalog.ResourceExternalLocation().ToResource().TestResourceData()
+		d.SetId("landing")
+		d.Set("name", "landing")
+		d.Set("credential_name", "creds")
+		err := resourcesMap["databricks_external_location"].Import(ic, &resource{ID: "landing", Data: d})
+		assert.NoError(t, err)
+		assert.Len(t, ic.testEmits, 2)
+		assert.True(t, ic.testEmits["databricks_catalog_workspace_binding[<unknown>] (id: 123|external_location|landing)"])
+	})
+}
+
+func TestImportUcExternalLocationBindingsError(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/unity-catalog/bindings/external_location/landing?",
+			Status:   403,
+			Response: apierr.APIError{
+				ErrorCode: "PERMISSION_DENIED",
+				Message:   "No access",
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTestWithClient(ctx, client)
+		ic.enableServices("uc-storage,uc-catalogs")
+		d := tfcatalog.ResourceExternalLocation().ToResource().TestResourceData()
+		d.SetId("landing")
+		d.Set("name", "landing")
+		d.Set("credential_name", "creds")
+		err := resourcesMap["databricks_external_location"].Import(ic, &resource{ID: "landing", Data: d})
+		assert.NoError(t, err)
+		assert.True(t, ic.testEmits["databricks_storage_credential[<unknown>] (id: creds)"])
+		assert.Equal(t, 1, len(ic.ignoredResources))
+	})
+}
+
+func TestUcWorkspaceBindingReferencesSecurableOfItsType(t *testing.T) {
+	ic := importContextForTest()
+	for _, resourceType := range []string{"databricks_catalog", "databricks_external_location"} {
+		ic.State.Append(resourceApproximation{
+			Type: resourceType,
+			Name: "landing",
+			Mode: "managed",
+			Instances: []instanceApproximation{{Attributes: map[string]any{
+				"id": "landing", "name": "landing"}}},
+		})
+	}
+	pr := tfcatalog.ResourceCatalogWorkspaceBinding().ToResource()
+	for securableType, expected := range map[string]string{
+		"catalog":           "databricks_catalog.landing.id",
+		"external_location": "databricks_external_location.landing.id",
+	} {
+		d := pr.TestResourceData()
+		d.SetId("123|" + securableType + "|landing")
+		d.Set("securable_type", securableType)
+		d.Set("securable_name", "landing")
+		d.Set("workspace_id", 123)
+		body := hclwrite.NewEmptyFile().Body()
+		err := ic.dataToHcl(ic.Importables["databricks_catalog_workspace_binding"], []string{}, pr, d, body)
+		assert.NoError(t, err)
+		hcl := string(hclwrite.Format(body.BuildTokens(nil).Bytes()))
+		assert.Contains(t, hcl, expected)
+	}
+}
+
+func TestUcStorageCredentialSecretsAreVariables(t *testing.T) {
+	ic := importContextForTest()
+	ic.variables = map[string]string{}
+	d := tfcatalog.ResourceStorageCredential().ToResource().TestResourceData()
+	d.SetId("creds")
+	d.Set("name", "creds")
+	d.Set("azure_service_principal", []any{
+		map[string]any{
+			"directory_id":   "tenant",
+			"application_id": "app",
+		},
+	})
+	body := hclwrite.NewEmptyFile().Body()
+	err := ic.dataToHcl(ic.Importables["databricks_storage_credential"], []string{},
+		ic.Resources["databricks_storage_credential"], d, body)
+	assert.NoError(t, err)
+	assert.Contains(t, string(body.BuildTokens(nil).Bytes()), "var.azure_service_principal_creds")
+	assert.Contains(t, ic.variables, "azure_service_principal_creds")
+}
+
+func TestServerDefaultsAreOmitted(t *testing.T) {
+	ic := importContextForTest()
+	d := clusters.ResourceCluster().ToResource().TestResourceData()
+	d.SetId("abc")
+	d.Set("cluster_name", "test")
+	d.Set("aws_attributes", []any{
+		map[string]any{
+			"zone_id":      "auto",
+			"availability": "SPOT",
+		},
+	})
+	body := hclwrite.NewEmptyFile().Body()
+	err := ic.dataToHcl(ic.Importables["databricks_cluster"], []string{},
+		ic.Resources["databricks_cluster"], d, body)
+	assert.NoError(t, err)
+	hcl := string(body.BuildTokens(nil).Bytes())
+	assert.Contains(t, hcl, "SPOT")
+	assert.NotContains(t, hcl, "zone_id")
+}
+
+func TestListUcVolumes(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/unity-catalog/catalogs",
+			Response: catalog.ListCatalogsResponse{
+				Catalogs: []catalog.CatalogInfo{
+					{Name: "main", CatalogType: catalog.CatalogTypeManagedCatalog},
+					{Name: "shared", CatalogType: catalog.CatalogTypeDeltasharingCatalog, ShareName: "share"},
+					{Name: "system", CatalogType: catalog.CatalogTypeSystemCatalog},
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/unity-catalog/schemas?catalog_name=main",
+			Response: catalog.ListSchemasResponse{
+				Schemas: []catalog.SchemaInfo{
+					{Name: "sales", CatalogName: "main", FullName: "main.sales"},
+					{Name: "information_schema", CatalogName: "main", FullName: "main.information_schema"},
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/unity-catalog/volumes?catalog_name=main&schema_name=sales",
+			Response: catalog.ListVolumesResponseContent{
+				Volumes: []catalog.VolumeInfo{
+					{Name: "raw", FullName: "main.sales.raw"},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTestWithClient(ctx, client)
+		ic.enableServices("uc-volumes")
+		ic.currentMetastore = currentMetastoreResponse
+		err := resourcesMap["databricks_volume"].List(ic)
+		assert.NoError(t, err)
+		assert.Len(t, ic.testEmits, 1)
+		assert.True(t, ic.testEmits["databricks_volume[<unknown>] (id: main.sales.raw)"])
+	})
+}
+
+func TestImportUcVolumeEmitsFiles(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/fs/directories/Volumes/main/sales/raw?",
+			Response: map[string]any{
+				"contents": []storage.DirectoryEntry{
+					{Path: "/Volumes/main/sales/raw/a.csv", Name: "a.csv"},
+					{Path: "/Volumes/main/sales/raw/dir", Name: "dir", IsDirectory: true},
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/fs/directories/Volumes/main/sales/raw/dir?",
+			Response: map[string]any{
+				"contents": []storage.DirectoryEntry{
+					{Path: "/Volumes/main/sales/raw/dir/b.csv", Name: "b.csv"},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTestWithClient(ctx, client)
+		ic.enableServices("uc-volumes")
+		d := tfcatalog.ResourceVolume().ToResource().TestResourceData()
+		d.SetId("main.sales.raw")
+		d.Set("volume_path", "/Volumes/main/sales/raw")
+
+		err := resourcesMap["databricks_volume"].Import(ic, &resource{ID: "main.sales.raw", Data: d})
+		assert.NoError(t, err)
+		assert.Len(t, ic.testEmits, 0)
+
+		ic.exportVolumeContent = true
+		err = resourcesMap["databricks_volume"].Import(ic, &resource{ID: "main.sales.raw", Data: d})
+		assert.NoError(t, err)
+		assert.Len(t, ic.testEmits, 2)
+		assert.True(t, ic.testEmits["databricks_file[<unknown>] (id: /Volumes/main/sales/raw/a.csv)"])
+		assert.True(t, ic.testEmits["databricks_file[<unknown>] (id: /Volumes/main/sales/raw/dir/b.csv)"])
+	})
+}
+
+func TestImportUcVolumeFile(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/fs/files/Volumes/main/sales/raw/a.csv?",
+			Response: "a,b\n1,2",
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTestWithClient(ctx, client)
+		ic.Directory = t.TempDir()
+		d := storage.ResourceFile().ToResource().TestResourceData()
+		d.SetId("/Volumes/main/sales/raw/a.csv")
+		d.Set("path", "/Volumes/main/sales/raw/a.csv")
+		err := resourcesMap["databricks_file"].Import(ic, &resource{ID: "/Volumes/main/sales/raw/a.csv", Data: d})
+		assert.NoError(t, err)
+		assert.Equal(t, "uc_volume_files/Volumes/main/sales/raw/a.csv", d.Get("source"))
+		content, err := os.ReadFile(ic.Directory + "/uc_volume_files/Volumes/main/sales/raw/a.csv")
+		assert.NoError(t, err)
+		assert.Equal(t, "a,b\n1,2", string(content))
+	})
+}
+
+func TestImportUcGrantsEmitsPrincipals(t *testing.T) {
+	ic := importContextForTest()
+	ic.enableServices("uc-grants,groups")
+	d := tfcatalog.ResourceGrants().ToResource().TestResourceData()
+	d.SetId("catalog/main")
+	d.Set("catalog", "main")
+	d.Set("grant", []any{
+		map[string]any{
+			"principal":  "data engineers",
+			"privileges": []any{"USE_CATALOG"},
+		},
+	})
+	err := resourcesMap["databricks_grants"].Import(ic, &resource{ID: "catalog/main", Data: d})
+	assert.NoError(t, err)
+	assert.Len(t, ic.testEmits, 1)
+	assert.True(t, ic.testEmits["databricks_group[<unknown>] (display_name: data engineers)"])
+}
+
+func TestUcVolumeShouldOmitField(t *testing.T) {
+	ic := importContextForTest()
+	pr := tfcatalog.ResourceVolume().ToResource()
+	d := pr.TestResourceData()
+	shouldOmit := resourcesMap["databricks_volume"].ShouldOmitField
+	d.Set("volume_type", "MANAGED")
+	assert.True(t, shouldOmit(ic, "storage_location", pr.Schema["storage_location"], d))
+	d.Set("volume_type", "EXTERNAL")
+	assert.False(t, shouldOmit(ic, "storage_location", pr.Schema["storage_location"], d))
+}
+
+func TestUcSqlTableShouldOmitField(t *testing.T) {
+	ic := importContextForTest()
+	pr := tfcatalog.ResourceSqlTable().ToResource()
+	d := pr.TestResourceData()
+	shouldOmit := resourcesMap["databricks_sql_table"].ShouldOmitField
+	d.Set("table_type", "MANAGED")
+	assert.False(t, shouldOmit(ic, "column", pr.Schema["column"], d))
+	assert.True(t, shouldOmit(ic, "storage_location", pr.Schema["storage_location"], d))
+	d.Set("table_type", "EXTERNAL")
+	assert.False(t, shouldOmit(ic, "storage_location", pr.Schema["storage_location"], d))
+	d.Set("table_type", "VIEW")
+	assert.True(t, shouldOmit(ic, "column", pr.Schema["column"], d))
+}
+
+// appendSchemasWithSameName adds to the state schemas with the same name in different catalogs
+func appendSchemasWithSameName(ic *importContext) {
+	for _, catalogName := range []string{"cat_a", "cat_b"} {
+		ic.State.Append(resourceApproximation{
+			Type: "databricks_schema",
+			Name: catalogName + "_default",
+			Mode: "managed",
+			Instances: []instanceApproximation{{Attributes: map[string]any{
+				"id": catalogName + ".default", "name": "default", "catalog_name": catalogName}}},
+		})
+	}
+}
+
+func TestUcSqlTableReferencesSchemaInSameCatalog(t *testing.T) {
+	ic := importContextForTest()
+	appendSchemasWithSameName(ic)
+	pr := tfcatalog.ResourceSqlTable().ToResource()
+	d := pr.TestResourceData()
+	d.SetId("cat_b.default.orders")
+	d.Set("catalog_name", "cat_b")
+	d.Set("schema_name", "default")
+	d.Set("name", "orders")
+	d.Set("table_type", "MANAGED")
+	body := hclwrite.NewEmptyFile().Body()
+	err := ic.dataToHcl(ic.Importables["databricks_sql_table"], []string{}, pr, d, body)
+	assert.NoError(t, err)
+	hcl := string(hclwrite.Format(body.BuildTokens(nil).Bytes()))
+	assert.Contains(t, hcl, "schema_name  = databricks_schema.cat_b_default.name")
+}
+
+func TestUcVolumeReferencesSchemaInSameCatalog(t *testing.T) {
+	ic := importContextForTest()
+	appendSchemasWithSameName(ic)
+	pr := tfcatalog.ResourceVolume().ToResource()
+	d := pr.TestResourceData()
+	d.SetId("cat_a.default.files")
+	d.Set("catalog_name", "cat_a")
+	d.Set("schema_name", "default")
+	d.Set("name", "files")
+	d.Set("volume_type", "MANAGED")
+	body := hclwrite.NewEmptyFile().Body()
+	err := ic.dataToHcl(ic.Importables["databricks_volume"], []string{}, pr, d, body)
+	assert.NoError(t, err)
+	hcl := string(hclwrite.Format(body.BuildTokens(nil).Bytes()))
+	assert.Contains(t, hcl, "schema_name  = databricks_schema.cat_a_default.name")
+}
+
+func TestUcRegisteredModelReferencesSchemaInSameCatalog(t *testing.T) {
+	ic := importContextForTest()
+	appendSchemasWithSameName(ic)
+	pr := tfcatalog.ResourceRegisteredModel().ToResource()
+	d := pr.TestResourceData()
+	d.SetId("cat_b.default.churn")
+	d.Set("catalog_name", "cat_b")
+	d.Set("schema_name", "default")
+	d.Set("name", "churn")
+	body := hclwrite.NewEmptyFile().Body()
+	err := ic.dataToHcl(ic.Importables["databricks_registered_model"], []string{}, pr, d, body)
+	assert.NoError(t, err)
+	hcl := string(hclwrite.Format(body.BuildTokens(nil).Bytes()))
+	assert.Contains(t, hcl, "schema_name  = databricks_schema.cat_b_default.name")
+}
+
+func TestEmitSqlParent(t *testing.T) {
+	ic := importContextForTest()
+	ic.enableServices("directories")
+	ic.emitSqlParentDirectory("")
+	assert.Equal(t, 0, len(ic.testEmits))
+	ic.emitSqlParentDirectory("folders/12345")
+	assert.Equal(t, 1, len(ic.testEmits))
+	assert.Contains(t, ic.testEmits, "databricks_directory[<unknown>] (object_id: 12345)")
+}
+
+func TestEmitFilesFromSlice(t *testing.T) {
+	ic := importContextForTest()
+	ic.enableServices("storage,notebooks")
+	ic.emitFilesFromSlice([]string{
+		"dbfs:/FileStore/test.txt",
+		"/Workspace/Shared/test.txt",
+		"nothing",
+	})
+	assert.Equal(t, 2, len(ic.testEmits))
+	assert.Contains(t, ic.testEmits, "databricks_dbfs_file[<unknown>] (id: dbfs:/FileStore/test.txt)")
+	assert.Contains(t, ic.testEmits, "databricks_workspace_file[<unknown>] (id: /Shared/test.txt)")
+}
+
+func TestEmitFilesFromMap(t *testing.T) {
+	ic := importContextForTest()
+	ic.enableServices("storage,notebooks")
+	ic.emitFilesFromMap(map[string]string{
+		"k1": "dbfs:/FileStore/test.txt",
+		"k2": "/Workspace/Shared/test.txt",
+		"k3": "nothing",
+	})
+	assert.Equal(t, 2, len(ic.testEmits))
+	assert.Contains(t, ic.testEmits, "databricks_dbfs_file[<unknown>] (id: dbfs:/FileStore/test.txt)")
+	assert.Contains(t, ic.testEmits, "databricks_workspace_file[<unknown>] (id: /Shared/test.txt)")
+}
+
+func TestSqlEndpointPermissionsWithoutAccessService(t *testing.T) {
+	d := tfsql.ResourceSqlEndpoint().ToResource().TestResourceData()
+	d.Set("name", "abc")
+	ic := importContextForTest()
+	ic.enableServices("sql-endpoints")
+	ic.meAdmin = true
+	err := ic.Importables["databricks_sql_endpoint"].Import(ic, &resource{
+		ID:   "123",
+		Data: d,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, ic.testEmits, 2)
+	assert.True(t, ic.testEmits["databricks_permissions[sql_endpoint_abc] (id: /sql/warehouses/123)"])
+	assert.True(t, ic.testEmits["databricks_sql_global_config[<unknown>] (id: global)"])
+
+	ir := ic.Importables["databricks_permissions"]
+	assert.Equal(t, "sql-endpoints", ic.resourceService(ir, &resource{ID: "/sql/warehouses/123"}))
+	assert.Equal(t, "access", ic.resourceService(ir, &resource{ID: "/clusters/123"}))
+}
+
+func TestSqlGlobalConfigImport(t *testing.T) {
+	d := tfsql.ResourceSqlGlobalConfig().ToResource().TestResourceData()
+	d.Set("instance_profile_arn", "arn:aws:iam::123:instance-profile/sql")
+	d.Set("data_access_config", map[string]any{
+		"spark.hadoop.fs.azure.account.oauth2.client.secret": "{{secrets/sql/secret}}",
+		"spark.hadoop.fs.azure.account.auth.type":            "OAuth",
+	})
+	ic := importContextForTest()
+	ic.enableServices("sql-endpoints,access,secrets")
+	err := ic.Importables["databricks_sql_global_config"].Import(ic, &resource{
+		ID:   "global",
+		Data: d,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, ic.testEmits, 2)
+	assert.True(t, ic.testEmits["databricks_instance_profile[<unknown>] (id: arn:aws:iam::123:instance-profile/sql)"])
+	assert.True(t, ic.testEmits["databricks_secret_scope[<unknown>] (id: sql)"])
+}
+
+func TestEmitRepoContent(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/workspace/list?path=%2FRepos%2Fuser%40domain.com%2Frepo",
+			Response: workspace.ObjectList{
+				Objects: []workspace.ObjectStatus{
+					{
+						Path:       "/Repos/user@domain.com/repo/notebook",
+						ObjectType: workspace.Notebook,
+					},
+					{
+						Path:       "/Repos/user@domain.com/repo/src",
+						ObjectType: workspace.Directory,
+					},
+					{
+						Path:       "/Repos/user@domain.com/repo/.bundle",
+						ObjectType: workspace.Directory,
+					},
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/workspace/list?path=%2FRepos%2Fuser%40domain.com%2Frepo%2Fsrc",
+			Response: workspace.ObjectList{
+				Objects: []workspace.ObjectStatus{
+					{
+						Path:       "/Repos/user@domain.com/repo/src/utils.py",
+						ObjectType: workspace.File,
+					},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTestWithClient(ctx, client)
+		ic.enableServices("notebooks")
+		err := ic.emitRepoContent("/Repos/user@domain.com/repo")
+		assert.NoError(t, err)
+		assert.Len(t, ic.testEmits, 2)
+		assert.True(t, ic.testEmits["databricks_notebook[<unknown>] (id: /Repos/user@domain.com/repo/notebook)"])
+		assert.True(t, ic.testEmits["databricks_workspace_file[<unknown>] (id: /Repos/user@domain.com/repo/src/utils.py)"])
+	})
+}
+
+func TestRepoListExportsContentOnlyWithoutGitProvider(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/repos?",
+			Response: repos.ReposListResponse{
+				Repos: []repos.ReposInformation{
+					{ID: 123, Url: "https://github.com/user/test.git", Path: "/Repos/user@domain.com/test"},
+					{ID: 124, Path: "/Repos/user@domain.com/repo"},
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/workspace/list?path=%2FRepos%2Fuser%40domain.com%2Frepo",
+			Response: workspace.ObjectList{
+				Objects: []workspace.ObjectStatus{
+					{
+						Path:       "/Repos/user@domain.com/repo/notebook",
+						ObjectType: workspace.Notebook,
+					},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTestWithClient(ctx, client)
+		ic.enableServices("repos,notebooks")
+		ic.exportRepoContent = true
+		err := resourcesMap["databricks_repo"].List(ic)
+		assert.NoError(t, err)
+		assert.Len(t, ic.testEmits, 2)
+		assert.True(t, ic.testEmits["databricks_repo[<unknown>] (id: 123)"])
+		assert.True(t, ic.testEmits["databricks_notebook[<unknown>] (id: /Repos/user@domain.com/repo/notebook)"])
+	})
+}
+
+func TestImportWorkspaceConfSkipsDefaults(t *testing.T) {
+	conf := map[string]any{
+		"enableIpAccessLists":               "true",
+		"enableTokensConfig":                "true",
+		"maxTokenLifetimeDays":              "90",
+		"maxUserInactiveDays":               "",
+		"enableDeprecatedGlobalInitScripts": "false",
+	}
+	for _, includeDefaults := range []bool{false, true} {
+		qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: fmt.Sprintf("/api/2.0/workspace-conf?keys=%s", workspaceConfKeysToURL()),
+				Response: conf,
+			},
+		}, func(ctx context.Context, client *common.DatabricksClient) {
+			ic := importContextForTestWithClient(ctx, client)
+			ic.workspaceConfKeys = workspaceConfKeys
+			ic.includeDefaultConf = includeDefaults
+			d := workspace.ResourceWorkspaceConf().ToResource().TestResourceData()
+			err := resourcesMap["databricks_workspace_conf"].Import(ic, &resource{ID: globalWorkspaceConfName, Data: d})
+			assert.NoError(t, err)
+			customConfig := d.Get("custom_config").(map[string]any)
+			if includeDefaults {
+				assert.Equal(t, map[string]any{
+					"enableIpAccessLists":               "true",
+					"enableTokensConfig":                "true",
+					"maxTokenLifetimeDays":              "90",
+					"enableDeprecatedGlobalInitScripts": "false",
+				}, customConfig)
+			} else {
+				assert.Equal(t, map[string]any{
+					"enableIpAccessLists":  "true",
+					"maxTokenLifetimeDays": "90",
+				}, customConfig)
+			}
+		})
+	}
+}
+
+func TestListUcRegisteredModels(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/unity-catalog/models?",
+			Response: catalog.ListRegisteredModelsResponse{
+				RegisteredModels: []catalog.RegisteredModelInfo{
+					{Name: "churn", CatalogName: "main", SchemaName: "ml", FullName: "main.ml.churn"},
+					{Name: "llama", CatalogName: "system", SchemaName: "ai", FullName: "system.ai.llama"},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTestWithClient(ctx, client)
+		ic.enableServices("uc-models")
+		ic.currentMetastore = currentMetastoreResponse
+		err := resourcesMap["databricks_registered_model"].List(ic)
+		assert.NoError(t, err)
+		assert.Len(t, ic.testEmits, 1)
+		assert.True(t, ic.testEmits["databricks_registered_model[<unknown>] (id: main.ml.churn)"])
+	})
+}
+
+func TestListUcRegisteredModelsErrorGetMetastore(t *testing.T) {
+	ic := importContextForTest()
+	err := resourcesMap["databricks_registered_model"].List(ic)
+	assert.EqualError(t, err, "there is no UC metastore information")
+}
+
+func TestImportUcRegisteredModelEmitsGrants(t *testing.T) {
+	ic := importContextForTest()
+	ic.enableServices("uc-models,uc-grants")
+	d := tfcatalog.ResourceRegisteredModel().ToResource().TestResourceData()
+	d.SetId("main.ml.churn")
+	err := resourcesMap["databricks_registered_model"].Import(ic, &resource{ID: "main.ml.churn", Data: d})
+	assert.NoError(t, err)
+	assert.Len(t, ic.testEmits, 1)
+	assert.True(t, ic.testEmits["databricks_grants[<unknown>] (id: model/main.ml.churn)"])
+}
+
+func TestImportModelServingEmitsUcModels(t *testing.T) {
+	ic := importContextForTest()
+	ic.enableServices("model-serving,uc-models")
+	d := serving.ResourceModelServing().ToResource().TestResourceData()
+	d.SetId("churn-endpoint")
+	d.Set("config", []any{
+		map[string]any{
+			"served_models": []any{
+				map[string]any{"model_name": "main.ml.churn", "model_version": "1", "workload_size": "Small"},
+				map[string]any{"model_name": "workspace-model", "model_version": "2", "workload_size": "Small"},
+			},
+		},
+	})
+	err := resourcesMap["databricks_model_serving"].Import(ic, &resource{ID: "churn-endpoint", Data: d})
+	assert.NoError(t, err)
+	assert.Len(t, ic.testEmits, 1)
+	assert.True(t, ic.testEmits["databricks_registered_model[<unknown>] (id: main.ml.churn)"])
+}
+
+func TestImportUcTableEmitsLakehouseMonitor(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/unity-catalog/tables/main.sales.orders/monitor?",
+			Response: catalog.MonitorInfo{
+				TableName:        "main.sales.orders",
+				OutputSchemaName: "main.monitoring",
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/unity-catalog/tables/main.sales.customers/monitor?",
+			Status:   404,
+			Response: apierr.NotFound("no monitor"),
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTestWithClient(ctx, client)
+		ic.enableServices("uc-catalogs,uc-monitors")
+		for _, name := range []string{"main.sales.orders", "main.sales.customers"} {
+			d := tfcatalog.ResourceSqlTable().ToResource().TestResourceData()
+			d.SetId(name)
+			err := resourcesMap["databricks_sql_table"].Import(ic, &resource{ID: name, Data: d})
+			assert.NoError(t, err)
+		}
+		assert.Len(t, ic.testEmits, 1)
+		assert.True(t, ic.testEmits["databricks_lakehouse_monitor[<unknown>] (id: main.sales.orders)"])
+	})
+}
+
+func TestImportLakehouseMonitor(t *testing.T) {
+	ic := importContextForTest()
+	ic.enableServices("uc-catalogs,uc-monitors")
+	d := tfcatalog.ResourceLakehouseMonitor().ToResource().TestResourceData()
+	d.SetId("main.sales.orders")
+	d.Set("table_name", "main.sales.orders")
+	d.Set("output_schema_name", "main.monitoring")
+	d.Set("baseline_table_name", "main.sales.orders_baseline")
+	err := resourcesMap["databricks_lakehouse_monitor"].Import(ic, &resource{ID: "main.sales.orders", Data: d})
+	assert.NoError(t, err)
+	assert.Len(t, ic.testEmits, 2)
+	assert.True(t, ic.testEmits["databricks_schema[<unknown>] (id: main.monitoring)"])
+	assert.True(t, ic.testEmits["databricks_sql_table[<unknown>] (id: main.sales.orders_baseline)"])
+}
+
+func TestSqlTableIgnoresLakehouseMonitorOutputTables(t *testing.T) {
+	ic := importContextForTest()
+	ic.State.Append(resourceApproximation{
+		Type: "databricks_lakehouse_monitor",
+		Name: "main_sales_orders",
+		Mode: "managed",
+		Instances: []instanceApproximation{{Attributes: map[string]any{
+			"id":                         "main.sales.orders",
+			"table_name":                 "main.sales.orders",
+			"profile_metrics_table_name": "main.monitoring.orders_profile_metrics",
+			"drift_metrics_table_name":   "main.monitoring.orders_drift_metrics",
+		}}},
+	})
+	ignore := ic.Importables["databricks_sql_table"].Ignore
+	assert.True(t, ignore(ic, &resource{ID: "main.monitoring.orders_profile_metrics"}))
+	assert.True(t, ignore(ic, &resource{ID: "main.monitoring.orders_drift_metrics"}))
+	assert.False(t, ignore(ic, &resource{ID: "main.sales.orders"}))
+	assert.False(t, ignore(ic, &resource{ID: "main.monitoring.returns_profile_metrics"}))
+	assert.Equal(t, 2, len(ic.ignoredResources))
+}
+
+func TestLakehouseMonitorToHcl(t *testing.T) {
+	ic := importContextForTest()
+	d := tfcatalog.ResourceLakehouseMonitor().ToResource().TestResourceData()
+	d.SetId("main.sales.orders")
+	d.Set("table_name", "main.sales.orders")
+	d.Set("assets_dir", "/Shared/monitors/orders")
+	d.Set("output_schema_name", "main.monitoring")
+	d.Set("snapshot", []any{map[string]any{}})
+	d.Set("schedule", []any{map[string]any{
+		"quartz_cron_expression": "0 0 12 * * ?",
+		"timezone_id":            "UTC",
+	}})
+	d.Set("custom_metrics", []any{map[string]any{
+		"name":             "total_amount",
+		"definition":       "sum(amount)",
+		"input_columns":    []any{":table"},
+		"output_data_type": "double",
+		"type":             "CUSTOM_METRIC_TYPE_AGGREGATE",
+	}})
+	d.Set("status", "MONITOR_STATUS_ACTIVE")
+	body := hclwrite.NewEmptyFile().Body()
+	err := ic.dataToHcl(ic.Importables["databricks_lakehouse_monitor"], []string{},
+		ic.Resources["databricks_lakehouse_monitor"], d, body)
+	assert.NoError(t, err)
+	hcl := string(hclwrite.Format(body.BuildTokens(nil).Bytes()))
+	assert.Contains(t, hcl, "snapshot {")
+	assert.Contains(t, hcl, `quartz_cron_expression = "0 0 12 * * ?"`)
+	assert.Contains(t, hcl, `name             = "total_amount"`)
+	assert.NotContains(t, hcl, "status")
+}
+
+func TestListLakeviewDashboards(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/lakeview/dashboards?page_size=100",
+			Response: map[string]any{
+				"dashboards": []dashboards.Dashboard{
+					{DashboardID: "abc", DisplayName: "Sales", LifecycleState: "ACTIVE"},
+					{DashboardID: "def", DisplayName: "Old", LifecycleState: "TRASHED"},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTestWithClient(ctx, client)
+		ic.enableServices("dashboards")
+		err := resourcesMap["databricks_dashboard"].List(ic)
+		assert.NoError(t, err)
+		assert.Len(t, ic.testEmits, 1)
+		assert.True(t, ic.testEmits["databricks_dashboard[<unknown>] (id: abc)"])
+	})
+}
+
+func TestImportLakeviewDashboard(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/lakeview/dashboards/abc",
+			Response: dashboards.Dashboard{
+				DashboardID:         "abc",
+				DisplayName:         "Sales",
+				WarehouseID:         "1234",
+				ParentPath:          "/Workspace/Shared/dashboards",
+				SerializedDashboard: `{"pages":[]}`,
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTestWithClient(ctx, client)
+		ic.enableServices("dashboards,sql-endpoints,directories,access")
+		ic.meAdmin = true
+		ic.Directory = t.TempDir()
+		d := dashboards.ResourceDashboard().ToResource().TestResourceData()
+		d.SetId("abc")
+		d.Set("display_name", "Sales")
+		err := resourcesMap["databricks_dashboard"].Import(ic, &resource{ID: "abc", Name: "sales_abc", Data: d})
+		assert.NoError(t, err)
+		assert.Equal(t, "dashboards/sales_abc.lvdash.json", d.Get("serialized_dashboard_file"))
+		content, err := os.ReadFile(ic.Directory + "/dashboards/sales_abc.lvdash.json")
+		assert.NoError(t, err)
+		assert.Equal(t, `{"pages":[]}`, string(content))
+		assert.Len(t, ic.testEmits, 3)
+		assert.True(t, ic.testEmits["databricks_sql_endpoint[<unknown>] (id: 1234)"])
+		assert.True(t, ic.testEmits["databricks_directory[<unknown>] (id: /Shared/dashboards)"])
+		assert.True(t, ic.testEmits["databricks_permissions[dashboard_Sales_abc] (id: /dashboards/abc)"])
+	})
+}
+
+func TestEmitEntitlements(t *testing.T) {
+	ic := importContextForTest()
+	ic.enableServices("users,groups")
+	d := scim.ResourceUser().ToResource().TestResourceData()
+	d.SetId("123")
+	d.Set("user_name", "test@example.com")
+
+	// entitlements are embedded into identities by default
+	d.Set("allow_cluster_create", true)
+	ic.emitEntitlements("user", "123", "test_123", d)
+	assert.Len(t, ic.testEmits, 0)
+
+	ic.separateEntitlements = true
+	ic.emitEntitlements("user", "123", "test_123", d)
+	assert.Len(t, ic.testEmits, 1)
+	assert.True(t, ic.testEmits["databricks_entitlements[user_test_123] (id: user/123)"])
+
+	// identities without entitlements don't need a separate resource
+	d.Set("allow_cluster_create", false)
+	ic.emitEntitlements("group", "456", "test_456", d)
+	assert.Len(t, ic.testEmits, 1)
+}
+
+func TestUserWithSeparateEntitlementsToHcl(t *testing.T) {
+	ic := importContextForTest()
+	ic.separateEntitlements = true
+	d := scim.ResourceUser().ToResource().TestResourceData()
+	d.SetId("123")
+	d.Set("user_name", "test@example.com")
+	d.Set("allow_cluster_create", true)
+	d.Set("workspace_access", true)
+	body := hclwrite.NewEmptyFile().Body()
+	err := identityBlockBody(ic, body, &resource{
+		Resource: "databricks_user",
+		ID:       "123",
+		Name:     "test_123",
+		Data:     d,
+	})
+	assert.NoError(t, err)
+	hcl := string(hclwrite.Format(body.BuildTokens(nil).Bytes()))
+	assert.Contains(t, hcl, `user_name = "test@example.com"`)
+	assert.NotContains(t, hcl, "allow_cluster_create =")
+	assert.NotContains(t, hcl, "workspace_access =")
+	assert.Contains(t, hcl, `lifecycle {
+    ignore_changes = [allow_cluster_create, allow_instance_pool_create, databricks_sql_access, workspace_access]
+  }`)
+}
+
+func TestImportJobsSkipsBundleJobs(t *testing.T) {
+	ic := importContextForTest()
+	ic.enableServices("jobs")
+	l := []jobs.Job{
+		{JobID: 1, Settings: &jobs.JobSettings{Name: "manual"}},
+		{JobID: 2, Settings: &jobs.JobSettings{Name: "[dev] bundle job", Deployment: &sdk_jobs.JobDeployment{
+			Kind:             sdk_jobs.JobDeploymentKindBundle,
+			MetadataFilePath: "/Users/user@domain.com/.bundle/dev/state/metadata.json",
+		}}},
+	}
+	ic.importJobs(l)
+	assert.Len(t, ic.testEmits, 1)
+	assert.True(t, ic.testEmits["databricks_job[<unknown>] (id: 1)"])
+	assert.Len(t, ic.ignoredResources, 0)
+	assert.Equal(t, "/Users/user@domain.com/.bundle/dev/state/metadata.json",
+		ic.bundleManaged["databricks_job. job_id=2"].Message)
+
+	ic.includeBundleManaged = true
+	ic.importJobs(l)
+	assert.Len(t, ic.testEmits, 2)
+	assert.True(t, ic.testEmits["databricks_job[<unknown>] (id: 2)"])
+}
+
+func TestImportJobsFilteredByOwner(t *testing.T) {
+	ic := importContextForTest()
+	ic.enableServices("jobs")
+	ic.owners = parseOwners("User@domain.com")
+	ic.importJobs([]jobs.Job{
+		{JobID: 1, CreatorUserName: "other@domain.com", Settings: &jobs.JobSettings{Name: "other"}},
+		{JobID: 2, CreatorUserName: "user@domain.com", Settings: &jobs.JobSettings{Name: "created"}},
+		{JobID: 3, CreatorUserName: "other@domain.com", RunAsUserName: "user@domain.com",
+			Settings: &jobs.JobSettings{Name: "run as"}},
+	})
+	assert.Len(t, ic.testEmits, 2)
+	assert.True(t, ic.testEmits["databricks_job[<unknown>] (id: 2)"])
+	assert.True(t, ic.testEmits["databricks_job[<unknown>] (id: 3)"])
+}
+
+func TestPipelineEmitsRunAsServicePrincipalSecret(t *testing.T) {
+	appID := "3f670caf-9a4b-4479-8143-1a0878da8f57"
+	spFixture := qa.ListServicePrincipalsFixtures([]iam.ServicePrincipal{
+		{Id: "321", DisplayName: "spn", ApplicationId: appID},
+	})
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/pipelines/abc?",
+			Response: sdk_pipelines.GetPipelineResponse{PipelineId: "abc", RunAsUserName: appID},
+		},
+		spFixture[0],
+		spFixture[1],
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/ServicePrincipals/321?attributes=userName,displayName,active,externalId,entitlements,groups,roles",
+			Response: scim.User{ID: "321", DisplayName: "spn", ApplicationID: appID},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/accounts/xyz/servicePrincipals/321/credentials/secrets",
+			Response: tokens.ListServicePrincipalSecrets{
+				Secrets: []tokens.ServicePrincipalSecret{{ID: "s1", Status: "ACTIVE"}},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTestWithClient(ctx, client)
+		ic.enableServices("dlt,users")
+		ic.Client.Config.AccountID = "xyz"
+		d := pipelines.ResourcePipeline().ToResource().TestResourceData()
+		d.SetId("abc")
+		err := resourcesMap["databricks_pipeline"].Import(ic, &resource{Resource: "databricks_pipeline", ID: "abc", Data: d})
+		assert.NoError(t, err)
+		assert.True(t, ic.testEmits["databricks_service_principal_secret[<unknown>] (id: 321)"])
+	})
+}
+
+func TestPipelineIgnoresBundlePipelines(t *testing.T) {
+	ic := importContextForTest()
+	ic.Directory = t.TempDir()
+	d := pipelines.ResourcePipeline().ToResource().TestResourceData()
+	d.SetId("abc")
+	d.Set("library", []any{map[string]any{"notebook": []any{map[string]any{"path": "/Users/a/dlt"}}}})
+	d.Set("deployment", []any{map[string]any{
+		"kind":               "BUNDLE",
+		"metadata_file_path": "/Users/a/.bundle/dev/state/metadata.json",
+	}})
+	r := &resource{Resource: "databricks_pipeline", ID: "abc", Data: d}
+
+	err := resourcesMap["databricks_pipeline"].Import(ic, r)
+	assert.NoError(t, err)
+	assert.Len(t, ic.testEmits, 0)
+	assert.True(t, resourcesMap["databricks_pipeline"].Ignore(ic, r))
+
+	err = ic.writeBundleManagedResources()
+	assert.NoError(t, err)
+	content, err := os.ReadFile(ic.Directory + "/bundle-managed.txt")
+	assert.NoError(t, err)
+	assert.Equal(t, "databricks_pipeline. id=abc (metadata: /Users/a/.bundle/dev/state/metadata.json)\n",
+		string(content))
+
+	ic.includeBundleManaged = true
+	assert.False(t, resourcesMap["databricks_pipeline"].Ignore(ic, r))
+}
+
+func TestListSqlEndpointsSkipsStarterWarehouses(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/sql/warehouses?",
+			Response: sql.ListWarehousesResponse{
+				Warehouses: []sql.EndpointInfo{
+					{Id: "1", Name: "Serverless Starter Warehouse"},
+					{Id: "2", Name: "ETL"},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTestWithClient(ctx, client)
+		ic.enableServices("sql-endpoints")
+		err := resourcesMap["databricks_sql_endpoint"].List(ic)
+		assert.NoError(t, err)
+		assert.Len(t, ic.testEmits, 1)
+		assert.True(t, ic.testEmits["databricks_sql_endpoint[<unknown>] (id: 2)"])
+		assert.Len(t, ic.ignoredResources, 1)
+	})
+}
+
+func TestListGroupsEmitsBuiltInGroups(t *testing.T) {
+	ic := importContextForTest()
+	ic.enableServices("groups")
+	ic.allGroups = []scim.Group{
+		{ID: "1", DisplayName: "admins"},
+		{ID: "2", DisplayName: "users"},
+		{ID: "3", DisplayName: "data-engineers"},
+	}
+	err := resourcesMap["databricks_group"].List(ic)
+	assert.NoError(t, err)
+	// built-in groups are generated as data sources, so their membership is still exported
+	assert.Len(t, ic.testEmits, 3)
+	assert.True(t, ic.testEmits["databricks_group[<unknown>] (id: 1)"])
+	assert.Len(t, ic.ignoredResources, 0)
+}
+
+func TestListVectorSearchEndpoints(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/vector-search/endpoints?",
+			Response: vectorsearch.ListEndpointResponse{
+				Endpoints: []vectorsearch.EndpointInfo{{Name: "abc"}},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/vector-search/indexes?endpoint_name=abc",
+			Response: vectorsearch.ListVectorIndexesResponse{
+				VectorIndexes: []vectorsearch.MiniVectorIndex{{Name: "main.default.docs_index"}},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTestWithClient(ctx, client)
+		ic.enableServices("vector-search")
+		err := resourcesMap["databricks_vector_search_endpoint"].List(ic)
+		assert.NoError(t, err)
+		assert.Len(t, ic.testEmits, 1)
+		assert.True(t, ic.testEmits["databricks_vector_search_endpoint[<unknown>] (id: abc)"])
+
+		err = resourcesMap["databricks_vector_search_endpoint"].Import(ic, &resource{ID: "abc"})
+		assert.NoError(t, err)
+		assert.True(t, ic.testEmits["databricks_vector_search_index[<unknown>] (id: main.default.docs_index)"])
+	})
+}
+
+func TestImportVectorSearchIndex(t *testing.T) {
+	ic := importContextForTest()
+	ic.enableServices("vector-search,uc-catalogs,model-serving")
+	d := tfvectorsearch.ResourceVectorSearchIndex().ToResource().TestResourceData()
+	d.SetId("main.default.docs_index")
+	d.Set("endpoint_name", "abc")
+	d.Set("delta_sync_index_spec", []any{map[string]any{
+		"source_table": "main.default.docs",
+		"embedding_source_columns": []any{map[string]any{
+			"name": "text",
+			"embedding_config": []any{map[string]any{
+				"embedding_model_endpoint_name": "e5-small-v2",
+			}},
+		}},
+	}})
+	err := resourcesMap["databricks_vector_search_index"].Import(ic, &resource{ID: d.Id(), Data: d})
+	assert.NoError(t, err)
+	assert.Len(t, ic.testEmits, 3)
+	assert.True(t, ic.testEmits["databricks_vector_search_endpoint[<unknown>] (id: abc)"])
+	assert.True(t, ic.testEmits["databricks_sql_table[<unknown>] (id: main.default.docs)"])
+	assert.True(t, ic.testEmits["databricks_model_serving[<unknown>] (id: e5-small-v2)"])
+}
+
+func TestListMlflowExperiments(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/mlflow/experiments/list?",
+			Response: ml.ListExperimentsResponse{
+				Experiments: []ml.Experiment{
+					{ExperimentId: "123", Name: "/Users/user@domain.com/exp"},
+					{ExperimentId: "456", Name: "/Users/user@domain.com/notebook", Tags: []ml.ExperimentTag{
+						{Key: "mlflow.experimentType", Value: "NOTEBOOK"},
+					}},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTestWithClient(ctx, client)
+		ic.enableServices("mlflow")
+		err := resourcesMap["databricks_mlflow_experiment"].List(ic)
+		assert.NoError(t, err)
+		assert.Len(t, ic.testEmits, 1)
+		assert.True(t, ic.testEmits["databricks_mlflow_experiment[<unknown>] (id: 123)"])
+		assert.Equal(t, ignoreReasonNotebookBound,
+			ic.ignoredResources["databricks_mlflow_experiment. id=456"].Reason)
+	})
+}
+
+func TestImportMlflowExperiment(t *testing.T) {
+	ic := importContextForTest()
+	ic.enableServices("mlflow,users,access")
+	ic.meAdmin = true
+	d := mlflow.ResourceMlflowExperiment().ToResource().TestResourceData()
+	d.SetId("123")
+	d.Set("name", "/Shared/exp")
+	d.Set("artifact_location", "dbfs:/databricks/mlflow-tracking/123")
+	ir := resourcesMap["databricks_mlflow_experiment"]
+	assert.Equal(t, "exp_123", ir.Name(ic, d))
+
+	err := ir.Import(ic, &resource{ID: "123", Data: d})
+	assert.NoError(t, err)
+	assert.Len(t, ic.testEmits, 1)
+	assert.True(t, ic.testEmits["databricks_permissions[experiment_exp_123] (id: /experiments/123)"])
+
+	s := ic.Resources["databricks_mlflow_experiment"].Schema
+	assert.True(t, ir.ShouldOmitField(ic, "artifact_location", s["artifact_location"], d))
+	d.Set("artifact_location", "s3://bucket/exp")
+	assert.False(t, ir.ShouldOmitField(ic, "artifact_location", s["artifact_location"], d))
+}
+
+func TestListMlflowModels(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/mlflow/registered-models/list?",
+			Response: ml.ListModelsResponse{
+				RegisteredModels: []ml.Model{{Name: "churn"}},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTestWithClient(ctx, client)
+		ic.enableServices("mlflow")
+		err := resourcesMap["databricks_mlflow_model"].List(ic)
+		assert.NoError(t, err)
+		assert.Len(t, ic.testEmits, 1)
+		assert.True(t, ic.testEmits["databricks_mlflow_model[<unknown>] (id: churn)"])
+	})
+}
+
+func TestImportMlflowModel(t *testing.T) {
+	ic := importContextForTest()
+	ic.enableServices("mlflow,access")
+	ic.meAdmin = true
+	d := mlflow.ResourceMlflowModel().ToResource().TestResourceData()
+	d.SetId("churn")
+	d.Set("name", "churn")
+	d.Set("registered_model_id", "abc")
+	err := resourcesMap["databricks_mlflow_model"].Import(ic, &resource{ID: "churn", Data: d})
+	assert.NoError(t, err)
+	assert.True(t, ic.testEmits["databricks_permissions[mlflow_model_churn] (id: /registered-models/abc)"])
+}
+
+func TestListNotificationDestinations(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/notification-destinations?",
+			Response: tfsettings.NotificationDestinationsList{
+				Results: []tfsettings.NotificationDestinationInfo{
+					{
+						ID:                      "abc",
+						NotificationDestination: tfsettings.NotificationDestination{DisplayName: "alerts"},
+					},
+					{
+						ID:                      "def",
+						NotificationDestination: tfsettings.NotificationDestination{DisplayName: "test"},
+					},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTestWithClient(ctx, client)
+		ic.enableServices("notification-destinations")
+		ic.match = "alert"
+		err := resourcesMap["databricks_notification_destination"].List(ic)
+		assert.NoError(t, err)
+		assert.Len(t, ic.testEmits, 1)
+		assert.True(t, ic.testEmits["databricks_notification_destination[<unknown>] (id: abc)"])
+	})
+}
+
+func TestImportNotificationDestination(t *testing.T) {
+	ic := importContextForTest()
+	ic.enableServices("notification-destinations,users")
+	d := tfsettings.ResourceNotificationDestination().ToResource().TestResourceData()
+	d.SetId("abc")
+	d.Set("display_name", "team")
+	d.Set("config", []any{
+		map[string]any{
+			"email": []any{
+				map[string]any{
+					"addresses": []any{"user@domain.com"},
+				},
+			},
+		},
+	})
+	err := resourcesMap["databricks_notification_destination"].Import(ic, &resource{ID: "abc", Data: d})
+	assert.NoError(t, err)
+	assert.True(t, ic.testEmits["databricks_user[<unknown>] (user_name: user@domain.com)"])
+}
+
+func TestNotificationDestinationSecretsAreVariables(t *testing.T) {
+	ic := importContextForTest()
+	ic.variables = map[string]string{}
+	d := tfsettings.ResourceNotificationDestination().ToResource().TestResourceData()
+	d.SetId("abc")
+	d.Set("display_name", "hooks")
+	d.Set("config", []any{
+		map[string]any{
+			"generic_webhook": []any{
+				map[string]any{
+					"url_set":      true,
+					"password_set": true,
+				},
+			},
+		},
+	})
+	body := hclwrite.NewEmptyFile().Body()
+	err := ic.dataToHcl(ic.Importables["databricks_notification_destination"], []string{},
+		ic.Resources["databricks_notification_destination"], d, body)
+	assert.NoError(t, err)
+	hcl := string(body.BuildTokens(nil).Bytes())
+	assert.Contains(t, hcl, "var.config_generic_webhook_url_hooks_abc")
+	assert.Contains(t, hcl, "var.config_generic_webhook_password_hooks_abc")
+	assert.NotContains(t, hcl, "username")
+	assert.Len(t, ic.variables, 2)
+}
+
+func TestImportJobEmitsNotificationDestinations(t *testing.T) {
+	ic := importContextForTest()
+	ic.enableServices("jobs,notification-destinations")
+	d := jobs.ResourceJob().ToResource().TestResourceData()
+	d.SetId("123")
+	d.Set("name", "job")
+	d.Set("webhook_notifications", []any{
+		map[string]any{
+			"on_failure": []any{map[string]any{"id": "abc"}},
+		},
+	})
+	d.Set("task", []any{
+		map[string]any{
+			"task_key": "a",
+			"webhook_notifications": []any{
+				map[string]any{
+					"on_duration_warning_threshold_exceeded": []any{map[string]any{"id": "def"}},
+				},
+			},
+		},
+	})
+	err := resourcesMap["databricks_job"].Import(ic, &resource{ID: "123", Data: d})
+	assert.NoError(t, err)
+	assert.True(t, ic.testEmits["databricks_notification_destination[<unknown>] (id: abc)"])
+	assert.True(t, ic.testEmits["databricks_notification_destination[<unknown>] (id: def)"])
+}
+
+func TestListAccountUsersAndServicePrincipals(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/Users?attributes=id%2CuserName%2Cactive&count=100&startIndex=1",
+			Response: scim.UserList{
+				Resources: []scim.User{
+					{ID: "u2", UserName: "test@example.com"},
+				},
+				TotalResults: 2,
+				StartIndex:   1,
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/Users?attributes=id%2CuserName%2Cactive&count=100&startIndex=2",
+			Response: scim.UserList{
+				Resources: []scim.User{
+					{ID: "u1", UserName: "admin@example.com"},
+				},
+				TotalResults: 2,
+				StartIndex:   2,
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/ServicePrincipals?attributes=id%2CuserName%2Cactive&count=100&startIndex=1",
+			Response: scim.UserList{
+				Resources: []scim.User{
+					{ID: "s1", ApplicationID: "abc"},
+				},
+				TotalResults: 1,
+				StartIndex:   1,
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTestWithClient(ctx, client)
+		ic.accountLevel = true
+		ic.enableServices("users")
+		ic.match = "test"
+
+		err := resourcesMap["databricks_user"].List(ic)
+		assert.NoError(t, err)
+		err = resourcesMap["databricks_service_principal"].List(ic)
+		assert.NoError(t, err)
+		assert.Len(t, ic.testEmits, 1)
+		assert.True(t, ic.testEmits["databricks_user[<unknown>] (id: u2)"])
+
+		ic.match = ""
+		err = resourcesMap["databricks_service_principal"].List(ic)
+		assert.NoError(t, err)
+		assert.True(t, ic.testEmits["databricks_service_principal[<unknown>] (id: s1)"])
+	})
+}
+
+func TestListUsersIsNoopOnWorkspaceLevel(t *testing.T) {
+	ic := importContextForTest()
+	ic.enableServices("users")
+	err := resourcesMap["databricks_user"].List(ic)
+	assert.NoError(t, err)
+	err = resourcesMap["databricks_service_principal"].List(ic)
+	assert.NoError(t, err)
+	assert.Len(t, ic.testEmits, 0)
+}
+
+func TestListMwsPermissionAssignments(t *testing.T) {
+	qa.MockAccountsApply(t, func(a *mocks.MockAccountClient) {
+		a.GetMockWorkspacesAPI().EXPECT().List(mock.Anything).Return([]provisioning.Workspace{
+			{WorkspaceId: 123, WorkspaceStatus: provisioning.WorkspaceStatusRunning},
+			{WorkspaceId: 456, WorkspaceStatus: provisioning.WorkspaceStatusRunning},
+			{WorkspaceId: 789, WorkspaceStatus: provisioning.WorkspaceStatusProvisioning},
+		}, nil)
+		api := a.GetMockWorkspaceAssignmentAPI().EXPECT()
+		api.ListByWorkspaceId(mock.Anything, int64(123)).Return(&iam.PermissionAssignments{
+			PermissionAssignments: []iam.PermissionAssignment{
+				{
+					Permissions: []iam.WorkspacePermission{iam.WorkspacePermissionAdmin},
+					Principal:   &iam.PrincipalOutput{PrincipalId: 1, GroupName: "admins"},
+				},
+				{
+					Permissions: []iam.WorkspacePermission{iam.WorkspacePermissionUser},
+					Principal:   &iam.PrincipalOutput{PrincipalId: 2, UserName: "test@example.com"},
+				},
+				{
+					Permissions: []iam.WorkspacePermission{iam.WorkspacePermissionUser},
+					Principal:   &iam.PrincipalOutput{PrincipalId: 3, ServicePrincipalName: "abc"},
+				},
+				{
+					Error:     "principal is deleted",
+					Principal: &iam.PrincipalOutput{PrincipalId: 4},
+				},
+			},
+		}, nil)
+		api.ListByWorkspaceId(mock.Anything, int64(456)).Return(nil, &apierr.APIError{
+			StatusCode: 400,
+			Message:    "Permission assignment APIs are not available for this workspace",
+		})
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTest()
+		ic.Client = client
+		ic.Context = ctx
+		ic.accountLevel = true
+		ic.accountClient, _ = client.AccountClient()
+		ic.enableServices("idfed,users,groups")
+
+		err := resourcesMap["databricks_mws_permission_assignment"].List(ic)
+		assert.NoError(t, err)
+		assert.Len(t, ic.testEmits, 6)
+		assert.True(t, ic.testEmits["databricks_mws_permission_assignment[123_admins] (id: 123|1)"])
+		assert.True(t, ic.testEmits["databricks_mws_permission_assignment[123_test@example.com] (id: 123|2)"])
+		assert.True(t, ic.testEmits["databricks_mws_permission_assignment[123_abc] (id: 123|3)"])
+		assert.True(t, ic.testEmits["databricks_group[<unknown>] (id: 1)"])
+		assert.True(t, ic.testEmits["databricks_user[<unknown>] (id: 2)"])
+		assert.True(t, ic.testEmits["databricks_service_principal[<unknown>] (id: 3)"])
+	})
+}
+
+func TestListAccountSettings(t *testing.T) {
+	ic := importContextForTest()
+	ic.accountLevel = true
+	ic.enableServices("settings")
+	err := resourcesMap["databricks_generic_setting"].List(ic)
+	assert.NoError(t, err)
+	assert.Len(t, ic.testEmits, 3)
+	assert.True(t, ic.testEmits["databricks_generic_setting[<unknown>] (id: shield_esm_enablement_ac)"])
+	assert.True(t, ic.testEmits["databricks_generic_setting[<unknown>] (id: shield_csp_enablement_ac)"])
+	assert.True(t, ic.testEmits["databricks_generic_setting[<unknown>] (id: dcp_acct_enable)"])
+}
+
+func TestIgnoreAccountSettingsThatWereNeverWritten(t *testing.T) {
+	ic := importContextForTest()
+	d := tfsettings.ResourceGenericSetting().ToResource().TestResourceData()
+	d.SetId("shield_esm_enablement_ac")
+	d.Set("value", "{}")
+	ir := resourcesMap["databricks_generic_setting"]
+	assert.True(t, ir.Ignore(ic, &resource{ID: d.Id(), Data: d}))
+	assert.Len(t, ic.ignoredResources, 1)
+
+	d.Set("value", `{"esm_enablement_account":{"is_enforced":true}}`)
+	assert.False(t, ir.Ignore(ic, &resource{ID: d.Id(), Data: d}))
+	assert.Equal(t, "shield_esm_enablement_ac", ir.Name(ic, d))
+}
+
+func TestListAndImportBudgets(t *testing.T) {
+	qa.MockAccountsApply(t, func(a *mocks.MockAccountClient) {
+		a.GetMockBudgetsAPI().EXPECT().ListAll(mock.Anything).Return([]billing.BudgetWithStatus{
+			{BudgetId: "b1", Name: "team"},
+			{BudgetId: "b2", Name: "other"},
+		}, nil)
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTest()
+		ic.Client = client
+		ic.Context = ctx
+		ic.accountLevel = true
+		ic.accountClient, _ = client.AccountClient()
+		ic.enableServices("billing,users")
+		ic.match = "team"
+
+		err := resourcesMap["databricks_budget"].List(ic)
+		assert.NoError(t, err)
+		assert.Len(t, ic.testEmits, 1)
+		assert.True(t, ic.testEmits["databricks_budget[<unknown>] (id: b1)"])
+
+		d := mws.ResourceBudget().ToResource().TestResourceData()
+		d.SetId("b1")
+		d.Set("name", "team")
+		d.Set("alerts", []any{
+			map[string]any{
+				"email_notifications": []any{"user@domain.com"},
+				"min_percentage":      50,
+			},
+		})
+		err = resourcesMap["databricks_budget"].Import(ic, &resource{ID: "b1", Data: d})
+		assert.NoError(t, err)
+		assert.True(t, ic.testEmits["databricks_user[<unknown>] (user_name: user@domain.com)"])
+		assert.Equal(t, "team_b1", resourcesMap["databricks_budget"].Name(ic, d))
+	})
+}
+
+func TestListAndImportMwsWorkspaces(t *testing.T) {
+	qa.MockAccountsApply(t, func(a *mocks.MockAccountClient) {
+		a.GetMockWorkspacesAPI().EXPECT().List(mock.Anything).Return([]provisioning.Workspace{
+			{WorkspaceId: 123, WorkspaceName: "prod", WorkspaceStatus: provisioning.WorkspaceStatusRunning},
+			{WorkspaceId: 456, WorkspaceName: "failed", WorkspaceStatus: provisioning.WorkspaceStatusFailed},
+		}, nil)
+		a.GetMockCredentialsAPI().EXPECT().List(mock.Anything).Return([]provisioning.Credential{
+			{CredentialsId: "cred", CredentialsName: "prod-creds"},
+		}, nil)
+		a.GetMockStorageAPI().EXPECT().List(mock.Anything).Return([]provisioning.StorageConfiguration{
+			{StorageConfigurationId: "storage", StorageConfigurationName: "prod-storage"},
+		}, nil)
+		a.GetMockNetworksAPI().EXPECT().List(mock.Anything).Return([]provisioning.Network{
+			{NetworkId: "network", NetworkName: "prod-network"},
+		}, nil)
+		a.GetMockPrivateAccessAPI().EXPECT().List(mock.Anything).Return([]provisioning.PrivateAccessSettings{
+			{PrivateAccessSettingsId: "pas", PrivateAccessSettingsName: "prod-pas"},
+		}, nil)
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTest()
+		ic.Client = client
+		ic.Context = ctx
+		ic.accountLevel = true
+		ic.accountClient, _ = client.AccountClient()
+		ic.enableServices("mws")
+		accountID := client.Config.AccountID
+
+		for _, name := range []string{"databricks_mws_workspaces", "databricks_mws_credentials",
+			"databricks_mws_storage_configurations", "databricks_mws_networks",
+			"databricks_mws_private_access_settings"} {
+			err := resourcesMap[name].List(ic)
+			assert.NoError(t, err)
+		}
+		assert.Len(t, ic.testEmits, 5)
+		assert.True(t, ic.testEmits["databricks_mws_workspaces[<unknown>] (id: "+accountID+"/123)"])
+		assert.True(t, ic.testEmits["databricks_mws_credentials[<unknown>] (id: "+accountID+"/cred)"])
+		assert.True(t, ic.testEmits["databricks_mws_storage_configurations[<unknown>] (id: "+accountID+"/storage)"])
+		assert.True(t, ic.testEmits["databricks_mws_networks[<unknown>] (id: "+accountID+"/network)"])
+		assert.True(t, ic.testEmits["databricks_mws_private_access_settings[<unknown>] (id: "+accountID+"/pas)"])
+
+		ic.testEmits = map[string]bool{}
+		d := mws.ResourceMwsWorkspaces().ToResource().TestResourceData()
+		d.SetId(accountID + "/123")
+		d.Set("workspace_name", "prod")
+		d.Set("credentials_id", "cred")
+		d.Set("storage_configuration_id", "storage")
+		d.Set("network_id", "network")
+		err := resourcesMap["databricks_mws_workspaces"].Import(ic, &resource{ID: d.Id(), Data: d})
+		assert.NoError(t, err)
+		assert.Len(t, ic.testEmits, 3)
+		assert.True(t, ic.testEmits["databricks_mws_credentials[<unknown>] (id: "+accountID+"/cred)"])
+		assert.True(t, ic.testEmits["databricks_mws_storage_configurations[<unknown>] (id: "+accountID+"/storage)"])
+		assert.True(t, ic.testEmits["databricks_mws_networks[<unknown>] (id: "+accountID+"/network)"])
+		assert.Equal(t, "prod", resourcesMap["databricks_mws_workspaces"].Name(ic, d))
+	})
+}
+
+func TestListAccountIpAccessLists(t *testing.T) {
+	qa.MockAccountsApply(t, func(a *mocks.MockAccountClient) {
+		a.GetMockAccountIpAccessListsAPI().EXPECT().ListAll(mock.Anything).Return([]settings.IpAccessListInfo{
+			{ListId: "123", Label: "office", ListType: settings.ListTypeAllow},
+		}, nil)
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTest()
+		ic.Client = client
+		ic.Context = ctx
+		ic.accountLevel = true
+		ic.accountClient, _ = client.AccountClient()
+		ic.enableServices("access")
+
+		err := resourcesMap["databricks_ip_access_list"].List(ic)
+		assert.NoError(t, err)
+		// workspace configuration isn't emitted at account level
+		assert.Len(t, ic.testEmits, 1)
+		assert.True(t, ic.testEmits["databricks_ip_access_list[<unknown>] (id: 123)"])
+	})
+}
+
+func TestListDefaultNamespaceSetting(t *testing.T) {
+	qa.MockWorkspaceApply(t, func(w *mocks.MockWorkspaceClient) {
+		w.GetMockSettingsAPI().EXPECT().ReadDefaultWorkspaceNamespace(mock.Anything,
+			settings.ReadDefaultWorkspaceNamespaceRequest{}).Return(&settings.DefaultNamespaceSetting{
+			Etag:      "etag1",
+			Namespace: settings.StringMessage{Value: "main"},
+		}, nil)
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTest()
+		ic.Client = client
+		ic.Context = ctx
+		ic.workspaceClient, _ = client.WorkspaceClient()
+		ic.enableServices("workspace")
+
+		err := resourcesMap["databricks_default_namespace_setting"].List(ic)
+		assert.NoError(t, err)
+		assert.Len(t, ic.testEmits, 1)
+		assert.True(t, ic.testEmits["databricks_default_namespace_setting[<unknown>] (id: etag1)"])
+	})
+}
+
+func TestListDefaultNamespaceSettingNeverWritten(t *testing.T) {
+	qa.MockWorkspaceApply(t, func(w *mocks.MockWorkspaceClient) {
+		w.GetMockSettingsAPI().EXPECT().ReadDefaultWorkspaceNamespace(mock.Anything,
+			settings.ReadDefaultWorkspaceNamespaceRequest{}).Return(nil, apierr.ErrNotFound)
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTest()
+		ic.Client = client
+		ic.Context = ctx
+		ic.workspaceClient, _ = client.WorkspaceClient()
+		ic.enableServices("workspace")
+
+		err := resourcesMap["databricks_default_namespace_setting"].List(ic)
+		assert.NoError(t, err)
+		assert.Len(t, ic.testEmits, 0)
+	})
+}
+
+func TestListTokens(t *testing.T) {
+	qa.MockWorkspaceApply(t, func(w *mocks.MockWorkspaceClient) {
+		w.GetMockCurrentUserAPI().EXPECT().Me(mock.Anything).Return(&iam.User{Id: "1", UserName: "me@example.com"}, nil)
+		w.GetMockTokenManagementAPI().EXPECT().ListAll(mock.Anything,
+			settings.ListTokenManagementRequest{}).Return([]settings.TokenInfo{
+			{TokenId: "t1", Comment: "ci", OwnerId: 1, CreatedById: 1, CreatedByUsername: "me@example.com"},
+			{TokenId: "t2", Comment: "obo", OwnerId: 2, CreatedById: 1, CreatedByUsername: "me@example.com"},
+			{TokenId: "t3", Comment: "other", OwnerId: 3, CreatedById: 3, CreatedByUsername: "other@example.com"},
+		}, nil)
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTest()
+		ic.Client = client
+		ic.Context = ctx
+		ic.workspaceClient, _ = client.WorkspaceClient()
+		ic.enableServices("tokens")
+		ic.exportTokens = true
+
+		err := resourcesMap["databricks_token"].List(ic)
+		assert.NoError(t, err)
+		assert.Len(t, ic.testEmits, 2)
+		assert.True(t, ic.testEmits["databricks_token[<unknown>] (id: t1)"])
+		assert.True(t, ic.testEmits["databricks_obo_token[<unknown>] (id: t2)"])
+		assert.Len(t, ic.ignoredResources, 1)
+	})
+}
+
+func TestListTokensWithoutFlag(t *testing.T) {
+	ic := importContextForTest()
+	ic.enableServices("tokens")
+	err := resourcesMap["databricks_token"].List(ic)
+	assert.NoError(t, err)
+	assert.Len(t, ic.testEmits, 0)
+}
+
+func TestImportOboToken(t *testing.T) {
+	qa.MockWorkspaceApply(t, func(w *mocks.MockWorkspaceClient) {
+		w.GetMockTokenManagementAPI().EXPECT().GetByTokenId(mock.Anything, "t2").Return(&settings.GetTokenResponse{
+			TokenInfo: &settings.TokenInfo{TokenId: "t2", Comment: "obo", OwnerId: 2,
+				CreationTime: 1000, ExpiryTime: 3601000},
+		}, nil)
+		w.GetMockServicePrincipalsAPI().EXPECT().GetById(mock.Anything, "2").Return(&iam.ServicePrincipal{
+			Id: "2", ApplicationId: "app-2",
+		}, nil)
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTest()
+		ic.Client = client
+		ic.Context = ctx
+		ic.workspaceClient, _ = client.WorkspaceClient()
+		ic.enableServices("tokens,users")
+
+		d := tokens.ResourceOboToken().ToResource().TestResourceData()
+		d.SetId("t2")
+		d.Set("comment", "obo")
+		err := resourcesMap["databricks_obo_token"].Import(ic, &resource{ID: "t2", Data: d})
+		assert.NoError(t, err)
+		assert.Equal(t, "app-2", d.Get("application_id"))
+		assert.Equal(t, 3600, d.Get("lifetime_seconds"))
+		assert.True(t, ic.testEmits["databricks_service_principal[<unknown>] (id: 2)"])
+		assert.Equal(t, "obo_t2", resourcesMap["databricks_obo_token"].Name(ic, d))
+	})
+}
+
+func TestGenerateOboTokenBody(t *testing.T) {
+	ic := importContextForTest()
+	ic.variables = map[string]string{}
+	d := tokens.ResourceOboToken().ToResource().TestResourceData()
+	d.SetId("t2")
+	d.Set("comment", "obo")
+	d.Set("application_id", "app-2")
+	d.Set("lifetime_seconds", 3600)
+	r := &resource{Resource: "databricks_obo_token", ID: "t2", Name: "obo_t2", Data: d}
+
+	f := hclwrite.NewEmptyFile()
+	err := generateOboTokenBody(ic, f.Body(), r)
+	assert.NoError(t, err)
+	assert.Contains(t, string(hclwrite.Format(f.Bytes())), "application_id   = var.application_id_obo_t2")
+	assert.Contains(t, ic.variables, "application_id_obo_t2")
+
+	ic.State.Append(resourceApproximation{
+		Type: "databricks_service_principal",
+		Name: "app_2",
+		Mode: "managed",
+		Instances: []instanceApproximation{{Attributes: map[string]any{
+			"id": "2", "application_id": "app-2"}}},
+	})
+	f = hclwrite.NewEmptyFile()
+	err = generateOboTokenBody(ic, f.Body(), r)
+	assert.NoError(t, err)
+	assert.Contains(t, string(hclwrite.Format(f.Bytes())),
+		"application_id   = databricks_service_principal.app_2.application_id")
+	assert.Equal(t, "", ic.generatedImportCommand(r, ic.Importables["databricks_obo_token"]))
+}
+
+func TestListSqlEndpointsSkipsInactive(t *testing.T) {
+	qa.MockWorkspaceApply(t, func(w *mocks.MockWorkspaceClient) {
+		w.GetMockWarehousesAPI().EXPECT().ListAll(mock.Anything, sql.ListWarehousesRequest{}).Return(
+			[]sql.EndpointInfo{
+				{Id: "1", Name: "running", State: sql.StateRunning},
+				{Id: "2", Name: "recently used", State: sql.StateStopped},
+				{Id: "3", Name: "unused", State: sql.StateStopped},
+			}, nil)
+		queries := w.GetMockQueryHistoryAPI().EXPECT()
+		queries.ListAll(mock.Anything, mock.MatchedBy(func(req sql.ListQueryHistoryRequest) bool {
+			return req.FilterBy.WarehouseIds[0] == "2"
+		})).Return([]sql.QueryInfo{{QueryId: "q1"}}, nil)
+		queries.ListAll(mock.Anything, mock.MatchedBy(func(req sql.ListQueryHistoryRequest) bool {
+			return req.FilterBy.WarehouseIds[0] == "3"
+		})).Return([]sql.QueryInfo{}, nil)
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTestWithClient(ctx, client)
+		ic.enableServices("sql-endpoints")
+		ic.lastActiveDays = 30
+
+		err := resourcesMap["databricks_sql_endpoint"].List(ic)
+		assert.NoError(t, err)
+		assert.Len(t, ic.testEmits, 2)
+		assert.True(t, ic.testEmits["databricks_sql_endpoint[<unknown>] (id: 1)"])
+		assert.True(t, ic.testEmits["databricks_sql_endpoint[<unknown>] (id: 2)"])
+	})
+}
+
+func TestListMlflowExperimentsSkipsInactive(t *testing.T) {
+	now := time.Now().UnixMilli()
+	qa.MockWorkspaceApply(t, func(w *mocks.MockWorkspaceClient) {
+		w.GetMockExperimentsAPI().EXPECT().ListExperimentsAll(mock.Anything, ml.ListExperimentsRequest{}).Return(
+			[]ml.Experiment{
+				{ExperimentId: "1", Name: "/Shared/recent", LastUpdateTime: now},
+				{ExperimentId: "2", Name: "/Shared/old", LastUpdateTime: now - 100*24*60*60*1000},
+			}, nil)
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTestWithClient(ctx, client)
+		ic.enableServices("mlflow")
+		ic.lastActiveDays = 30
+
+		err := resourcesMap["databricks_mlflow_experiment"].List(ic)
+		assert.NoError(t, err)
+		assert.Len(t, ic.testEmits, 1)
+		assert.True(t, ic.testEmits["databricks_mlflow_experiment[<unknown>] (id: 1)"])
 	})
-	assert.Equal(t, 2, len(ic.testEmits))
-	assert.Contains(t, ic.testEmits, "databricks_dbfs_file[<unknown>] (id: dbfs:/FileStore/test.txt)")
-	assert.Contains(t, ic.testEmits, "databricks_workspace_file[<unknown>] (id: /Shared/test.txt)")
 }
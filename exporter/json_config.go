@@ -0,0 +1,195 @@
+package exporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+)
+
+// hclToJSON converts the HCL file generated by the exporter into the JSON configuration syntax of Terraform:
+// https://developer.hashicorp.com/terraform/language/syntax/json
+func hclToJSON(content []byte, fileName string) ([]byte, error) {
+	file, diags := hclsyntax.ParseConfig(content, fileName, hcl.Pos{Line: 1, Column: 1})
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	conv := jsonConverter{src: content}
+	root := map[string]any{}
+	body := file.Body.(*hclsyntax.Body)
+	for name, attr := range body.Attributes {
+		root[name] = conv.expression(attr.Expr, false)
+	}
+	for _, block := range body.Blocks {
+		value := conv.body(block.Type, block.Body)
+		if len(block.Labels) == 0 {
+			// blocks like `locals`, `terraform` or `import` could be repeated
+			list, _ := root[block.Type].([]any)
+			root[block.Type] = append(list, value)
+			continue
+		}
+		parent, ok := root[block.Type].(map[string]any)
+		if !ok {
+			parent = map[string]any{}
+			root[block.Type] = parent
+		}
+		for _, label := range block.Labels[:len(block.Labels)-1] {
+			child, ok := parent[label].(map[string]any)
+			if !ok {
+				child = map[string]any{}
+				parent[label] = child
+			}
+			parent = child
+		}
+		parent[block.Labels[len(block.Labels)-1]] = value
+	}
+	return json.MarshalIndent(root, "", "  ")
+}
+
+type jsonConverter struct {
+	src []byte
+}
+
+// meta-arguments with references that are written as plain strings in the JSON syntax
+var jsonPlainReferences = map[string]map[string]bool{
+	"":          {"depends_on": true, "provider": true},
+	"import":    {"to": true, "provider": true},
+	"lifecycle": {"ignore_changes": true, "replace_triggered_by": true},
+}
+
+func (c jsonConverter) body(blockType string, body *hclsyntax.Body) map[string]any {
+	result := map[string]any{}
+	plain := jsonPlainReferences[blockType]
+	if plain == nil {
+		plain = jsonPlainReferences[""]
+	}
+	for name, attr := range body.Attributes {
+		result[name] = c.expression(attr.Expr, plain[name])
+	}
+	for _, block := range body.Blocks {
+		list, _ := result[block.Type].([]any)
+		result[block.Type] = append(list, c.body(block.Type, block.Body))
+	}
+	return result
+}
+
+func (c jsonConverter) source(expr hclsyntax.Expression) string {
+	return string(expr.Range().SliceBytes(c.src))
+}
+
+// expression converts literal values into JSON values, and references or function calls into string templates.
+// When plain is true, references are written without the template wrapping, like in `depends_on`
+func (c jsonConverter) expression(expr hclsyntax.Expression, plain bool) any {
+	if len(expr.Variables()) == 0 {
+		if value, diags := expr.Value(nil); !diags.HasErrors() {
+			var result any
+			b, err := ctyjson.SimpleJSONValue{Value: value}.MarshalJSON()
+			if err == nil && json.Unmarshal(b, &result) == nil {
+				return escapeJSONTemplates(result)
+			}
+		}
+	}
+	switch e := expr.(type) {
+	case *hclsyntax.ScopeTraversalExpr:
+		if plain {
+			return c.source(e)
+		}
+	case *hclsyntax.TupleConsExpr:
+		list := []any{}
+		for _, item := range e.Exprs {
+			list = append(list, c.expression(item, plain))
+		}
+		return list
+	case *hclsyntax.ObjectConsExpr:
+		object := map[string]any{}
+		for _, item := range e.Items {
+			key, diags := item.KeyExpr.Value(nil)
+			if !diags.HasErrors() {
+				key, err := convert.Convert(key, cty.String)
+				if err == nil && key.IsKnown() && !key.IsNull() {
+					object[key.AsString()] = c.expression(item.ValueExpr, plain)
+					continue
+				}
+			}
+			log.Printf("[WARN] can't convert object key %s to JSON", c.source(item.KeyExpr))
+			return fmt.Sprintf("${%s}", c.source(e))
+		}
+		return object
+	case *hclsyntax.TemplateWrapExpr:
+		return fmt.Sprintf("${%s}", c.source(e.Wrapped))
+	case *hclsyntax.TemplateExpr:
+		var sb strings.Builder
+		for _, part := range e.Parts {
+			if literal, ok := part.(*hclsyntax.LiteralValueExpr); ok && literal.Val.Type() == cty.String {
+				sb.WriteString(escapeJSONTemplates(literal.Val.AsString()).(string))
+			} else {
+				sb.WriteString(fmt.Sprintf("${%s}", c.source(part)))
+			}
+		}
+		return sb.String()
+	}
+	return fmt.Sprintf("${%s}", c.source(expr))
+}
+
+// escapeJSONTemplates escapes template sequences in literal strings, as every string in the JSON syntax
+// is interpreted as a template
+func escapeJSONTemplates(value any) any {
+	switch v := value.(type) {
+	case string:
+		return strings.NewReplacer("${", "$${", "%{", "%%{").Replace(v)
+	case []any:
+		for i := range v {
+			v[i] = escapeJSONTemplates(v[i])
+		}
+	case map[string]any:
+		for k := range v {
+			v[k] = escapeJSONTemplates(v[k])
+		}
+	}
+	return value
+}
+
+// convertFilesToJSON replaces the generated `*.tf` files with `*.tf.json` files
+func (ic *importContext) convertFilesToJSON() error {
+	fileNames := []string{"databricks", "vars", "imports"}
+	services := map[string]struct{}{}
+	for _, ir := range ic.Importables {
+		services[ir.Service] = struct{}{}
+	}
+	for service := range services {
+		fileNames = append(fileNames, service)
+		if ic.separateAcls {
+			fileNames = append(fileNames, aclsFilePrefix+service)
+		}
+	}
+	for _, name := range fileNames {
+		fileName := fmt.Sprintf("%s/%s.tf", ic.Directory, name)
+		content, err := os.ReadFile(fileName)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		converted, err := hclToJSON(content, fileName)
+		if err != nil {
+			return fmt.Errorf("can't convert %s to JSON: %w", fileName, err)
+		}
+		err = os.WriteFile(fileName+".json", converted, 0644)
+		if err != nil {
+			return err
+		}
+		err = os.Remove(fileName)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
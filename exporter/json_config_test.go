@@ -0,0 +1,131 @@
+package exporter
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHclToJSON(t *testing.T) {
+	converted, err := hclToJSON([]byte(`resource "databricks_notebook" "abc" {
+  source = "${path.module}/notebooks/abc.py"
+  path   = "/Shared/abc"
+  depends_on = [databricks_directory.shared]
+}
+
+resource "databricks_user" "user" {
+  user_name = "user@example.com"
+  display_name = "Example $${not_template}"
+  lifecycle {
+    ignore_changes = [allow_cluster_create]
+  }
+}
+
+resource "databricks_job" "job" {
+  name = var.job_name
+  task {
+    task_key = "a"
+    notebook_task {
+      notebook_path = databricks_notebook.abc.id
+      base_parameters = {
+        "param" = "x"
+      }
+    }
+  }
+  task {
+    task_key = "b"
+    max_retries = 3
+  }
+}
+
+locals {
+  group_members = {
+    "u1" = databricks_user.user.id
+  }
+}
+
+import {
+  to = databricks_job.job
+  id = "123"
+}
+
+variable "job_name" {
+  description = ""
+}
+`), "test.tf")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+  "resource": {
+    "databricks_notebook": {
+      "abc": {
+        "source": "${path.module}/notebooks/abc.py",
+        "path": "/Shared/abc",
+        "depends_on": ["databricks_directory.shared"]
+      }
+    },
+    "databricks_user": {
+      "user": {
+        "user_name": "user@example.com",
+        "display_name": "Example $${not_template}",
+        "lifecycle": [{"ignore_changes": ["allow_cluster_create"]}]
+      }
+    },
+    "databricks_job": {
+      "job": {
+        "name": "${var.job_name}",
+        "task": [
+          {
+            "task_key": "a",
+            "notebook_task": [{
+              "notebook_path": "${databricks_notebook.abc.id}",
+              "base_parameters": {"param": "x"}
+            }]
+          },
+          {"task_key": "b", "max_retries": 3}
+        ]
+      }
+    }
+  },
+  "locals": [{"group_members": {"u1": "${databricks_user.user.id}"}}],
+  "import": [{"to": "databricks_job.job", "id": "123"}],
+  "variable": {"job_name": {"description": ""}}
+}`, string(converted))
+}
+
+func TestHclToJSONInvalid(t *testing.T) {
+	_, err := hclToJSON([]byte(`resource "a" {`), "test.tf")
+	assert.Error(t, err)
+}
+
+func TestConvertFilesToJSON(t *testing.T) {
+	ic := importContextForTest()
+	ic.Directory = t.TempDir()
+	require.NoError(t, os.WriteFile(ic.Directory+"/compute.tf", []byte(`resource "databricks_cluster" "abc" {
+  cluster_name = "abc"
+}
+`), 0644))
+	require.NoError(t, os.WriteFile(ic.Directory+"/other.tf", []byte(`# not generated by the exporter`), 0644))
+
+	require.NoError(t, ic.convertFilesToJSON())
+	content, err := os.ReadFile(ic.Directory + "/compute.tf.json")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"resource": {"databricks_cluster": {"abc": {"cluster_name": "abc"}}}}`, string(content))
+	assert.NoFileExists(t, ic.Directory+"/compute.tf")
+	assert.FileExists(t, ic.Directory+"/other.tf")
+}
+
+func TestConvertAclFilesToJSON(t *testing.T) {
+	ic := importContextForTest()
+	ic.Directory = t.TempDir()
+	ic.separateAcls = true
+	require.NoError(t, os.WriteFile(ic.Directory+"/acls_compute.tf", []byte(`resource "databricks_permissions" "abc" {
+  cluster_id = "abc"
+}
+`), 0644))
+
+	require.NoError(t, ic.convertFilesToJSON())
+	assert.FileExists(t, ic.Directory+"/acls_compute.tf.json")
+	assert.NoFileExists(t, ic.Directory+"/acls_compute.tf")
+}
@@ -0,0 +1,110 @@
+package exporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+)
+
+const mappingFileName = "mapping.json"
+
+// addressMapping correlates the exported object with the generated Terraform code
+type addressMapping struct {
+	Type    string `json:"type"`
+	ID      string `json:"id"`
+	Path    string `json:"path,omitempty"`
+	Address string `json:"address"`
+	File    string `json:"file"`
+}
+
+func resourceAddress(r *resource) string {
+	address := r.Resource + "." + r.Name
+	if r.Mode == "data" {
+		return "data." + address
+	}
+	return address
+}
+
+// generatedFileName returns the name of the file with generated code, like `jobs.tf`. With `-format json`,
+// files are converted into `*.tf.json` after generation, so the final name is returned
+func (ic *importContext) generatedFileName(name string) string {
+	if ic.outputFormat == "json" {
+		return name + ".tf.json"
+	}
+	return name + ".tf"
+}
+
+// addMapping records the file the resource is generated into
+func (ic *importContext) addMapping(r *resource, file string) {
+	m := addressMapping{
+		Type:    r.Resource,
+		ID:      r.ID,
+		Address: resourceAddress(r),
+		File:    file,
+	}
+	if r.Data != nil {
+		if path, ok := r.Data.GetOk("path"); ok {
+			m.Path = fmt.Sprint(path)
+		}
+	}
+	ic.mappingsMutex.Lock()
+	defer ic.mappingsMutex.Unlock()
+	ic.mappings[m.Address] = m
+}
+
+// addLocalMapping records the file the local value generated for the object is written into
+func (ic *importContext) addLocalMapping(name, id, file string) {
+	ic.mappingsMutex.Lock()
+	defer ic.mappingsMutex.Unlock()
+	ic.mappings["local."+name] = addressMapping{
+		Type:    "locals",
+		ID:      id,
+		Address: "local." + name,
+		File:    file,
+	}
+}
+
+func readMappings(fileName string) ([]addressMapping, error) {
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	var mappings []addressMapping
+	if err = json.Unmarshal(content, &mappings); err != nil {
+		return nil, fmt.Errorf("can't parse mapping file %s: %w", fileName, err)
+	}
+	return mappings, nil
+}
+
+// writeMappings writes addresses and files of generated resources, sorted by address, so tools could
+// correlate objects in the workspace with the generated code
+func (ic *importContext) writeMappings() error {
+	fileName := fmt.Sprintf("%s/%s", ic.Directory, mappingFileName)
+	ic.mappingsMutex.Lock()
+	defer ic.mappingsMutex.Unlock()
+	if ic.incremental {
+		existing, err := readMappings(fileName)
+		if err != nil {
+			log.Printf("[WARN] Can't load existing mappings: %v", err)
+		}
+		for _, m := range existing {
+			if _, ok := ic.mappings[m.Address]; !ok {
+				ic.mappings[m.Address] = m
+			}
+		}
+	}
+	mappings := make([]addressMapping, 0, len(ic.mappings))
+	for _, m := range ic.mappings {
+		mappings = append(mappings, m)
+	}
+	sort.Slice(mappings, func(i, j int) bool {
+		return mappings[i].Address < mappings[j].Address
+	})
+	content, err := json.MarshalIndent(mappings, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(fileName, content, 0644)
+}
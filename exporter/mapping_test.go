@@ -0,0 +1,83 @@
+package exporter
+
+import (
+	"os"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/databricks/terraform-provider-databricks/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteMappings(t *testing.T) {
+	testGenerate(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/dbfs/get-status?path=%2Fa",
+			Response: storage.FileInfo{
+				Path: "/a",
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/dbfs/read?length=1000000&path=%2Fa",
+			Response: storage.ReadResponse{
+				Data:      "YWJj",
+				BytesRead: 3,
+			},
+		},
+	}, "storage", false, func(ic *importContext) {
+		ic.Emit(&resource{
+			Resource: "databricks_dbfs_file",
+			ID:       "/a",
+		})
+		ic.waitGroup.Wait()
+		ic.closeImportChannels()
+		ic.generateAndWriteResources(nil)
+		require.NoError(t, ic.writeMappings())
+
+		mappings, err := readMappings(ic.Directory + "/" + mappingFileName)
+		require.NoError(t, err)
+		assert.Equal(t, []addressMapping{
+			{
+				Type:    "databricks_dbfs_file",
+				ID:      "/a",
+				Path:    "/a",
+				Address: "databricks_dbfs_file._0639767f3e9eaad729b54037a7e2abf5_a",
+				File:    "storage.tf",
+			},
+		}, mappings)
+	})
+}
+
+func TestGeneratedFileName(t *testing.T) {
+	ic := importContextForTest()
+	assert.Equal(t, "jobs.tf", ic.generatedFileName("jobs"))
+	ic.outputFormat = "json"
+	assert.Equal(t, "jobs.tf.json", ic.generatedFileName("jobs"))
+	ic.addMapping(&resource{Resource: "databricks_job", ID: "1", Name: "a"}, ic.generatedFileName("jobs"))
+	assert.Equal(t, "jobs.tf.json", ic.mappings["databricks_job.a"].File)
+}
+
+func TestWriteMappingsIncremental(t *testing.T) {
+	ic := importContextForTest()
+	ic.Directory = t.TempDir()
+	ic.incremental = true
+	err := os.WriteFile(ic.Directory+"/"+mappingFileName, []byte(`[
+		{"type": "databricks_job", "id": "1", "address": "databricks_job.a", "file": "jobs.tf"},
+		{"type": "databricks_job", "id": "2", "address": "databricks_job.b", "file": "jobs.tf"}
+	]`), 0644)
+	require.NoError(t, err)
+	ic.addMapping(&resource{Resource: "databricks_job", ID: "2", Name: "b"}, "jobs.tf")
+	ic.addMapping(&resource{Resource: "databricks_group", ID: "3", Name: "c", Mode: "data"}, "groups.tf")
+	require.NoError(t, ic.writeMappings())
+
+	mappings, err := readMappings(ic.Directory + "/" + mappingFileName)
+	require.NoError(t, err)
+	addresses := []string{}
+	for _, m := range mappings {
+		addresses = append(addresses, m.Address)
+	}
+	assert.Equal(t, []string{"data.databricks_group.c", "databricks_job.a", "databricks_job.b"}, addresses)
+}
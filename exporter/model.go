@@ -34,6 +34,8 @@ type resourceApproximation struct {
 	Mode      string                  `json:"mode"`
 	Module    string                  `json:"module,omitempty"`
 	Instances []instanceApproximation `json:"instances"`
+	// Type of the data block that refers to the resource outside of the export scope
+	DataSourceType string `json:"-"`
 }
 
 // TODO: think if something like trie may help here...
@@ -125,9 +127,52 @@ func (s *stateApproximation) Append(ra resourceApproximation) {
 	rah.Append(ra)
 }
 
+const (
+	ignoreReasonNotFound         = "not found"
+	ignoreReasonPermissionDenied = "permission denied"
+	ignoreReasonUnsupported      = "unsupported feature"
+	ignoreReasonApiError         = "api error"
+	ignoreReasonDeletedUser      = "belongs to deleted user or service principal"
+	ignoreReasonInactiveUser     = "belongs to deactivated user or service principal"
+	ignoreReasonEmpty            = "no meaningful content"
+	ignoreReasonNoGitProvider    = "no Git provider"
+	ignoreReasonGroupsFilter     = "not a member of groups from -groups-filter"
+	ignoreReasonSystemObject     = "system or built-in object, use -include-system-objects to export it"
+	ignoreReasonNotebookBound    = "experiment of a notebook, it's created automatically"
+	ignoreReasonBundleManaged    = "deployed by Databricks Asset Bundles, use -include-bundle-managed to export it"
+	ignoreReasonMountNoSource    = "source of the mount isn't in the Terraform state, use -mounts to read it on a cluster"
+	ignoreReasonExcluded         = "matches -exclude-regex"
+	ignoreReasonInherited        = "all permissions are inherited from the parent directory"
+	ignoreReasonOptedOut         = "opted out with the " + optOutMarker + " tag"
+	ignoreReasonMonitorOutput    = "output table of an exported quality monitor, it's managed by the monitor"
+)
+
+// ignoredResource describes an object that wasn't exported, together with the reason
+type ignoredResource struct {
+	Resource  string `json:"resource"`
+	Attribute string `json:"attribute"`
+	Value     string `json:"value"`
+	Reason    string `json:"reason"`
+	Message   string `json:"message,omitempty"`
+}
+
+func (ir ignoredResource) String() string {
+	return fmt.Sprintf("%s. %s=%s", ir.Resource, ir.Attribute, ir.Value)
+}
+
+// dataSource describes how to look up the object, that is referenced, but not exported
+type dataSource struct {
+	// Type of the data source, if it differs from the resource type
+	Type string
+	// Maps attributes of the data source to attributes of the resource, that identify the object
+	Attributes map[string]string
+}
+
 type importable struct {
 	// Logical (file) group that resources belong to
 	Service string
+	// Overrides logical (file) group for specific resources, i.e. permissions of SQL warehouses
+	ResourceService func(ic *importContext, r *resource) string
 	// Semantic resource block name
 	Name func(ic *importContext, d *schema.ResourceData) string
 	// Method to perform depth-first search and emit resources
@@ -150,6 +195,14 @@ type importable struct {
 	AccountLevel bool
 	// Defines if specific service is workspace level resource
 	WorkspaceLevel bool
+	// Resource is generated as a stub for creation of a new object, so no import command is generated for it
+	CreateOnly bool
+	// List skips objects with names that don't match `-match` and the `-filter` expression of the service
+	Filterable bool
+	// Data source that refers to the object outside of the export scope with `-emit-data-sources`
+	DataSource *dataSource
+	// Resource type, filled from the key of resourcesMap, to look up attributes with server defaults
+	resourceType string
 }
 
 type MatchType string
@@ -182,6 +235,8 @@ type reference struct {
 	File bool
 	// regular expression (if MatchType == "regexp") must define a group that will be used to extract value to match
 	Regexp *regexp.Regexp
+	// returns ID of the referenced resource when the value alone doesn't identify it, like, schema names that are unique only within a catalog
+	ResourceID func(d *schema.ResourceData, value string) string
 }
 
 func (r reference) MatchAttribute() string {
@@ -198,6 +253,20 @@ func (r reference) MatchTypeValue() MatchType {
 	return r.MatchType
 }
 
+// variableName returns name of the variable for a given variable reference. It's prefixed with the top-level
+// block of the field, unless other variable references share the same block, so names stay unique
+func (i importable) variableName(r reference, value string) string {
+	prefix, _, _ := strings.Cut(r.Path, ".")
+	for _, other := range i.Depends {
+		otherPrefix, _, _ := strings.Cut(other.Path, ".")
+		if other.Variable && other.Path != r.Path && otherPrefix == prefix {
+			prefix = strings.ReplaceAll(r.Path, ".", "_")
+			break
+		}
+	}
+	return fmt.Sprintf("%s_%s", prefix, value)
+}
+
 type resource struct {
 	// Name of the resource: `databricks_cluster`, `databricks_job`, etc.
 	Resource string
@@ -212,6 +281,8 @@ type resource struct {
 	// If not specified, then we generate a normal resource block, or we can generate a data block if it's set to "data"
 	Mode        string
 	Incremental bool
+	// Object belongs to a service that isn't exported, so it's only referenced with a data block
+	External bool
 	// Actual Terraform data
 	Data *schema.ResourceData
 }
@@ -237,11 +308,7 @@ func (r *resource) String() string {
 }
 
 func (r *resource) ImportCommand(ic *importContext) string {
-	m := ""
-	if ic.Module != "" {
-		m = ic.Module + "."
-	}
-	return fmt.Sprintf(`terraform import %s%s.%s "%s"`, m, r.Resource, r.Name, r.ID)
+	return ic.importCommand(r.Resource+"."+r.Name, r.ID)
 }
 
 func (r *resource) ImportResource(ic *importContext) {
@@ -272,14 +339,19 @@ func (r *resource) ImportResource(ic *importContext) {
 			fmt.Sprintf("searching of %v", r))
 		if err != nil {
 			log.Printf("[ERROR] Error searching %s#%s: %v", r.Resource, r.ID, err)
+			ic.addIgnoredResourceWithError(r, err)
 			return
 		}
 		if r.ID == "" {
 			log.Printf("[WARN] Cannot find %s", r)
-			ic.addIgnoredResource(fmt.Sprintf("%s: %s=%s", r.Resource, r.Attribute, r.Value))
+			ic.addIgnoredResource(ignoredResource{Resource: r.Resource, Attribute: r.Attribute,
+				Value: r.Value, Reason: ignoreReasonNotFound})
 			return
 		}
 	}
+	if ic.isExcluded(ir, r, r.ID, r.Value) {
+		return
+	}
 	if r.Data == nil {
 		// empty data with resource schema
 		r.Data = pr.Data(&terraform.InstanceState{
@@ -299,20 +371,31 @@ func (r *resource) ImportResource(ic *importContext) {
 			fmt.Sprintf("reading %s#%s", r.Resource, r.ID))
 		if dia != nil {
 			log.Printf("[ERROR] Error reading %s#%s: %v", r.Resource, r.ID, dia)
+			ic.addIgnoredResourceWithError(r, fmt.Errorf("%s", dia[0].Summary))
 			return
 		}
 		if r.Data.Id() == "" {
 			r.Data.SetId(r.ID)
 		}
 	}
+	if ic.isExcluded(ir, r, excludableAttributes(r.Data)...) {
+		return
+	}
+	if ic.isOptedOut(r) {
+		return
+	}
 	r.Name = ic.ResourceName(r)
-	if ir.Import != nil {
+	if r.External {
+		// dependencies of objects outside of the export scope aren't exported either
+		r.Mode = "data"
+	} else if ir.Import != nil {
 		err := runWithRetries(func() error {
 			return ir.Import(ic, r)
 		},
 			fmt.Sprintf("importing of %s#%s", r.Resource, r.ID))
 		if err != nil {
 			log.Printf("[ERROR] Failed custom import of %s: %s", r, err)
+			ic.addIgnoredResourceWithError(r, err)
 			return
 		}
 	}
@@ -0,0 +1,108 @@
+package exporter
+
+import (
+	"crypto/md5"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// strategies of resource naming, specified by -naming
+const (
+	// normalized names of objects, with MD5 of the name for numeric and non-ASCII names
+	namingName = "name"
+	// normalized IDs of objects
+	namingID = "id"
+	// normalized names of objects with the hash of the ID, so addresses stay unique for objects with
+	// the same or non-ASCII names
+	namingNameIDHash = "name_id_hash"
+	// names of objects with diacritics removed, with the hash of the ID if nothing is left
+	namingSlug = "slug"
+)
+
+var startsWithDigitRegex = regexp.MustCompile(`^\d`)
+
+// namingStrategies generate names of resources in the generated code
+var namingStrategies = map[string]func(ic *importContext, r *resource) string{
+	namingName: func(ic *importContext, r *resource) string {
+		name := ic.prefix + ic.objectName(r)
+		origCaseName := name
+		name = ic.normalizeName(name)
+		// this is either numeric id or all-non-ascii
+		if startsWithDigitRegex.MatchString(name) || name == "" {
+			if name == "" {
+				origCaseName = r.ID
+			}
+			name = fmt.Sprintf("r%x", md5.Sum([]byte(origCaseName)))[0:12]
+		}
+		return name
+	},
+	namingID: func(ic *importContext, r *resource) string {
+		name := strings.Trim(ic.normalizeName(ic.prefix+r.ID), "_")
+		if name == "" {
+			return "r" + idHash(r)
+		}
+		if startsWithDigitRegex.MatchString(name) {
+			return "_" + name
+		}
+		return name
+	},
+	namingNameIDHash: func(ic *importContext, r *resource) string {
+		return withIDHash(strings.Trim(ic.normalizeName(ic.prefix+ic.objectName(r)), "_"), r)
+	},
+	namingSlug: func(ic *importContext, r *resource) string {
+		name := strings.Trim(ic.normalizeName(removeDiacritics(ic.prefix+ic.objectName(r))), "_")
+		if name == "" {
+			return "r" + idHash(r)
+		}
+		if startsWithDigitRegex.MatchString(name) {
+			return "_" + name
+		}
+		return name
+	},
+}
+
+// objectName returns the name of the object, falling back to its ID
+func (ic *importContext) objectName(r *resource) string {
+	name := r.Name
+	if name == "" && ic.Importables[r.Resource].Name != nil {
+		name = ic.Importables[r.Resource].Name(ic, r.Data)
+	}
+	if name == "" {
+		name = r.ID
+	}
+	return name
+}
+
+func (ic *importContext) normalizeName(name string) string {
+	return ic.regexFix(strings.ToLower(name), ic.nameFixes)
+}
+
+// idHash returns the short hash of the object ID, that doesn't change between runs
+func idHash(r *resource) string {
+	return fmt.Sprintf("%x", md5.Sum([]byte(r.ID)))[0:8]
+}
+
+func withIDHash(name string, r *resource) string {
+	if name == "" {
+		return "r" + idHash(r)
+	}
+	if startsWithDigitRegex.MatchString(name) {
+		name = "_" + name
+	}
+	return name + "_" + idHash(r)
+}
+
+// removeDiacritics replaces accented letters with their ASCII counterparts, i.e. `é` with `e`
+func removeDiacritics(s string) string {
+	var sb strings.Builder
+	for _, c := range norm.NFD.String(s) {
+		if !unicode.Is(unicode.Mn, c) {
+			sb.WriteRune(c)
+		}
+	}
+	return sb.String()
+}
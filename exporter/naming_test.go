@@ -0,0 +1,44 @@
+package exporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNamingStrategies(t *testing.T) {
+	ic := importContextForTest()
+	job := &resource{Resource: "databricks_job", ID: "123", Name: "Daily ETL"}
+	numeric := &resource{Resource: "databricks_job", ID: "456", Name: "2024 report"}
+	cyrillic := &resource{Resource: "databricks_job", ID: "789", Name: "Отчёт"}
+	accented := &resource{Resource: "databricks_job", ID: "790", Name: "Café Métriques"}
+
+	ic.naming = namingName
+	assert.Equal(t, "daily_etl", ic.ResourceName(job))
+	assert.Equal(t, "_", ic.ResourceName(cyrillic))
+
+	ic.naming = namingID
+	assert.Equal(t, "_123", ic.ResourceName(job))
+	assert.Equal(t, "_456", ic.ResourceName(numeric))
+
+	ic.naming = namingNameIDHash
+	assert.Equal(t, "daily_etl_"+idHash(job), ic.ResourceName(job))
+	assert.Equal(t, "_2024_report_"+idHash(numeric), ic.ResourceName(numeric))
+	assert.Equal(t, "r"+idHash(cyrillic), ic.ResourceName(cyrillic))
+
+	ic.naming = namingSlug
+	assert.Equal(t, "daily_etl", ic.ResourceName(job))
+	assert.Equal(t, "cafe_metriques", ic.ResourceName(accented))
+	assert.Equal(t, "_2024_report", ic.ResourceName(numeric))
+	assert.Equal(t, "r"+idHash(cyrillic), ic.ResourceName(cyrillic))
+
+	// names are stable between runs
+	assert.Equal(t, ic.ResourceName(cyrillic), ic.ResourceName(&resource{
+		Resource: "databricks_job", ID: "789", Name: "Отчёт"}))
+}
+
+func TestNamingUnknownStrategyFallsBackToName(t *testing.T) {
+	ic := importContextForTest()
+	ic.naming = ""
+	assert.Equal(t, "daily_etl", ic.ResourceName(&resource{Resource: "databricks_job", ID: "1", Name: "Daily ETL"}))
+}
@@ -0,0 +1,153 @@
+package exporter
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/exp/maps"
+)
+
+// default interval between progress lines, printed to stderr
+const defaultProgressInterval = 30 * time.Second
+
+// exportProgress counts objects found, imported and generated per service, so progress of long exports
+// could be observed without debug logs. All methods are no-op on nil, like in tests.
+type exportProgress struct {
+	mu sync.Mutex
+
+	startTime  time.Time
+	phaseStart time.Time
+
+	found    map[string]int
+	imported map[string]int
+
+	generating bool
+	total      int
+	generated  int
+}
+
+func newExportProgress(now time.Time) *exportProgress {
+	return &exportProgress{
+		startTime:  now,
+		phaseStart: now,
+		found:      map[string]int{},
+		imported:   map[string]int{},
+	}
+}
+
+func (p *exportProgress) objectFound(service string) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.found[service]++
+}
+
+func (p *exportProgress) objectImported(service string) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.imported[service]++
+}
+
+func (p *exportProgress) startGeneration(now time.Time, total int) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.generating = true
+	p.phaseStart = now
+	p.total = total
+}
+
+func (p *exportProgress) objectGenerated() {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.generated++
+}
+
+// eta estimates the remaining time from the rate of processing since the start of the current phase
+func eta(elapsed time.Duration, done, remaining int) string {
+	if done == 0 || elapsed <= 0 {
+		return "unknown"
+	}
+	return (elapsed * time.Duration(remaining) / time.Duration(done)).Round(time.Second).String()
+}
+
+// line returns the human-readable progress line
+func (p *exportProgress) line(now time.Time) string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	elapsed := now.Sub(p.startTime).Round(time.Second)
+	phaseElapsed := now.Sub(p.phaseStart)
+	if p.generating {
+		return fmt.Sprintf("[PROGRESS] %s elapsed, generating: %d of %d resources, ETA %s",
+			elapsed, p.generated, p.total, eta(phaseElapsed, p.generated, p.total-p.generated))
+	}
+	found, imported := 0, 0
+	services := []string{}
+	for service, n := range p.found {
+		found += n
+		imported += p.imported[service]
+		services = append(services, fmt.Sprintf("%s %d/%d", service, p.imported[service], n))
+	}
+	sort.Strings(services)
+	return fmt.Sprintf("[PROGRESS] %s elapsed, listing: %d found, %d imported, ETA for found %s (%s)",
+		elapsed, found, imported, eta(phaseElapsed, imported, found-imported), strings.Join(services, ", "))
+}
+
+// report prints the progress line every interval, until the returned function is called
+func (p *exportProgress) report(out io.Writer, interval time.Duration) (stop func()) {
+	if p == nil || interval <= 0 {
+		return func() {}
+	}
+	done := make(chan struct{})
+	ticker := time.NewTicker(interval)
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case now := <-ticker.C:
+				fmt.Fprintln(out, p.line(now))
+			}
+		}
+	}()
+	return func() {
+		ticker.Stop()
+		close(done)
+	}
+}
+
+// writeInventory prints the number of objects found per service by the dry run, together with
+// the estimation of API calls that the full export would need, and services that aren't fully counted
+func (p *exportProgress) writeInventory(out io.Writer, listingCalls int64, uncountedServices []string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	services := maps.Keys(p.found)
+	sort.Strings(services)
+	total := 0
+	fmt.Fprintf(out, "%-30s %10s\n", "SERVICE", "OBJECTS")
+	for _, service := range services {
+		fmt.Fprintf(out, "%-30s %10d\n", service, p.found[service])
+		total += p.found[service]
+	}
+	fmt.Fprintf(out, "%-30s %10d\n", "TOTAL", total)
+	fmt.Fprintf(out, "\n%d API calls were made during listing. The export would need at least %d more "+
+		"to read the found objects, and more for their dependencies.\n", listingCalls, total)
+	if len(uncountedServices) > 0 {
+		fmt.Fprintf(out, "\nObjects that are discovered only while importing other objects aren't counted, "+
+			"so numbers are incomplete for services: %s\n", strings.Join(uncountedServices, ", "))
+	}
+}
@@ -0,0 +1,119 @@
+package exporter
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportProgressListing(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	p := newExportProgress(start)
+	assert.Equal(t, "[PROGRESS] 0s elapsed, listing: 0 found, 0 imported, ETA for found unknown ()",
+		p.line(start))
+
+	for i := 0; i < 4; i++ {
+		p.objectFound("jobs")
+	}
+	p.objectFound("compute")
+	p.objectImported("jobs")
+	p.objectImported("jobs")
+	assert.Equal(t, "[PROGRESS] 1m0s elapsed, listing: 5 found, 2 imported, ETA for found 1m30s (compute 0/1, jobs 2/4)",
+		p.line(start.Add(time.Minute)))
+}
+
+func TestExportProgressGeneration(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	p := newExportProgress(start)
+	p.startGeneration(start.Add(time.Hour), 100)
+	for i := 0; i < 25; i++ {
+		p.objectGenerated()
+	}
+	assert.Equal(t, "[PROGRESS] 1h0m10s elapsed, generating: 25 of 100 resources, ETA 30s",
+		p.line(start.Add(time.Hour+10*time.Second)))
+}
+
+func TestExportProgressNil(t *testing.T) {
+	var p *exportProgress
+	p.objectFound("jobs")
+	p.objectImported("jobs")
+	p.startGeneration(time.Now(), 1)
+	p.objectGenerated()
+	p.report(&bytes.Buffer{}, time.Second)()
+}
+
+type syncBuffer struct {
+	sync.Mutex
+	bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.Lock()
+	defer b.Unlock()
+	return b.Buffer.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.Lock()
+	defer b.Unlock()
+	return b.Buffer.String()
+}
+
+func TestExportProgressReport(t *testing.T) {
+	p := newExportProgress(time.Now())
+	p.objectFound("jobs")
+	out := &syncBuffer{}
+	stop := p.report(out, 10*time.Millisecond)
+	assert.Eventually(t, func() bool {
+		return strings.Contains(out.String(), "listing: 1 found, 0 imported")
+	}, time.Second, 10*time.Millisecond)
+	stop()
+
+	p.report(out, 0)()
+}
+
+func TestExportProgressInventory(t *testing.T) {
+	p := newExportProgress(time.Now())
+	p.objectFound("jobs")
+	p.objectFound("jobs")
+	p.objectFound("compute")
+	out := &bytes.Buffer{}
+	p.writeInventory(out, 7, []string{"uc-catalogs"})
+	assert.Equal(t, `SERVICE                           OBJECTS
+compute                                 1
+jobs                                    2
+TOTAL                                   3
+
+7 API calls were made during listing. The export would need at least 3 more to read the found objects, and more for their dependencies.
+
+Objects that are discovered only while importing other objects aren't counted, so numbers are incomplete for services: uc-catalogs
+`, out.String())
+}
+
+func TestServicesDiscoveredOnImport(t *testing.T) {
+	ic := importContextForTest()
+	ic.enableServices("jobs,uc-catalogs")
+	// UC schemas & tables are emitted from the import of catalogs
+	assert.Contains(t, ic.servicesDiscoveredOnImport(), "uc-catalogs")
+	assert.NotContains(t, ic.servicesDiscoveredOnImport(), "uc-volumes")
+	ic.enableServices("")
+	assert.Empty(t, ic.servicesDiscoveredOnImport())
+}
+
+func TestEmitDryRun(t *testing.T) {
+	ic := importContextForTest()
+	ic.testEmits = nil
+	ic.importing = map[string]bool{}
+	ic.dryRun = true
+	ic.progress = newExportProgress(time.Now())
+	ic.enableServices("jobs")
+	ic.Emit(&resource{Resource: "databricks_job", ID: "1"})
+	ic.Emit(&resource{Resource: "databricks_job", ID: "1"})
+	ic.Emit(&resource{Resource: "databricks_job", ID: "2"})
+	assert.Equal(t, 2, ic.progress.found["jobs"])
+	assert.Equal(t, 0, len(ic.defaultChannel))
+}
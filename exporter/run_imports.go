@@ -0,0 +1,213 @@
+package exporter
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// constants related to the execution of imports with `-run-imports`
+const (
+	envVarImportParallelism  = "EXPORTER_IMPORT_PARALLELISM"
+	defaultImportParallelism = 4
+	importPlanFileName       = "imports.tfplan"
+)
+
+var (
+	importRetryDelay = 5 * time.Second
+	// errors of Terraform that are caused by concurrent imports or by throttling of API
+	importRetriableErrors = []string{"Error acquiring the state lock", "TEMPORARILY_UNAVAILABLE",
+		"Too Many Requests", "context deadline exceeded", "Timed out after "}
+)
+
+// terraformRunner executes Terraform with given arguments in the directory, and returns its combined output
+type terraformRunner func(ctx context.Context, dir string, args ...string) (string, error)
+
+func runTerraform(ctx context.Context, dir string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "terraform", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "TF_IN_AUTOMATION=1")
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return string(out), fmt.Errorf("terraform %s: %w\n%s", args[0], err, out)
+	}
+	return string(out), nil
+}
+
+// runTerraformWithRetries retries Terraform commands that failed because of transient errors
+func (ic *importContext) runTerraformWithRetries(ctx context.Context, args ...string) (string, error) {
+	var out string
+	var err error
+	for i := 0; i < maxRetries; i++ {
+		out, err = ic.terraform(ctx, ic.Directory, args...)
+		if err == nil || !isRetryableImportError(err.Error()) {
+			break
+		}
+		log.Printf("[INFO] next retry (%d) of terraform %s after error: %v", i+1, args[0], err)
+		time.Sleep(time.Duration(i+1) * importRetryDelay)
+	}
+	return out, err
+}
+
+func isRetryableImportError(err string) bool {
+	for _, msg := range importRetriableErrors {
+		if strings.Contains(err, msg) {
+			return true
+		}
+	}
+	return false
+}
+
+// executeImports brings exported resources under management of Terraform after generation, so the
+// output directory is ready for `terraform plan`
+func (ic *importContext) executeImports() error {
+	ctx := context.Background()
+	log.Print("[INFO] Initializing Terraform in the output directory")
+	if _, err := ic.runTerraformWithRetries(ctx, "init", "-input=false"); err != nil {
+		return err
+	}
+	if ic.nativeImport {
+		return ic.applyImportBlocks(ctx)
+	}
+	return ic.runImportCommands(ctx)
+}
+
+type importPlan struct {
+	ResourceChanges []struct {
+		Address string `json:"address"`
+		Change  struct {
+			Actions   []string `json:"actions"`
+			Importing *struct {
+				ID string `json:"id"`
+			} `json:"importing,omitempty"`
+		} `json:"change"`
+	} `json:"resource_changes"`
+}
+
+// applyImportBlocks plans `import` blocks from imports.tf, and applies the plan only if it doesn't
+// change anything besides importing, as generated code may differ from the actual objects
+func (ic *importContext) applyImportBlocks(ctx context.Context) error {
+	log.Printf("[INFO] Planning imports with parallelism %d", ic.importParallelism)
+	_, err := ic.runTerraformWithRetries(ctx, "plan", "-input=false",
+		fmt.Sprintf("-parallelism=%d", ic.importParallelism), "-out="+importPlanFileName)
+	if err != nil {
+		return err
+	}
+	out, err := ic.terraform(ctx, ic.Directory, "show", "-json", importPlanFileName)
+	if err != nil {
+		return err
+	}
+	var plan importPlan
+	if err = json.Unmarshal([]byte(out), &plan); err != nil {
+		return fmt.Errorf("can't parse %s: %w", importPlanFileName, err)
+	}
+	imported := 0
+	changed := []string{}
+	for _, rc := range plan.ResourceChanges {
+		if len(rc.Change.Actions) == 1 && rc.Change.Actions[0] == "no-op" {
+			if rc.Change.Importing != nil {
+				imported++
+			}
+			continue
+		}
+		changed = append(changed, rc.Address)
+	}
+	if len(changed) > 0 {
+		sort.Strings(changed)
+		return fmt.Errorf("plan of imports changes %d resources: %s. Review %s and apply it manually",
+			len(changed), strings.Join(changed, ", "), importPlanFileName)
+	}
+	log.Printf("[INFO] Applying the plan with %d imports", imported)
+	_, err = ic.terraform(ctx, ic.Directory, "apply", "-input=false",
+		fmt.Sprintf("-parallelism=%d", ic.importParallelism), importPlanFileName)
+	if err != nil {
+		return err
+	}
+	return os.Remove(fmt.Sprintf("%s/%s", ic.Directory, importPlanFileName))
+}
+
+// parseImportCommand extracts address and ID from the `terraform import` command generated by importCommand
+func parseImportCommand(line string) (address, id string, ok bool) {
+	rest, found := strings.CutPrefix(line, "terraform import ")
+	if !found {
+		return "", "", false
+	}
+	address, id, found = strings.Cut(rest, " ")
+	if !found {
+		return "", "", false
+	}
+	return strings.Trim(address, "'"), strings.Trim(id, `"`), true
+}
+
+// runImportCommands executes commands from import.sh in parallel, skipping resources that are already
+// in the state, so it's safe to rerun the export after the partial failure
+func (ic *importContext) runImportCommands(ctx context.Context) error {
+	managed := map[string]bool{}
+	// there is no state before the first import
+	if out, err := ic.terraform(ctx, ic.Directory, "state", "list"); err == nil {
+		for _, address := range strings.Split(out, "\n") {
+			if address = strings.TrimSpace(address); address != "" {
+				managed[address] = true
+			}
+		}
+	}
+	shFile, err := os.Open(fmt.Sprintf("%s/import.sh", ic.Directory))
+	if err != nil {
+		return err
+	}
+	defer shFile.Close()
+	commands := [][2]string{}
+	fileScanner := bufio.NewScanner(shFile)
+	for fileScanner.Scan() {
+		address, id, ok := parseImportCommand(fileScanner.Text())
+		if ok && !managed[address] {
+			commands = append(commands, [2]string{address, id})
+		}
+	}
+	if err = fileScanner.Err(); err != nil {
+		return err
+	}
+	log.Printf("[INFO] Importing %d resources (%d already in state) with parallelism %d",
+		len(commands), len(managed), ic.importParallelism)
+
+	ch := make(chan [2]string, len(commands))
+	for _, command := range commands {
+		ch <- command
+	}
+	close(ch)
+	var failedMutex sync.Mutex
+	failed := []string{}
+	wg := &sync.WaitGroup{}
+	for i := 0; i < ic.importParallelism; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for command := range ch {
+				// concurrent imports wait for each other on the state lock
+				_, err := ic.runTerraformWithRetries(ctx, "import", "-input=false", "-lock-timeout=10m",
+					command[0], command[1])
+				if err != nil {
+					log.Printf("[ERROR] import of %s failed: %v", command[0], err)
+					failedMutex.Lock()
+					failed = append(failed, command[0])
+					failedMutex.Unlock()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if len(failed) > 0 {
+		sort.Strings(failed)
+		return fmt.Errorf("import of %d resources failed: %s. Fix errors and rerun the export with -run-imports",
+			len(failed), strings.Join(failed, ", "))
+	}
+	return nil
+}
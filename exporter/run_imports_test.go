@@ -0,0 +1,137 @@
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeTerraform struct {
+	mu       sync.Mutex
+	commands []string
+	outputs  map[string]string
+	errors   map[string][]error
+}
+
+func (f *fakeTerraform) run(ctx context.Context, dir string, args ...string) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	command := strings.Join(args, " ")
+	f.commands = append(f.commands, command)
+	if errs := f.errors[command]; len(errs) > 0 {
+		f.errors[command] = errs[1:]
+		return "", errs[0]
+	}
+	return f.outputs[command], nil
+}
+
+func importContextForRunImports(t *testing.T, f *fakeTerraform) *importContext {
+	ic := importContextForTest()
+	ic.Directory = t.TempDir()
+	ic.importParallelism = 2
+	ic.terraform = f.run
+	return ic
+}
+
+func TestParseImportCommand(t *testing.T) {
+	address, id, ok := parseImportCommand(`terraform import databricks_job.abc "123"`)
+	assert.True(t, ok)
+	assert.Equal(t, "databricks_job.abc", address)
+	assert.Equal(t, "123", id)
+
+	address, id, ok = parseImportCommand(`terraform import 'databricks_job.abc["x"]' "1|2"`)
+	assert.True(t, ok)
+	assert.Equal(t, `databricks_job.abc["x"]`, address)
+	assert.Equal(t, "1|2", id)
+
+	_, _, ok = parseImportCommand("set -e")
+	assert.False(t, ok)
+}
+
+func TestRunImportCommands(t *testing.T) {
+	importRetryDelay = 0
+	f := &fakeTerraform{
+		outputs: map[string]string{
+			"state list": "databricks_job.a\n",
+		},
+		errors: map[string][]error{
+			`import -input=false -lock-timeout=10m databricks_job.b 2`: {
+				fmt.Errorf("Error acquiring the state lock"),
+			},
+			`import -input=false -lock-timeout=10m databricks_job.c 3`: {
+				fmt.Errorf("Error: Cannot import non-existent remote object"),
+			},
+		},
+	}
+	ic := importContextForRunImports(t, f)
+	err := os.WriteFile(ic.Directory+"/import.sh", []byte(`#!/bin/sh
+
+set -e
+
+terraform import databricks_job.a "1"
+terraform import databricks_job.b "2"
+terraform import databricks_job.c "3"
+`), 0755)
+	require.NoError(t, err)
+
+	err = ic.executeImports()
+	assert.EqualError(t, err, "import of 1 resources failed: databricks_job.c. "+
+		"Fix errors and rerun the export with -run-imports")
+	sort.Strings(f.commands)
+	assert.Equal(t, []string{
+		"import -input=false -lock-timeout=10m databricks_job.b 2",
+		"import -input=false -lock-timeout=10m databricks_job.b 2",
+		"import -input=false -lock-timeout=10m databricks_job.c 3",
+		"init -input=false",
+		"state list",
+	}, f.commands)
+}
+
+func TestRunImportsNative(t *testing.T) {
+	f := &fakeTerraform{
+		outputs: map[string]string{
+			"show -json imports.tfplan": `{"resource_changes": [
+				{"address": "databricks_job.a", "change": {"actions": ["no-op"], "importing": {"id": "1"}}},
+				{"address": "databricks_job.b", "change": {"actions": ["no-op"]}}
+			]}`,
+		},
+	}
+	ic := importContextForRunImports(t, f)
+	ic.nativeImport = true
+	require.NoError(t, os.WriteFile(ic.Directory+"/imports.tfplan", []byte{}, 0644))
+
+	err := ic.executeImports()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"init -input=false",
+		"plan -input=false -parallelism=2 -out=imports.tfplan",
+		"show -json imports.tfplan",
+		"apply -input=false -parallelism=2 imports.tfplan",
+	}, f.commands)
+	assert.NoFileExists(t, ic.Directory+"/imports.tfplan")
+}
+
+func TestRunImportsNativeWithChanges(t *testing.T) {
+	f := &fakeTerraform{
+		outputs: map[string]string{
+			"show -json imports.tfplan": `{"resource_changes": [
+				{"address": "databricks_job.a", "change": {"actions": ["update"], "importing": {"id": "1"}}},
+				{"address": "databricks_notebook.b", "change": {"actions": ["create"]}}
+			]}`,
+		},
+	}
+	ic := importContextForRunImports(t, f)
+	ic.nativeImport = true
+
+	err := ic.executeImports()
+	assert.EqualError(t, err, "plan of imports changes 2 resources: databricks_job.a, databricks_notebook.b. "+
+		"Review imports.tfplan and apply it manually")
+	assert.NotContains(t, f.commands, "apply -input=false -parallelism=2 imports.tfplan")
+}
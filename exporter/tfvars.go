@@ -0,0 +1,225 @@
+package exporter
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/databricks/terraform-provider-databricks/secrets"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const (
+	tfvarsExampleFileName = "terraform.tfvars.example"
+	secretsTfvarsFileName = "secrets.auto.tfvars"
+)
+
+var tfvarsAssignmentRegex = regexp.MustCompile(`(?m)^\s*(?:#\s*)?([A-Za-z_][A-Za-z0-9_-]*)\s*=`)
+
+// matches descriptions of variables for secrets & Azure Key Vaults, that are generated with the secret scope
+var variableScopeRegex = regexp.MustCompile(`secrets? (?:in|of) the (.+) secret scope$`)
+
+// setVariableScope records the secret scope of the variable
+func (ic *importContext) setVariableScope(name, scope string) {
+	ic.variablesMutex.Lock()
+	defer ic.variablesMutex.Unlock()
+	if ic.variableScopes == nil {
+		ic.variableScopes = map[string]string{}
+	}
+	ic.variableScopes[name] = scope
+}
+
+type tfvar struct {
+	Name        string
+	Description string
+	Sensitive   bool
+	Scope       string
+}
+
+// readVariables returns variables declared in vars.tf, that also contains variables of previous runs in
+// the incremental mode
+func (ic *importContext) readVariables() ([]tfvar, error) {
+	fileName := filepath.Join(ic.Directory, "vars.tf")
+	content, err := os.ReadFile(fileName)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	file, diags := hclsyntax.ParseConfig(content, fileName, hcl.Pos{Line: 1, Column: 1})
+	if diags.HasErrors() {
+		return nil, fmt.Errorf("can't parse %s: %s", fileName, diags.Error())
+	}
+	var vars []tfvar
+	for _, block := range file.Body.(*hclsyntax.Body).Blocks {
+		if block.Type != "variable" || len(block.Labels) != 1 {
+			continue
+		}
+		v := tfvar{Name: block.Labels[0], Scope: ic.variableScopes[block.Labels[0]]}
+		if attr, ok := block.Body.Attributes["description"]; ok {
+			if value, diags := attr.Expr.Value(nil); !diags.HasErrors() && value.Type() == cty.String {
+				v.Description = value.AsString()
+			}
+		}
+		if v.Scope == "" {
+			// variables of previous runs in the incremental mode
+			if match := variableScopeRegex.FindStringSubmatch(v.Description); match != nil {
+				v.Scope = match[1]
+			}
+		}
+		if attr, ok := block.Body.Attributes["sensitive"]; ok {
+			if value, diags := attr.Expr.Value(nil); !diags.HasErrors() && value.Type() == cty.Bool {
+				v.Sensitive = value.True()
+			}
+		}
+		vars = append(vars, v)
+	}
+	return vars, nil
+}
+
+// secretScopeAcls returns permissions on the secret scope as `PERMISSION principal` strings
+func (ic *importContext) secretScopeAcls(scope string) []string {
+	acls, err := secrets.NewSecretAclsAPI(ic.Context, ic.Client).List(scope)
+	if err != nil {
+		log.Printf("[WARN] can't list ACLs of the %s secret scope: %s", scope, err)
+		return nil
+	}
+	var result []string
+	for _, acl := range acls {
+		result = append(result, fmt.Sprintf("%s %s", acl.Permission, acl.Principal))
+	}
+	sort.Strings(result)
+	return result
+}
+
+// tfvarsContent returns assignments of variables grouped by secret scopes, with variables without the scope
+// going last, and with permissions on scopes from acls. Assignments are commented out when commented is true.
+func tfvarsContent(vars []tfvar, acls map[string][]string, commented bool) string {
+	groups := map[string][]tfvar{}
+	for _, v := range vars {
+		groups[v.Scope] = append(groups[v.Scope], v)
+	}
+	scopes := make([]string, 0, len(groups))
+	for scope := range groups {
+		if scope != "" {
+			scopes = append(scopes, scope)
+		}
+	}
+	sort.Strings(scopes)
+	if _, ok := groups[""]; ok {
+		scopes = append(scopes, "")
+	}
+	var sb strings.Builder
+	for _, scope := range scopes {
+		if scope == "" {
+			sb.WriteString("\n# Other variables\n")
+		} else {
+			sb.WriteString(fmt.Sprintf("\n# Secret scope: %s\n", scope))
+			for _, acl := range acls[scope] {
+				sb.WriteString(fmt.Sprintf("#   %s\n", acl))
+			}
+		}
+		group := groups[scope]
+		sort.Slice(group, func(i, j int) bool {
+			return group[i].Name < group[j].Name
+		})
+		for _, v := range group {
+			if v.Description != "" {
+				sb.WriteString(fmt.Sprintf("# %s\n", v.Description))
+			}
+			if commented {
+				sb.WriteString("# ")
+			}
+			sb.WriteString(fmt.Sprintf("%s = \"\"\n", v.Name))
+		}
+	}
+	return sb.String()
+}
+
+// assignedTfvarsNames returns names of variables that are assigned in the tfvars file, including
+// commented out assignments
+func assignedTfvarsNames(content string) map[string]bool {
+	names := map[string]bool{}
+	for _, match := range tfvarsAssignmentRegex.FindAllStringSubmatch(content, -1) {
+		names[match[1]] = true
+	}
+	return names
+}
+
+// appendSecretsTfvars adds commented out assignments of sensitive variables that aren't yet in the existing
+// secrets file, i.e. after an incremental export, keeping values that were already provided
+func (ic *importContext) appendSecretsTfvars(fileName string, sensitive []tfvar, acls map[string][]string) error {
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		return err
+	}
+	assigned := assignedTfvarsNames(string(content))
+	var missing []tfvar
+	for _, v := range sensitive {
+		if !assigned[v.Name] {
+			missing = append(missing, v)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	log.Printf("[INFO] Adding %d sensitive variables to %s", len(missing), fileName)
+	f, err := os.OpenFile(fileName, os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = f.WriteString(tfvarsContent(missing, acls, true))
+	return err
+}
+
+// generateTfvars writes templates with values of variables, so providing values for many variables after
+// a big export is manageable: terraform.tfvars.example with all variables, and secrets.auto.tfvars with
+// values of secrets. The latter is readable only by the owner and isn't overwritten, as it may already contain
+// values - only assignments of new secrets are appended to it.
+func (ic *importContext) generateTfvars() error {
+	if len(ic.variables) == 0 {
+		return nil
+	}
+	vars, err := ic.readVariables()
+	if err != nil {
+		return err
+	}
+	acls := map[string][]string{}
+	if ic.tfvarsSecretAcls {
+		for _, v := range vars {
+			if _, ok := acls[v.Scope]; v.Scope != "" && !ok {
+				acls[v.Scope] = ic.secretScopeAcls(v.Scope)
+			}
+		}
+	}
+	err = os.WriteFile(filepath.Join(ic.Directory, tfvarsExampleFileName), []byte("# Values of variables "+
+		"from vars.tf. Copy this file to terraform.tfvars and provide values.\n"+tfvarsContent(vars, acls, false)), 0644)
+	if err != nil {
+		return err
+	}
+	var sensitive []tfvar
+	for _, v := range vars {
+		if v.Sensitive {
+			sensitive = append(sensitive, v)
+		}
+	}
+	if len(sensitive) == 0 {
+		return nil
+	}
+	secretsFileName := filepath.Join(ic.Directory, secretsTfvarsFileName)
+	if _, err := os.Stat(secretsFileName); err == nil {
+		return ic.appendSecretsTfvars(secretsFileName, sensitive, acls)
+	}
+	log.Printf("[INFO] Writing %d sensitive variables into %s", len(sensitive), secretsFileName)
+	return os.WriteFile(secretsFileName, []byte("# Values of secrets, that are loaded by Terraform automatically. "+
+		"Uncomment and provide values,\n# and don't commit this file into version control.\n"+
+		tfvarsContent(sensitive, acls, true)), 0600)
+}
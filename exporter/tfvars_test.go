@@ -0,0 +1,132 @@
+package exporter
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/databricks/terraform-provider-databricks/secrets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateTfvars(t *testing.T) {
+	ic := importContextForTest()
+	ic.Directory = t.TempDir()
+	ic.variables = map[string]string{}
+	ic.sensitiveVariable("string_value_abc_a", "Value of the a secret in the abc secret scope")
+	ic.setVariableScope("string_value_abc_a", "abc")
+	ic.variable("key_vault_id_kv", "ID of Azure Key Vault with secrets of the kv secret scope")
+	ic.setVariableScope("key_vault_id_kv", "kv")
+	ic.sensitiveVariable("config_url_slack", "")
+	require.NoError(t, ic.generateVariables())
+	require.NoError(t, ic.generateTfvars())
+
+	example, err := os.ReadFile(filepath.Join(ic.Directory, tfvarsExampleFileName))
+	require.NoError(t, err)
+	assert.Equal(t, `# Values of variables from vars.tf. Copy this file to terraform.tfvars and provide values.
+
+# Secret scope: abc
+# Value of the a secret in the abc secret scope
+string_value_abc_a = ""
+
+# Secret scope: kv
+# ID of Azure Key Vault with secrets of the kv secret scope
+key_vault_id_kv = ""
+
+# Other variables
+config_url_slack = ""
+`, string(example))
+
+	secretsFile := filepath.Join(ic.Directory, secretsTfvarsFileName)
+	stat, err := os.Stat(secretsFile)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), stat.Mode().Perm())
+	content, err := os.ReadFile(secretsFile)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "# Secret scope: abc\n# Value of the a secret in the abc secret scope\n"+
+		"# string_value_abc_a = \"\"\n")
+	assert.Contains(t, string(content), "# config_url_slack = \"\"\n")
+	assert.NotContains(t, string(content), "key_vault_id_kv")
+
+	// values of secrets aren't overwritten by the next export, only new secrets are added
+	require.NoError(t, os.WriteFile(secretsFile, []byte(`string_value_abc_a = "secret"`), 0644))
+	require.NoError(t, ic.generateTfvars())
+	content, err = os.ReadFile(secretsFile)
+	require.NoError(t, err)
+	expected := `string_value_abc_a = "secret"
+# Other variables
+# config_url_slack = ""
+`
+	assert.Equal(t, expected, string(content))
+
+	require.NoError(t, ic.generateTfvars())
+	content, err = os.ReadFile(secretsFile)
+	require.NoError(t, err)
+	assert.Equal(t, expected, string(content))
+}
+
+func TestGenerateTfvarsScopesOfPreviousRuns(t *testing.T) {
+	ic := importContextForTest()
+	ic.Directory = t.TempDir()
+	ic.variables = map[string]string{}
+	ic.sensitiveVariable("string_value_abc_a", "Value of the a secret in the abc secret scope")
+	ic.variable("key_vault_id_kv", "ID of Azure Key Vault with secrets of the kv secret scope")
+	require.NoError(t, ic.generateVariables())
+
+	vars, err := ic.readVariables()
+	require.NoError(t, err)
+	scopes := map[string]string{}
+	for _, v := range vars {
+		scopes[v.Name] = v.Scope
+	}
+	assert.Equal(t, map[string]string{"string_value_abc_a": "abc", "key_vault_id_kv": "kv"}, scopes)
+}
+
+func TestAssignedTfvarsNames(t *testing.T) {
+	assert.Equal(t, map[string]bool{"a": true, "b_c": true, "d": true}, assignedTfvarsNames(`a = "x"
+# Secret scope: abc
+#   READ users
+# b_c = ""
+  # d = "y"
+`))
+}
+
+func TestGenerateTfvarsNoVariables(t *testing.T) {
+	ic := importContextForTest()
+	ic.Directory = t.TempDir()
+	require.NoError(t, ic.generateTfvars())
+	assert.NoFileExists(t, filepath.Join(ic.Directory, tfvarsExampleFileName))
+	assert.NoFileExists(t, filepath.Join(ic.Directory, secretsTfvarsFileName))
+}
+
+func TestGenerateTfvarsWithSecretAcls(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/secrets/acls/list?scope=abc",
+			Response: secrets.SecretScopeACL{
+				Items: []secrets.ACLItem{
+					{Principal: "users", Permission: "READ"},
+					{Principal: "admins", Permission: "MANAGE"},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTestWithClient(ctx, client)
+		ic.Directory = t.TempDir()
+		ic.tfvarsSecretAcls = true
+		ic.variables = map[string]string{}
+		ic.sensitiveVariable("string_value_abc_a", "Value of the a secret in the abc secret scope")
+		ic.setVariableScope("string_value_abc_a", "abc")
+		require.NoError(t, ic.generateVariables())
+		require.NoError(t, ic.generateTfvars())
+
+		example, err := os.ReadFile(filepath.Join(ic.Directory, tfvarsExampleFileName))
+		require.NoError(t, err)
+		assert.Contains(t, string(example), "# Secret scope: abc\n#   MANAGE admins\n#   READ users\n")
+	})
+}
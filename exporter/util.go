@@ -1,14 +1,18 @@
 package exporter
 
 import (
+	"bytes"
 	"crypto/sha1"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"os"
 	"path"
+	"path/filepath"
 	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -20,17 +24,29 @@ import (
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/jobs"
 	"github.com/databricks/terraform-provider-databricks/libraries"
+	"github.com/databricks/terraform-provider-databricks/permissions"
+	"github.com/databricks/terraform-provider-databricks/pipelines"
 	"github.com/databricks/terraform-provider-databricks/scim"
 	"github.com/databricks/terraform-provider-databricks/storage"
+	"github.com/databricks/terraform-provider-databricks/tokens"
 	"github.com/databricks/terraform-provider-databricks/workspace"
 
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
 	"github.com/databricks/databricks-sdk-go/service/compute"
-	"github.com/databricks/databricks-sdk-go/service/iam"
+	sdk_jobs "github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/databricks/databricks-sdk-go/service/ml"
+	"github.com/databricks/databricks-sdk-go/service/sql"
 
+	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
 
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/hashicorp/hcl/v2/hclwrite"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zclconf/go-cty/cty"
 )
 
 func (ic *importContext) emitInitScripts(initScripts []clusters.InitScriptStorageInfo) {
@@ -119,6 +135,481 @@ func (ic *importContext) emitListOfUsers(users []string) {
 	}
 }
 
+// emitNotificationDestinations emits notification destinations referenced from webhook notifications of jobs & tasks
+func (ic *importContext) emitNotificationDestinations(wn *sdk_jobs.WebhookNotifications) {
+	if wn == nil {
+		return
+	}
+	ids := []string{}
+	for _, w := range wn.OnDurationWarningThresholdExceeded {
+		ids = append(ids, w.Id)
+	}
+	for _, webhooks := range [][]sdk_jobs.Webhook{wn.OnFailure, wn.OnStart, wn.OnSuccess} {
+		for _, w := range webhooks {
+			ids = append(ids, w.Id)
+		}
+	}
+	for _, id := range ids {
+		if id != "" {
+			ic.Emit(&resource{
+				Resource: "databricks_notification_destination",
+				ID:       id,
+			})
+		}
+	}
+}
+
+// emitUCGrants emits grants on the given Unity Catalog securable, like `catalog` or `table`
+func (ic *importContext) emitUCGrants(securable, name string) {
+	ic.Emit(&resource{
+		Resource: "databricks_grants",
+		ID:       securable + "/" + name,
+	})
+}
+
+// emitWorkspaceBindings emits bindings of the given Unity Catalog securable, like `catalog` or `external_location`,
+// to workspaces. Errors are only logged, so the securable itself is still exported
+func (ic *importContext) emitWorkspaceBindings(securableType, name string) {
+	bindings, err := ic.workspaceClient.WorkspaceBindings.GetBindings(ic.Context, catalog.GetBindingsRequest{
+		SecurableType: securableType,
+		SecurableName: name,
+	})
+	if err != nil {
+		log.Printf("[WARN] Can't get workspace bindings of %s %s: %v", securableType, name, err)
+		ic.addIgnoredResource(ignoredResource{Resource: "databricks_catalog_workspace_binding",
+			Attribute: "securable_name", Value: name, Reason: ignoreReasonForError(err), Message: err.Error()})
+		return
+	}
+	for _, binding := range bindings.Bindings {
+		ic.Emit(&resource{
+			Resource: "databricks_catalog_workspace_binding",
+			ID:       fmt.Sprintf("%d|%s|%s", binding.WorkspaceId, securableType, name),
+		})
+	}
+}
+
+// bindingSecurableOfType returns the ResourceID function for references from workspace bindings, that resolves
+// `securable_name` only for bindings of the given `securable_type`, as catalogs and external locations could
+// have the same names
+func bindingSecurableOfType(securableType string) func(d *schema.ResourceData, value string) string {
+	return func(d *schema.ResourceData, value string) string {
+		if d.Get("securable_type").(string) != securableType {
+			return ""
+		}
+		return value
+	}
+}
+
+// emitServicePrincipalSecret emits a stub of OAuth secret for the service principal that runs exported jobs
+// or pipelines, if the service principal already uses OAuth secrets. Values of existing secrets can't be read
+// back, so the stub creates a new secret when applied, and it requires `account_id` in the provider
+// configuration, like the resource itself
+func (ic *importContext) emitServicePrincipalSecret(applicationID string) {
+	if ic.Client.Config.AccountID == "" {
+		log.Printf("[DEBUG] skipping secret of service principal %s because account_id isn't set", applicationID)
+		return
+	}
+	sp, err := ic.findSpnByAppID(applicationID, false)
+	if err != nil {
+		log.Printf("[WARN] can't find service principal %s: %v", applicationID, err)
+		return
+	}
+	secrets, err := tokens.NewServicePrincipalSecretAPI(ic.Context, ic.Client).ListAll(sp.ID)
+	if err != nil {
+		log.Printf("[WARN] can't list secrets of service principal %s: %v", applicationID, err)
+		return
+	}
+	if len(secrets) == 0 {
+		log.Printf("[DEBUG] skipping secret of service principal %s that doesn't use OAuth secrets", applicationID)
+		return
+	}
+	ic.Emit(&resource{
+		Resource: "databricks_service_principal_secret",
+		ID:       sp.ID,
+		Data: ic.Resources["databricks_service_principal_secret"].Data(
+			&terraform.InstanceState{
+				ID: sp.ID,
+				Attributes: map[string]string{
+					"service_principal_id": sp.ID,
+				},
+			}),
+	})
+}
+
+// generateServicePrincipalSecretBody generates the stub of service principal secret, that is created only
+// when the corresponding variable is true, so new credentials are minted only on request. The new secret
+// is available through the sensitive output, so it could be passed to CI/CD pipelines
+func generateServicePrincipalSecretBody(ic *importContext, body *hclwrite.Body, r *resource) error {
+	ir := ic.Importables[r.Resource]
+	resourceBlock := body.AppendNewBlock("resource", []string{r.Resource, r.Name})
+	createVariable := ic.variable("create_"+r.Name, fmt.Sprintf(
+		"Set to true to create a new OAuth secret for the service principal %s, as existing secrets can't be exported",
+		r.Data.Get("service_principal_id").(string)))
+	count := append(createVariable, hclwrite.Tokens{
+		{Type: hclsyntax.TokenQuestion, Bytes: []byte("?")},
+		{Type: hclsyntax.TokenNumberLit, Bytes: []byte("1")},
+		{Type: hclsyntax.TokenColon, Bytes: []byte(":")},
+		{Type: hclsyntax.TokenNumberLit, Bytes: []byte("0")},
+	}...)
+	resourceBlock.Body().SetAttributeRaw("count", count)
+	err := ic.dataToHcl(ir, []string{}, ic.Resources[r.Resource], r.Data, resourceBlock.Body())
+	if err != nil {
+		return err
+	}
+	output := body.AppendNewBlock("output", []string{r.Name + "_secret"}).Body()
+	secrets := append(hclwrite.TokensForTraversal(hcl.Traversal{
+		hcl.TraverseRoot{Name: r.Resource},
+		hcl.TraverseAttr{Name: r.Name},
+	}), hclwrite.Tokens{
+		{Type: hclsyntax.TokenOBrack, Bytes: []byte("[")},
+		{Type: hclsyntax.TokenStar, Bytes: []byte("*")},
+		{Type: hclsyntax.TokenCBrack, Bytes: []byte("]")},
+		{Type: hclsyntax.TokenDot, Bytes: []byte(".")},
+		{Type: hclsyntax.TokenIdent, Bytes: []byte("secret")},
+	}...)
+	output.SetAttributeRaw("value", hclwrite.TokensForFunctionCall("one", secrets))
+	output.SetAttributeValue("sensitive", cty.True)
+	return nil
+}
+
+// naming conventions of secret scopes, which values are stored in external secret stores
+var secretStoreScopeRegexes = []struct {
+	dataSource string
+	re         *regexp.Regexp
+}{
+	{"azurerm_key_vault_secret", regexp.MustCompile(`(?i)(^|[-_.])(kv|akv|keyvault|key-vault)([-_.]|$)`)},
+	{"aws_secretsmanager_secret_version",
+		regexp.MustCompile(`(?i)(^|[-_.])(aws|asm|secretsmanager|secrets-manager)([-_.]|$)`)},
+}
+
+// secretStoreKeyRegex matches characters that aren't allowed in names of Azure Key Vault secrets
+var secretStoreKeyRegex = regexp.MustCompile(`[^0-9a-zA-Z-]`)
+
+// secretStore returns the data source of the secret store that backs the secret scope, when
+// `-secret-store-stubs` is specified and the name of the scope follows one of the naming conventions
+func (ic *importContext) secretStore(scope string) string {
+	if !ic.secretStoreStubs {
+		return ""
+	}
+	for _, store := range secretStoreScopeRegexes {
+		if store.re.MatchString(scope) {
+			return store.dataSource
+		}
+	}
+	return ""
+}
+
+func generateSecretBody(ic *importContext, body *hclwrite.Body, r *resource) error {
+	ir := ic.Importables[r.Resource]
+	resourceBlock := body.AppendNewBlock("resource", []string{r.Resource, r.Name})
+	err := ic.dataToHcl(ir, []string{}, ic.Resources[r.Resource], r.Data, resourceBlock.Body())
+	if err != nil {
+		return err
+	}
+	scope := r.Data.Get("scope").(string)
+	key := r.Data.Get("key").(string)
+	store := ic.secretStore(scope)
+	if store == "" {
+		// the same name, as generated for `Variable: true` dependency
+		name := ir.variableName(reference{Path: "string_value", Variable: true},
+			ic.regexFix(ir.Name(ic, r.Data), simpleNameFixes))
+		ic.sensitiveVariable(name, fmt.Sprintf("Value of the %s secret in the %s secret scope", key, scope))
+		ic.setVariableScope(name, scope)
+		return nil
+	}
+	// resource block goes first, as the name of the first block identifies generated code in incremental mode
+	data := body.AppendNewBlock("data", []string{store, r.Name}).Body()
+	valueAttribute := "secret_string"
+	if store == "azurerm_key_vault_secret" {
+		valueAttribute = "value"
+		data.SetAttributeValue("name", cty.StringVal(secretStoreKeyRegex.ReplaceAllString(key, "-")))
+		name := "key_vault_id_" + ic.regexFix(scope, simpleNameFixes)
+		data.SetAttributeRaw("key_vault_id", ic.variable(name,
+			fmt.Sprintf("ID of Azure Key Vault with secrets of the %s secret scope", scope)))
+		ic.setVariableScope(name, scope)
+	} else {
+		data.SetAttributeValue("secret_id", cty.StringVal(scope+"/"+key))
+	}
+	resourceBlock.Body().SetAttributeTraversal("string_value", hcl.Traversal{
+		hcl.TraverseRoot{Name: "data"},
+		hcl.TraverseAttr{Name: store},
+		hcl.TraverseAttr{Name: r.Name},
+		hcl.TraverseAttr{Name: valueAttribute},
+	})
+	return nil
+}
+
+// isSystemUcCatalog checks if the catalog is created by Databricks and shouldn't be exported
+func isSystemUcCatalog(v catalog.CatalogInfo) bool {
+	return v.CatalogType == catalog.CatalogTypeSystemCatalog || v.Name == "hive_metastore" ||
+		strings.HasPrefix(v.Name, "__databricks_internal")
+}
+
+// starterWarehouseNames are names of SQL warehouses automatically created in new workspaces
+var starterWarehouseNames = []string{"Starter Warehouse", "Serverless Starter Warehouse"}
+
+// isBuiltInGroup checks if the group is created by Databricks in every workspace or account
+func (ic *importContext) isBuiltInGroup(name string) bool {
+	if ic.accountLevel {
+		return name == "account users"
+	}
+	return name == "users" || name == "admins"
+}
+
+// isBundleJob checks if the job is deployed by Databricks Asset Bundles, so it's managed outside of Terraform
+func isBundleJob(job jobs.Job) bool {
+	return job.Settings != nil && job.Settings.Deployment != nil &&
+		job.Settings.Deployment.Kind == sdk_jobs.JobDeploymentKindBundle
+}
+
+// isNotebookExperiment checks if the MLflow experiment is bound to a notebook, such experiments can't be created
+// via API and are created automatically when runs are logged from the notebook
+func isNotebookExperiment(experiment ml.Experiment) bool {
+	for _, tag := range experiment.Tags {
+		if tag.Key == "mlflow.experimentType" && tag.Value == "NOTEBOOK" {
+			return true
+		}
+	}
+	return false
+}
+
+// isBundlePipeline checks if the DLT pipeline is deployed by Databricks Asset Bundles
+func isBundlePipeline(d *schema.ResourceData) bool {
+	return d.Get("deployment.0.kind").(string) == string(sdk_jobs.JobDeploymentKindBundle)
+}
+
+// skipBundleManaged returns true and records the object in the bundle-managed report, if the object is
+// deployed by Databricks Asset Bundles, unless -include-bundle-managed is specified
+func (ic *importContext) skipBundleManaged(isBundleManaged bool, resourceType, attribute, value,
+	metadataFilePath string) bool {
+	if !isBundleManaged || ic.includeBundleManaged {
+		return false
+	}
+	log.Printf("[INFO] Skipping %s with %s=%s deployed by Databricks Asset Bundles", resourceType, attribute, value)
+	ir := ignoredResource{Resource: resourceType, Attribute: attribute, Value: value,
+		Reason: ignoreReasonBundleManaged, Message: metadataFilePath}
+	ic.bundleManagedMutex.Lock()
+	defer ic.bundleManagedMutex.Unlock()
+	ic.bundleManaged[ir.String()] = ir
+	return true
+}
+
+// writeBundleManagedResources writes a list of objects deployed by Databricks Asset Bundles together with
+// the paths to their bundle metadata, so they aren't managed by both Terraform & bundles
+func (ic *importContext) writeBundleManagedResources() error {
+	ic.bundleManagedMutex.Lock()
+	defer ic.bundleManagedMutex.Unlock()
+	if len(ic.bundleManaged) == 0 {
+		return nil
+	}
+	keys := maps.Keys(ic.bundleManaged)
+	sort.Strings(keys)
+	var sb strings.Builder
+	for _, k := range keys {
+		sb.WriteString(k)
+		if metadataFilePath := ic.bundleManaged[k].Message; metadataFilePath != "" {
+			sb.WriteString(" (metadata: " + metadataFilePath + ")")
+		}
+		sb.WriteString("\n")
+	}
+	return os.WriteFile(fmt.Sprintf("%s/bundle-managed.txt", ic.Directory), []byte(sb.String()), 0644)
+}
+
+// excludableAttributes returns values of attributes that are matched by `-exclude-regex` after the object is read
+func excludableAttributes(d *schema.ResourceData) []string {
+	values := []string{}
+	if d == nil {
+		return values
+	}
+	for _, attr := range []string{"path", "name", "display_name", "user_name"} {
+		if v, ok := d.GetOk(attr); ok {
+			if s, ok := v.(string); ok {
+				values = append(values, s)
+			}
+		}
+	}
+	return values
+}
+
+// isExcluded returns true and records the object in the list of ignored resources, if any of the values matches
+// regular expressions from `-exclude-regex` for the resource type or its service
+func (ic *importContext) isExcluded(ir importable, r *resource, values ...string) bool {
+	if len(ic.excludeRegexes) == 0 {
+		return false
+	}
+	for _, key := range []string{r.Resource, ir.Service} {
+		for _, re := range ic.excludeRegexes[key] {
+			for _, v := range values {
+				if v == "" || !re.MatchString(v) {
+					continue
+				}
+				log.Printf("[INFO] Excluding %s because %s matches %s", r, v, re)
+				ic.addIgnoredResource(ignoredResource{Resource: r.Resource, Attribute: "id", Value: r.ID,
+					Reason: ignoreReasonExcluded, Message: v})
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// objects are opted out of the export with the `tf-exporter` tag set to `skip`, or with the `tf-exporter:skip`
+// marker in their description
+const (
+	optOutTagKey   = "tf-exporter"
+	optOutTagValue = "skip"
+	optOutMarker   = optOutTagKey + ":" + optOutTagValue
+)
+
+// optOutTags maps resource types to attributes with their tags
+var optOutTags = map[string]string{
+	"databricks_job":          "tags",
+	"databricks_cluster":      "custom_tags",
+	"databricks_sql_endpoint": "tags.0.custom_tags",
+	"databricks_pipeline":     "configuration",
+}
+
+func hasOptOutTag(v any) bool {
+	switch tags := v.(type) {
+	case map[string]any:
+		value, ok := tags[optOutTagKey]
+		return ok && strings.EqualFold(fmt.Sprint(value), optOutTagValue)
+	case []any:
+		for _, tag := range tags {
+			m, ok := tag.(map[string]any)
+			if ok && m["key"] == optOutTagKey && strings.EqualFold(fmt.Sprint(m["value"]), optOutTagValue) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isOptedOut returns true and records the object in the list of ignored resources, if its owners marked it
+// to be skipped by the exporter
+func (ic *importContext) isOptedOut(r *resource) bool {
+	attribute, ok := optOutTags[r.Resource]
+	if !ok || r.Data == nil {
+		return false
+	}
+	optedOut := hasOptOutTag(r.Data.Get(attribute))
+	if _, ok := ic.Resources[r.Resource].Schema["description"]; ok {
+		optedOut = optedOut || strings.Contains(r.Data.Get("description").(string), optOutMarker)
+	}
+	if !optedOut {
+		return false
+	}
+	log.Printf("[INFO] Skipping %s, as it's opted out with %s", r, optOutMarker)
+	ic.addIgnoredResource(ignoredResource{Resource: r.Resource, Attribute: "id", Value: r.ID,
+		Reason: ignoreReasonOptedOut})
+	return true
+}
+
+// skipSystemObject returns true and records the object in the list of ignored resources, if the object is
+// created by Databricks, unless -include-system-objects is specified
+func (ic *importContext) skipSystemObject(isSystemObject bool, resourceType, attribute, value string) bool {
+	if !isSystemObject || ic.includeSystemObjects {
+		return false
+	}
+	log.Printf("[INFO] Skipping system object %s with %s=%s", resourceType, attribute, value)
+	ic.addIgnoredResource(ignoredResource{Resource: resourceType, Attribute: attribute, Value: value,
+		Reason: ignoreReasonSystemObject})
+	return true
+}
+
+// emitVolumeFiles recursively walks the directory of UC volume and emits all files in it
+func (ic *importContext) emitVolumeFiles(path string) error {
+	entries, err := storage.ListVolumeDirectory(ic.Context, ic.Client, path)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if e.IsDirectory {
+			if err = ic.emitVolumeFiles(e.Path); err != nil {
+				return err
+			}
+			continue
+		}
+		ic.Emit(&resource{
+			Resource: "databricks_file",
+			ID:       e.Path,
+		})
+	}
+	return nil
+}
+
+// emitLakehouseMonitor emits the quality monitor of the table, if the table is monitored
+func (ic *importContext) emitLakehouseMonitor(tableName string) {
+	if !ic.isServiceEnabled("uc-monitors") {
+		return
+	}
+	_, err := ic.workspaceClient.LakehouseMonitors.GetByFullName(ic.Context, tableName)
+	if err != nil {
+		if apierr.IsMissing(err) || errors.Is(err, apierr.ErrNotFound) {
+			return
+		}
+		log.Printf("[WARN] Can't get quality monitor of table %s: %v", tableName, err)
+		ic.addIgnoredResource(ignoredResource{Resource: "databricks_lakehouse_monitor", Attribute: "table_name",
+			Value: tableName, Reason: ignoreReasonForError(err), Message: err.Error()})
+		return
+	}
+	ic.Emit(&resource{
+		Resource: "databricks_lakehouse_monitor",
+		ID:       tableName,
+	})
+}
+
+// isLakehouseMonitorOutputTable checks if the table is the profile or drift metrics table of an exported
+// quality monitor, as such tables are created and updated by the monitor itself
+func (ic *importContext) isLakehouseMonitorOutputTable(tableName string) bool {
+	return ic.State.Get("databricks_lakehouse_monitor", "profile_metrics_table_name", tableName) != nil ||
+		ic.State.Get("databricks_lakehouse_monitor", "drift_metrics_table_name", tableName) != nil
+}
+
+// entitlementFields are attributes of users, service principals and groups, that are exported
+// into separate databricks_entitlements resources with the `-separate-entitlements` option
+var entitlementFields = []string{"allow_cluster_create", "allow_instance_pool_create",
+	"databricks_sql_access", "workspace_access"}
+
+func (ic *importContext) isEntitlementExportedSeparately(pathString string) bool {
+	return ic.separateEntitlements && !ic.accountLevel && slices.Contains(entitlementFields, pathString)
+}
+
+// emitEntitlements emits databricks_entitlements resource for the identity, if it has any entitlements.
+// objType is one of `user`, `spn` or `group`, as in ID of databricks_entitlements resource
+func (ic *importContext) emitEntitlements(objType, id, name string, d *schema.ResourceData) {
+	if !ic.separateEntitlements || ic.accountLevel || d == nil {
+		return
+	}
+	for _, field := range entitlementFields {
+		if d.Get(field).(bool) {
+			ic.Emit(&resource{
+				Resource: "databricks_entitlements",
+				ID:       objType + "/" + id,
+				Name:     objType + "_" + name,
+			})
+			return
+		}
+	}
+}
+
+// identityBlockBody generates block of the user, service principal or group. When entitlements are exported
+// as databricks_entitlements resources, changes of them are ignored in the identity resource.
+func identityBlockBody(ic *importContext, body *hclwrite.Body, r *resource) error {
+	err := resourceOrDataBlockBody(ic, body, r)
+	if err != nil || r.Mode == "data" || !ic.separateEntitlements || ic.accountLevel {
+		return err
+	}
+	blocks := body.Blocks()
+	ignored := make([]hclwrite.Tokens, 0, len(entitlementFields))
+	for _, field := range entitlementFields {
+		ignored = append(ignored, hclwrite.TokensForIdentifier(field))
+	}
+	lifecycle := blocks[len(blocks)-1].Body().AppendNewBlock("lifecycle", nil)
+	lifecycle.Body().SetAttributeRaw("ignore_changes", hclwrite.TokensForTuple(ignored))
+	return nil
+}
+
 func (ic *importContext) emitUserOrServicePrincipal(userOrSPName string) {
 	if userOrSPName == "" || !ic.isServiceEnabled("users") {
 		return
@@ -135,7 +626,8 @@ func (ic *importContext) emitUserOrServicePrincipal(userOrSPName string) {
 		user, err := ic.findSpnByAppID(userOrSPName, false)
 		if err != nil {
 			log.Printf("[ERROR] Can't find SP with application ID %s", userOrSPName)
-			ic.addIgnoredResource(fmt.Sprintf("databricks_service_principal. application_id=%s", userOrSPName))
+			ic.addIgnoredResource(ignoredResource{Resource: "databricks_service_principal", Attribute: "application_id",
+				Value: userOrSPName, Reason: ignoreReasonNotFound, Message: err.Error()})
 		} else {
 			ic.Emit(&resource{
 				Resource: "databricks_service_principal",
@@ -146,7 +638,8 @@ func (ic *importContext) emitUserOrServicePrincipal(userOrSPName string) {
 		user, err := ic.findUserByName(strings.ToLower(userOrSPName), false)
 		if err != nil {
 			log.Printf("[ERROR] Can't find user with name %s", userOrSPName)
-			ic.addIgnoredResource(fmt.Sprintf("databricks_user. user_name=%s", userOrSPName))
+			ic.addIgnoredResource(ignoredResource{Resource: "databricks_user", Attribute: "user_name",
+				Value: userOrSPName, Reason: ignoreReasonNotFound, Message: err.Error()})
 		} else {
 			ic.Emit(&resource{
 				Resource: "databricks_user",
@@ -176,6 +669,76 @@ func getUserOrSpNameAndDirectory(path, prefix string) (string, string) {
 	return path[searchStart : pos+searchStart], path[0 : pos+searchStart]
 }
 
+// workspaceObjectPermissionsResources maps prefixes of IDs of permissions of workspace objects, that
+// inherit permissions of their parent directories, to resources of these objects
+var workspaceObjectPermissionsResources = map[string]string{
+	"/notebooks/":   "databricks_notebook",
+	"/files/":       "databricks_workspace_file",
+	"/directories/": "databricks_directory",
+}
+
+// parentDirectoryPermissions returns databricks_permissions of the parent directory of the workspace object,
+// if both the parent directory and its permissions are exported in this run
+func (ic *importContext) parentDirectoryPermissions(objectID string) *resourceApproximation {
+	for prefix, resourceType := range workspaceObjectPermissionsResources {
+		if !strings.HasPrefix(objectID, prefix) {
+			continue
+		}
+		object := ic.State.Get(resourceType, "object_id", strings.TrimPrefix(objectID, prefix))
+		if object == nil || len(object.Instances) == 0 {
+			return nil
+		}
+		objectPath, _ := object.Instances[0].Attributes["path"].(string)
+		parentPath := path.Dir(objectPath)
+		if objectPath == "" || parentPath == objectPath {
+			return nil
+		}
+		parent := ic.State.Get("databricks_directory", "path", parentPath)
+		if parent == nil || len(parent.Instances) == 0 {
+			return nil
+		}
+		parentObjectID, _ := parent.Instances[0].Attributes["object_id"].(string)
+		return ic.State.Get("databricks_permissions", "id", "/directories/"+parentObjectID)
+	}
+	return nil
+}
+
+// accessControlKey identifies the principal and permission level of the access control entry
+func accessControlKey(userName, groupName, servicePrincipalName, permissionLevel string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", userName, groupName, servicePrincipalName, permissionLevel)
+}
+
+// hasOnlyInheritedPermissions checks if every permission set directly on the workspace object is also set
+// by the exported databricks_permissions of its parent directory, so databricks_permissions for the object
+// wouldn't change anything. If the parent's permissions are skipped for the same reason, they are covered by
+// their own parent, so the grants are still exported.
+func (ic *importContext) hasOnlyInheritedPermissions(objectID string, acl []permissions.AccessControlChange) bool {
+	parent := ic.parentDirectoryPermissions(objectID)
+	if parent == nil || len(parent.Instances) == 0 {
+		return false
+	}
+	attrs := parent.Instances[0].Attributes
+	attr := func(k string) string {
+		v, _ := attrs[k].(string)
+		return v
+	}
+	parentACL := map[string]bool{}
+	for k := range attrs {
+		if !strings.HasPrefix(k, "access_control.") || !strings.HasSuffix(k, ".permission_level") {
+			continue
+		}
+		prefix := strings.TrimSuffix(k, "permission_level")
+		parentACL[accessControlKey(attr(prefix+"user_name"), attr(prefix+"group_name"),
+			attr(prefix+"service_principal_name"), attr(k))] = true
+	}
+	for _, ac := range acl {
+		if !parentACL[accessControlKey(ac.UserName, ac.GroupName, ac.ServicePrincipalName, ac.PermissionLevel)] {
+			return false
+		}
+	}
+	return true
+}
+
 func (ic *importContext) emitUserOrServicePrincipalForPath(path, prefix string) {
 	userOrSpName, _ := getUserOrSpNameAndDirectory(path, prefix)
 	if userOrSpName != "" {
@@ -203,12 +766,14 @@ func (ic *importContext) IsUserOrServicePrincipalDirectory(path, prefix string,
 	if common.StringIsUUID(userOrSPName) {
 		_, err = ic.findSpnByAppID(userOrSPName, true)
 		if err != nil {
-			ic.addIgnoredResource(fmt.Sprintf("databricks_service_principal. application_id=%s", userOrSPName))
+			ic.addIgnoredResource(ignoredResource{Resource: "databricks_service_principal", Attribute: "application_id",
+				Value: userOrSPName, Reason: ignoreReasonNotFound, Message: err.Error()})
 		}
 	} else {
 		_, err = ic.findUserByName(strings.ToLower(userOrSPName), true)
 		if err != nil {
-			ic.addIgnoredResource(fmt.Sprintf("databricks_user. user_name=%s", userOrSPName))
+			ic.addIgnoredResource(ignoredResource{Resource: "databricks_user", Attribute: "user_name",
+				Value: userOrSPName, Reason: ignoreReasonNotFound, Message: err.Error()})
 		}
 	}
 	ic.userOrSpDirectoriesMutex.Lock()
@@ -244,6 +809,26 @@ func (ic *importContext) emitNotebookOrRepo(path string) {
 	}
 }
 
+// emitRepoContent emits notebooks & workspace files stored in a repo, so its content could be migrated
+// to workspaces that can't reach the Git remote of the repo
+func (ic *importContext) emitRepoContent(repoPath string) error {
+	notebooksAPI := workspace.NewNotebooksAPI(ic.Context, ic.Client)
+	objects, err := ListParallel(notebooksAPI, repoPath, excludeAuxiliaryDirectories, nil)
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Exporting content of repo %s: %d objects", repoPath, len(objects))
+	for _, object := range objects {
+		if res := ignoreIdeFolderRegex.FindStringSubmatch(object.Path); res != nil {
+			continue
+		}
+		if object.ObjectType == workspace.Notebook || object.ObjectType == workspace.File {
+			emitWorkpaceObject(ic, object)
+		}
+	}
+	return nil
+}
+
 func (ic *importContext) getAllDirectories() []workspace.ObjectStatus {
 	if len(ic.allDirectories) == 0 {
 		objects := ic.getAllWorkspaceObjects(nil)
@@ -369,27 +954,18 @@ func (ic *importContext) cacheGroups() error {
 	defer ic.groupsMutex.Unlock()
 	if ic.allGroups == nil {
 		log.Printf("[INFO] Caching groups in memory ...")
-		var groups []iam.Group
-		var err error
-		if ic.accountLevel {
-			groups, err = ic.accountClient.Groups.ListAll(ic.Context, iam.ListAccountGroupsRequest{
-				Attributes: "id",
-			})
-		} else {
-			groups, err = ic.workspaceClient.Groups.ListAll(ic.Context, iam.ListGroupsRequest{
-				Attributes: "id",
-			})
-		}
+		api := scim.NewGroupsAPI(ic.Context, ic.Client)
+		it := api.List("", "id")
+		groups, err := it.All()
 		if err != nil {
-			log.Printf("[ERROR] can't fetch list of groups")
+			log.Printf("[ERROR] can't fetch list of groups starting from index %d", it.StartIndex)
 			return err
 		}
-		api := scim.NewGroupsAPI(ic.Context, ic.Client)
 		ic.allGroups = make([]scim.Group, 0, len(groups))
 		for i, g := range groups {
-			group, err := api.Read(g.Id, "id,displayName,active,externalId,entitlements,groups,roles,members")
+			group, err := api.Read(g.ID, "id,displayName,active,externalId,entitlements,groups,roles,members")
 			if err != nil {
-				log.Printf("[ERROR] Error reading group with ID %s", g.Id)
+				log.Printf("[ERROR] Error reading group with ID %s", g.ID)
 				continue
 			}
 			ic.allGroups = append(ic.allGroups, group)
@@ -397,15 +973,340 @@ func (ic *importContext) cacheGroups() error {
 				log.Printf("[DEBUG] Read %d out of %d groups", i+1, len(groups))
 			}
 		}
+		// stable order of groups makes the generated code independent of the order returned by API
+		sort.Slice(ic.allGroups, func(i, j int) bool {
+			return ic.allGroups[i].DisplayName < ic.allGroups[j].DisplayName
+		})
 		log.Printf("[INFO] Cached %d groups", len(ic.allGroups))
 	}
 	return nil
 }
 
-func (ic *importContext) addIgnoredResource(msg string) {
+// isInGroupsFilter checks if user or service principal with the given ID should be exported
+// when `-groups-filter` is specified. Membership is resolved through nested groups as well.
+func (ic *importContext) isInGroupsFilter(id string) bool {
+	if ic.groupsFilter == "" {
+		return true
+	}
+	if err := ic.cacheGroups(); err != nil {
+		log.Printf("[WARN] can't resolve members of groups from -groups-filter: %s", err)
+		return true
+	}
+	ic.groupsMutex.Lock()
+	defer ic.groupsMutex.Unlock()
+	if ic.groupsFilterMembers == nil {
+		groupsByID := map[string]scim.Group{}
+		var queue []scim.Group
+		names := map[string]struct{}{}
+		for _, name := range strings.Split(ic.groupsFilter, ",") {
+			names[strings.TrimSpace(name)] = struct{}{}
+		}
+		for _, g := range ic.allGroups {
+			groupsByID[g.ID] = g
+			if _, ok := names[g.DisplayName]; ok {
+				queue = append(queue, g)
+			}
+		}
+		members := map[string]struct{}{}
+		visited := map[string]struct{}{}
+		for len(queue) > 0 {
+			g := queue[0]
+			queue = queue[1:]
+			if _, ok := visited[g.ID]; ok {
+				continue
+			}
+			visited[g.ID] = struct{}{}
+			for _, m := range g.Members {
+				if strings.HasPrefix(m.Ref, "Groups/") {
+					if nested, ok := groupsByID[m.Value]; ok {
+						queue = append(queue, nested)
+					}
+					continue
+				}
+				members[m.Value] = struct{}{}
+			}
+		}
+		log.Printf("[INFO] %d users & service principals are members of groups from -groups-filter", len(members))
+		ic.groupsFilterMembers = members
+	}
+	_, ok := ic.groupsFilterMembers[id]
+	return ok
+}
+
+// ignoreNotInGroupsFilter is used by `databricks_user` & `databricks_service_principal` to skip
+// objects outside of `-groups-filter`
+func ignoreNotInGroupsFilter(ic *importContext, r *resource) bool {
+	if ic.isInGroupsFilter(r.ID) {
+		return false
+	}
+	ic.addIgnoredResource(ignoredResource{Resource: r.Resource, Attribute: "id", Value: r.ID,
+		Reason: ignoreReasonGroupsFilter})
+	return true
+}
+
+func (ic *importContext) addIgnoredResource(ir ignoredResource) {
 	ic.ignoredResourcesMutex.Lock()
 	defer ic.ignoredResourcesMutex.Unlock()
-	ic.ignoredResources[msg] = struct{}{}
+	ic.ignoredResources[ir.String()] = ir
+}
+
+// ignoreReasonForError classifies API errors, so it's clear what to do with ignored resources
+func ignoreReasonForError(err error) string {
+	switch {
+	case apierr.IsMissing(err) || errors.Is(err, apierr.ErrNotFound):
+		return ignoreReasonNotFound
+	case errors.Is(err, apierr.ErrPermissionDenied) || errors.Is(err, apierr.ErrUnauthenticated):
+		return ignoreReasonPermissionDenied
+	case errors.Is(err, apierr.ErrNotImplemented):
+		return ignoreReasonUnsupported
+	}
+	// errors from the provider's read functions are converted into diagnostics, so we can only look at messages
+	msg := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(msg, "does not exist") || strings.Contains(msg, "not found"):
+		return ignoreReasonNotFound
+	case strings.Contains(msg, "permission") || strings.Contains(msg, "not authorized") ||
+		strings.Contains(msg, "forbidden"):
+		return ignoreReasonPermissionDenied
+	case strings.Contains(msg, "not enabled") || strings.Contains(msg, "not supported") ||
+		strings.Contains(msg, "not implemented"):
+		return ignoreReasonUnsupported
+	}
+	return ignoreReasonApiError
+}
+
+func (ic *importContext) addIgnoredResourceWithError(r *resource, err error) {
+	k, v := r.MatchPair()
+	ic.addIgnoredResource(ignoredResource{
+		Resource:  r.Resource,
+		Attribute: k,
+		Value:     v,
+		Reason:    ignoreReasonForError(err),
+		Message:   err.Error(),
+	})
+}
+
+// writeIgnoredResources writes a plain list of ignored resources, together with a structured JSON file
+// that includes reasons of why resources were ignored
+func (ic *importContext) writeIgnoredResources() error {
+	ic.ignoredResourcesMutex.Lock()
+	defer ic.ignoredResourcesMutex.Unlock()
+	keys := maps.Keys(ic.ignoredResources)
+	sort.Strings(keys)
+	ignoredList := make([]ignoredResource, 0, len(keys))
+	var sb strings.Builder
+	for _, k := range keys {
+		sb.WriteString(k + "\n")
+		ignoredList = append(ignoredList, ic.ignoredResources[k])
+	}
+	err := os.WriteFile(fmt.Sprintf("%s/ignored_resources.txt", ic.Directory), []byte(sb.String()), 0644)
+	if err != nil {
+		return err
+	}
+	content, err := json.MarshalIndent(ignoredList, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(fmt.Sprintf("%s/ignored_resources.json", ic.Directory), content, 0644)
+}
+
+// writeReadme writes summary of the export, so the generated directory describes itself
+func (ic *importContext) writeReadme(startTime time.Time, duration time.Duration) error {
+	perService := map[string]int{}
+	for _, r := range ic.Scope.Sorted() {
+		if ir, ok := ic.Importables[r.Resource]; ok {
+			perService[ir.Service]++
+		}
+	}
+	level := "workspace"
+	if ic.accountLevel {
+		level = "account"
+	}
+	var sb strings.Builder
+	sb.WriteString("# Databricks export\n\n")
+	sb.WriteString(fmt.Sprintf("Exported %d objects from %s %s with Databricks Terraform provider %s. ",
+		ic.Scope.Len(), level, ic.Client.Config.Host, common.Version()))
+	sb.WriteString(fmt.Sprintf("The export started at %s and took %s.\n\n",
+		startTime.UTC().Format(time.RFC3339), duration.Round(time.Second)))
+
+	sb.WriteString("## Exported objects\n\n| Service | Objects |\n|---|---|\n")
+	services := maps.Keys(perService)
+	sort.Strings(services)
+	for _, service := range services {
+		sb.WriteString(fmt.Sprintf("| `%s` | %d |\n", service, perService[service]))
+	}
+
+	if len(ic.variables) > 0 {
+		sb.WriteString("\n## Variables\n\n")
+		sb.WriteString("The following variables from `vars.tf` require values, for example, in `terraform.tfvars` file (see `terraform.tfvars.example`):\n\n")
+		names := maps.Keys(ic.variables)
+		sort.Strings(names)
+		for _, name := range names {
+			sb.WriteString(fmt.Sprintf("* `%s` - %s\n", name, ic.variables[name]))
+		}
+	}
+
+	ic.ignoredResourcesMutex.Lock()
+	ignored := len(ic.ignoredResources)
+	ic.ignoredResourcesMutex.Unlock()
+	if ignored > 0 {
+		sb.WriteString(fmt.Sprintf("\n%d objects weren't exported, see `ignored_resources.json` for reasons.\n", ignored))
+	}
+
+	sb.WriteString("\n## Import\n\n")
+	sb.WriteString("Provide values for variables, and bring the exported objects under management of Terraform:\n\n")
+	if ic.nativeImport {
+		sb.WriteString("```sh\nterraform init\nterraform plan\n```\n\n")
+		sb.WriteString("Resources are imported by the `import` blocks in the `imports.tf` file, " +
+			"so Terraform 1.5 or later is required.\n")
+	} else {
+		sb.WriteString("```sh\nterraform init\n./import.sh\nterraform plan\n```\n")
+	}
+	if len(ic.stateOps) > 0 {
+		sb.WriteString(fmt.Sprintf("\n%d objects are already managed in %s. ", len(ic.stateOps), ic.existingStateFile))
+		sb.WriteString("Run `./state-ops.sh` to move them from that state into this one, " +
+			"instead of importing them again.\n")
+	}
+	return os.WriteFile(fmt.Sprintf("%s/README.md", ic.Directory), []byte(sb.String()), 0644)
+}
+
+// importCommand generates the `terraform import` command for the resource with the given address, or the
+// `import` block of Terraform 1.5+ when `-native-import` is specified
+func (ic *importContext) importCommand(address, id string) string {
+	if ic.Module != "" {
+		address = ic.Module + "." + address
+	}
+	if ic.nativeImport {
+		f := hclwrite.NewEmptyFile()
+		block := f.Body().AppendNewBlock("import", nil).Body()
+		block.SetAttributeRaw("to", hclwrite.Tokens{{Type: hclsyntax.TokenIdent, Bytes: []byte(address)}})
+		block.SetAttributeValue("id", cty.StringVal(id))
+		return strings.TrimRight(string(hclwrite.Format(f.Bytes())), "\n")
+	}
+	return fmt.Sprintf(`terraform import %s "%s"`, shellQuoteAddress(address), id)
+}
+
+// shellQuoteAddress quotes addresses of resources with `for_each` or `count` for shell
+func shellQuoteAddress(address string) string {
+	if strings.Contains(address, "[") {
+		return "'" + address + "'"
+	}
+	return address
+}
+
+type existingState struct {
+	Resources []struct {
+		Module    string `json:"module,omitempty"`
+		Mode      string `json:"mode"`
+		Type      string `json:"type"`
+		Name      string `json:"name"`
+		Instances []struct {
+			IndexKey   any `json:"index_key,omitempty"`
+			Attributes struct {
+				ID string `json:"id"`
+			} `json:"attributes"`
+		} `json:"instances"`
+	} `json:"resources"`
+}
+
+// loadExistingState reads addresses of managed resources from the state file passed with `-existing-state`.
+// The result maps resource type and ID to the address of the resource in that state.
+func loadExistingState(fileName string) (map[string]string, error) {
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	var state existingState
+	if err = json.Unmarshal(content, &state); err != nil {
+		return nil, fmt.Errorf("can't parse %s: %w", fileName, err)
+	}
+	existing := map[string]string{}
+	for _, r := range state.Resources {
+		if r.Mode != "managed" {
+			continue
+		}
+		address := r.Type + "." + r.Name
+		if r.Module != "" {
+			address = r.Module + "." + address
+		}
+		for _, instance := range r.Instances {
+			if instance.Attributes.ID == "" {
+				continue
+			}
+			instanceAddress := address
+			switch key := instance.IndexKey.(type) {
+			case string:
+				instanceAddress = fmt.Sprintf(`%s["%s"]`, address, key)
+			case float64:
+				instanceAddress = fmt.Sprintf("%s[%d]", address, int64(key))
+			}
+			existing[r.Type+"/"+instance.Attributes.ID] = instanceAddress
+		}
+	}
+	log.Printf("[INFO] Found %d resources in the existing state %s", len(existing), fileName)
+	return existing, nil
+}
+
+// addStateOp records commands that remove the resource from the existing state and import it into the export
+func (ic *importContext) addStateOp(r *resource, existingAddress string) {
+	address := r.Resource + "." + r.Name
+	if ic.Module != "" {
+		address = ic.Module + "." + address
+	}
+	log.Printf("[INFO] %s is already managed as %s in %s", address, existingAddress, ic.existingStateFile)
+	ic.stateOpsMutex.Lock()
+	defer ic.stateOpsMutex.Unlock()
+	ic.stateOps = append(ic.stateOps, fmt.Sprintf("# %s (id: %s)\nterraform state rm -state=\"%s\" %s\n"+
+		"terraform import %s \"%s\"\n", existingAddress, r.ID, ic.existingStateFile,
+		shellQuoteAddress(existingAddress), shellQuoteAddress(address), r.ID))
+}
+
+// writeStateOps writes the state-ops.sh script for resources that are already managed in the existing state,
+// so they are moved into the export instead of being managed by two states
+func (ic *importContext) writeStateOps() error {
+	ic.stateOpsMutex.Lock()
+	defer ic.stateOpsMutex.Unlock()
+	if len(ic.stateOps) == 0 {
+		return nil
+	}
+	sort.Strings(ic.stateOps)
+	var sb strings.Builder
+	sb.WriteString("#!/bin/sh\n\nset -e\n\n")
+	sb.WriteString(fmt.Sprintf("# The following resources are already managed in %s.\n", ic.existingStateFile))
+	sb.WriteString("# Remove them from that state, and import them into the state of the export.\n\n")
+	sb.WriteString(strings.Join(ic.stateOps, "\n"))
+	return os.WriteFile(fmt.Sprintf("%s/state-ops.sh", ic.Directory), []byte(sb.String()), 0755)
+}
+
+// parseImportBlocks splits the text into separately formatted `import` blocks
+func parseImportBlocks(text string) []string {
+	f, diags := hclwrite.ParseConfig([]byte(text), "imports.tf", hcl.Pos{Line: 1, Column: 1})
+	if diags.HasErrors() {
+		log.Printf("[ERROR] parsing of import blocks failed: %s", diags.Error())
+		return nil
+	}
+	blocks := []string{}
+	for _, block := range f.Body().Blocks() {
+		if block.Type() != "import" {
+			continue
+		}
+		bf := hclwrite.NewEmptyFile()
+		bf.Body().AppendBlock(block)
+		blocks = append(blocks, strings.TrimRight(string(hclwrite.Format(bf.Bytes())), "\n"))
+	}
+	return blocks
+}
+
+// loadImportBlocks reads `import` blocks generated by the previous run, so they are kept in incremental mode
+func (ic *importContext) loadImportBlocks(fileName string) {
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		log.Printf("[ERROR] opening %s: %v", fileName, err)
+		return
+	}
+	for _, block := range parseImportBlocks(string(content)) {
+		ic.shImports[block] = true
+	}
 }
 
 const (
@@ -423,24 +1324,18 @@ func (ic *importContext) getUsersMapping() {
 			return
 		}
 		ic.allUsersMapping = make(map[string]string)
-		var users []iam.User
-		var err error
-		if ic.accountLevel {
-			users, err = ic.accountClient.Users.ListAll(ic.Context, iam.ListAccountUsersRequest{
-				Attributes: "id,userName",
-			})
-		} else {
-			users, err = ic.workspaceClient.Users.ListAll(ic.Context, iam.ListUsersRequest{
-				Attributes: "id,userName",
-			})
-		}
+		it := scim.NewUsersAPI(ic.Context, ic.Client).List("", "id,userName,active", "")
+		users, err := it.All()
 		if err != nil {
-			log.Printf("[ERROR] can't fetch list of users")
+			log.Printf("[ERROR] can't fetch list of users starting from index %d", it.StartIndex)
 			return
 		}
 		for _, user := range users {
 			// log.Printf("[DEBUG] adding user %v into the map. %d out of %d", user, i+1, len(users))
-			ic.allUsersMapping[user.UserName] = user.Id
+			ic.allUsersMapping[user.UserName] = user.ID
+			if !user.Active {
+				ic.markInactiveUserOrSp(user.UserName)
+			}
 		}
 		log.Printf("[DEBUG] users are copied")
 	}
@@ -490,24 +1385,17 @@ func (ic *importContext) getSpsMapping() {
 	defer ic.spsMutex.Unlock()
 	if ic.allSpsMapping == nil {
 		ic.allSpsMapping = make(map[string]string)
-		var sps []iam.ServicePrincipal
-		var err error
-		// Reimplement it myself
-		if ic.accountLevel {
-			sps, err = ic.accountClient.ServicePrincipals.ListAll(ic.Context, iam.ListAccountServicePrincipalsRequest{
-				Attributes: "id,userName",
-			})
-		} else {
-			sps, err = ic.workspaceClient.ServicePrincipals.ListAll(ic.Context, iam.ListServicePrincipalsRequest{
-				Attributes: "id,userName",
-			})
-		}
+		it := scim.NewServicePrincipalsAPI(ic.Context, ic.Client).List("", "id,userName,active", "")
+		sps, err := it.All()
 		if err != nil {
-			log.Printf("[ERROR] can't fetch list of service principals")
+			log.Printf("[ERROR] can't fetch list of service principals starting from index %d", it.StartIndex)
 			return
 		}
 		for _, sp := range sps {
-			ic.allSpsMapping[sp.ApplicationId] = sp.Id
+			ic.allSpsMapping[sp.ApplicationID] = sp.ID
+			if !sp.Active {
+				ic.markInactiveUserOrSp(sp.ApplicationID)
+			}
 		}
 	}
 }
@@ -663,9 +1551,19 @@ func (ic *importContext) refreshMounts() error {
 	if ic.mountMap != nil {
 		return nil
 	}
+	if ic.mountsNoCluster {
+		return ic.refreshMountsWithoutCluster()
+	}
 	commandAPI := ic.Client.CommandExecutor(ic.Context)
 	clustersAPI := clusters.NewClustersAPI(ic.Context, ic.Client)
-	cluster, err := clustersAPI.GetOrCreateRunningCluster("terraform-mount")
+	var cluster clusters.ClusterInfo
+	var err error
+	if clusterID := ic.Client.CommandExecutorConfig().ClusterID; clusterID != "" {
+		// designated cluster is used instead of creating a new one
+		cluster, err = clustersAPI.StartAndGetInfo(clusterID)
+	} else {
+		cluster, err = clustersAPI.GetOrCreateRunningCluster("terraform-mount")
+	}
 	if err != nil {
 		return err
 	}
@@ -706,6 +1604,93 @@ func (ic *importContext) refreshMounts() error {
 	return nil
 }
 
+// refreshMountsWithoutCluster finds mount points through the DBFS API. Their sources can be read only on a cluster,
+// so they are taken from the Terraform state of the previous export, together with cluster IDs & instance profiles.
+// Without that state, all mount points are ignored, because cluster specs aren't used to find their sources.
+func (ic *importContext) refreshMountsWithoutCluster() error {
+	known, err := loadMountsFromState(fmt.Sprintf("%s/terraform.tfstate", ic.Directory))
+	if err != nil {
+		return err
+	}
+	files, err := storage.NewDbfsAPI(ic.Context, ic.Client).List("/mnt", false)
+	if apierr.IsMissing(err) || errors.Is(err, apierr.ErrNotFound) {
+		log.Printf("[INFO] There is no /mnt directory, so there are no mounts")
+		files, err = nil, nil
+	}
+	if err != nil {
+		return err
+	}
+	ic.mountMap = map[string]mount{}
+	for _, f := range files {
+		if !f.IsDir {
+			continue
+		}
+		name := strings.TrimSuffix(strings.TrimPrefix(f.Path, "/mnt/"), "/")
+		m, ok := known[name]
+		if !ok {
+			log.Printf("[WARN] Can't find source of the mount %s in the Terraform state", name)
+			ic.addIgnoredResource(ignoredResource{Resource: "databricks_mount", Attribute: "name", Value: name,
+				Reason: ignoreReasonMountNoSource})
+			continue
+		}
+		ic.mountMap[name] = m
+	}
+	log.Printf("[INFO] Found %d mounts without a cluster", len(ic.mountMap))
+	return nil
+}
+
+type mountsState struct {
+	Resources []struct {
+		Mode      string `json:"mode"`
+		Type      string `json:"type"`
+		Instances []struct {
+			Attributes struct {
+				ID        string `json:"id"`
+				Source    string `json:"source"`
+				ClusterID string `json:"cluster_id"`
+				S3        []struct {
+					InstanceProfile string `json:"instance_profile"`
+				} `json:"s3"`
+			} `json:"attributes"`
+		} `json:"instances"`
+	} `json:"resources"`
+}
+
+// loadMountsFromState reads databricks_mount resources from the Terraform state file, if it exists
+func loadMountsFromState(fileName string) (map[string]mount, error) {
+	mounts := map[string]mount{}
+	content, err := os.ReadFile(fileName)
+	if os.IsNotExist(err) {
+		log.Printf("[WARN] There is no %s file, sources of mounts are unknown", fileName)
+		return mounts, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var state mountsState
+	if err = json.Unmarshal(content, &state); err != nil {
+		return nil, fmt.Errorf("can't parse %s: %w", fileName, err)
+	}
+	for _, r := range state.Resources {
+		if r.Mode != "managed" || r.Type != "databricks_mount" {
+			continue
+		}
+		for _, instance := range r.Instances {
+			attrs := instance.Attributes
+			if attrs.ID == "" || attrs.Source == "" {
+				continue
+			}
+			m := mount{URL: attrs.Source, ClusterID: attrs.ClusterID}
+			if len(attrs.S3) > 0 && attrs.S3[0].InstanceProfile != "" {
+				m.InstanceProfile = attrs.S3[0].InstanceProfile
+				m.ClusterID = ""
+			}
+			mounts[attrs.ID] = m
+		}
+	}
+	return mounts, nil
+}
+
 func (ic *importContext) addAwsMounts(arn string, profileMountMap map[string]string) {
 	i := 0
 	for k, v := range profileMountMap {
@@ -782,8 +1767,16 @@ func eitherString(a any, b any) string {
 func (ic *importContext) importJobs(l []jobs.Job) {
 	i := 0
 	for offset, job := range l {
-		if !ic.MatchesName(job.Settings.Name) {
-			log.Printf("[INFO] Job name %s doesn't match selection %s", job.Settings.Name, ic.match)
+		if !ic.MatchesName("databricks_job", job.Settings.Name) {
+			log.Printf("[INFO] Job name %s doesn't match selection", job.Settings.Name)
+			continue
+		}
+		if !ic.MatchesOwner(job.CreatorUserName, job.RunAsUserName) {
+			log.Printf("[INFO] Job %s isn't created by or running as any of -owner", job.Settings.Name)
+			continue
+		}
+		if isBundleJob(job) && ic.skipBundleManaged(true, "databricks_job", "job_id", job.ID(),
+			job.Settings.Deployment.MetadataFilePath) {
 			continue
 		}
 		ic.Emit(&resource{
@@ -801,6 +1794,20 @@ func (ic *importContext) createFile(name string, content []byte) (string, error)
 	return ic.createFileIn("files", name, content)
 }
 
+// layouts of local files for notebooks & workspace files, specified by -notebooks-layout
+const (
+	// normalized workspace paths with object IDs, so names are always unique and safe
+	notebooksLayoutFlat = "flat"
+	// workspace paths as is, mirroring the structure of the workspace
+	notebooksLayoutWorkspace = "workspace"
+)
+
+// workspacePathFileName returns the local name for the workspace path, replacing only characters that
+// aren't allowed in file names
+func workspacePathFileName(workspacePath string) string {
+	return unsafeFileNameRegex.ReplaceAllString(strings.TrimPrefix(workspacePath, "/"), "_")
+}
+
 func (ic *importContext) createFileIn(dir, name string, content []byte) (string, error) {
 	fileName := ic.prefix + name
 	localFileName := fmt.Sprintf("%s/%s/%s", ic.Directory, dir, fileName)
@@ -882,6 +1889,100 @@ func resourceOrDataBlockBody(ic *importContext, body *hclwrite.Body, r *resource
 		[]string{}, ic.Resources[r.Resource], r.Data, resourceBlock.Body())
 }
 
+// formatGeneratedFiles formats all .tf files in the output directory like `terraform fmt` does, so the
+// export doesn't depend on the Terraform binary
+func (ic *importContext) formatGeneratedFiles() error {
+	files, err := filepath.Glob(filepath.Join(ic.Directory, "*.tf"))
+	if err != nil {
+		return err
+	}
+	for _, fileName := range files {
+		content, err := os.ReadFile(fileName)
+		if err != nil {
+			return err
+		}
+		// parsing catches syntax errors, that `hclwrite.Format` silently keeps in the output
+		if _, diags := hclwrite.ParseConfig(content, fileName, hcl.Pos{Line: 1, Column: 1}); diags.HasErrors() {
+			return fmt.Errorf("can't parse %s: %s", fileName, diags.Error())
+		}
+		formatted := []byte(ic.regexFix(string(hclwrite.Format(content)), ic.hclFixes))
+		if bytes.Equal(content, formatted) {
+			continue
+		}
+		if err = os.WriteFile(fileName, formatted, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// aclsFilePrefix is the prefix of files with ACLs of objects of the given service, i.e. `acls_jobs.tf`
+const aclsFilePrefix = "acls_"
+
+// aclResourceTypes are written into separate files with `-separate-acls`, so they could be reviewed together
+var aclResourceTypes = map[string]bool{
+	"databricks_permissions": true,
+	"databricks_grants":      true,
+	"databricks_secret_acl":  true,
+}
+
+// aclServices maps prefixes of IDs of permissions and grants to services of secured objects
+var aclServices = map[string]string{
+	"/instance-pools/":    "pools",
+	"/clusters/":          "compute",
+	"/jobs/":              "jobs",
+	"/cluster-policies/":  "policies",
+	"/repos/":             "repos",
+	"/notebooks/":         "notebooks",
+	"/files/":             "notebooks",
+	"/directories/":       "directories",
+	"/queries/":           "sql-queries",
+	"/sql/warehouses/":    "sql-endpoints",
+	"/dbsql-dashboards/":  "sql-dashboards",
+	"/alerts/":            "sql-alerts",
+	"/dashboards/":        "dashboards",
+	"/pipelines/":         "dlt",
+	"/serving-endpoints/": "model-serving",
+	"/experiments/":       "mlflow",
+	"/registered-models/": "mlflow",
+	"catalog/":            "uc-catalogs",
+	"schema/":             "uc-catalogs",
+	"table/":              "uc-catalogs",
+	"volume/":             "uc-volumes",
+	"model/":              "uc-models",
+	"external_location/":  "uc-storage",
+	"storage_credential/": "uc-storage",
+}
+
+// aclService returns the service of the object secured by the ACL resource, or the given default
+func aclService(id, defaultService string) string {
+	for prefix, service := range aclServices {
+		if strings.HasPrefix(id, prefix) {
+			return service
+		}
+	}
+	return defaultService
+}
+
+// generateDataSourceBody refers to the object outside of the export scope with the data block, that looks
+// it up by attributes of the object instead of hard-coding its ID
+func generateDataSourceBody(ir importable, body *hclwrite.Body, r *resource) {
+	dataBlock := body.AppendNewBlock("data", []string{ir.DataSource.typeOf(r.Resource), r.Name}).Body()
+	attributes := maps.Keys(ir.DataSource.Attributes)
+	sort.Strings(attributes)
+	for _, attribute := range attributes {
+		value := r.Data.Get(ir.DataSource.Attributes[attribute])
+		dataBlock.SetAttributeValue(attribute, cty.StringVal(fmt.Sprint(value)))
+	}
+}
+
+func (ds *dataSource) typeOf(resourceType string) string {
+	if ds.Type != "" {
+		return ds.Type
+	}
+	return resourceType
+}
+
 func generateUniqueID(v string) string {
 	return fmt.Sprintf("%x", sha1.Sum([]byte(v)))[:10]
 }
@@ -911,24 +2012,90 @@ func wsObjectGetModifiedAt(obs workspace.ObjectStatus) int64 {
 	return obs.ModifiedAt
 }
 
-func (ic *importContext) shouldEmitForPath(path string) bool {
-	if !ic.exportDeletedUsersAssets && strings.HasPrefix(path, "/Users/") {
-		return ic.IsUserOrServicePrincipalDirectory(path, "/Users", false)
+func (ic *importContext) markInactiveUserOrSp(userOrSpName string) {
+	ic.inactiveUsersOrSpsMutex.Lock()
+	defer ic.inactiveUsersOrSpsMutex.Unlock()
+	if ic.inactiveUsersOrSps == nil {
+		ic.inactiveUsersOrSps = map[string]struct{}{}
 	}
-	return true
+	ic.inactiveUsersOrSps[strings.ToLower(userOrSpName)] = struct{}{}
 }
 
-func (ic *importContext) maybeEmitWorkspaceObject(resourceType, path string) {
-	if ic.shouldEmitForPath(path) {
-		ic.Emit(&resource{
-			Resource:    resourceType,
-			ID:          path,
-			Incremental: ic.incremental,
-		})
+func (ic *importContext) isInactiveUserOrSp(userOrSpName string) bool {
+	ic.inactiveUsersOrSpsMutex.RLock()
+	defer ic.inactiveUsersOrSpsMutex.RUnlock()
+	_, inactive := ic.inactiveUsersOrSps[strings.ToLower(userOrSpName)]
+	return inactive
+}
+
+// ownerSkipReason returns the reason for skipping assets of the deleted or deactivated user or service principal,
+// or an empty string if assets should be exported
+func (ic *importContext) ownerSkipReason(userOrSpName string) string {
+	if ic.exportDeletedUsersAssets || userOrSpName == "" {
+		return ""
+	}
+	var err error
+	if common.StringIsUUID(userOrSpName) {
+		_, err = ic.findSpnByAppID(userOrSpName, true)
 	} else {
-		log.Printf("[WARN] Not emitting a workspace object %s for deleted user. Path='%s'", resourceType, path)
-		ic.addIgnoredResource(fmt.Sprintf("%s. path=%s", resourceType, path))
+		_, err = ic.findUserByName(strings.ToLower(userOrSpName), true)
+	}
+	if err != nil {
+		return ignoreReasonDeletedUser
+	}
+	if ic.isInactiveUserOrSp(userOrSpName) {
+		return ignoreReasonInactiveUser
+	}
+	return ""
+}
+
+// pathSkipReason returns the reason for skipping objects in home directories of deleted or deactivated users
+// and service principals, or an empty string if objects should be exported
+func (ic *importContext) pathSkipReason(path string) string {
+	if ic.exportDeletedUsersAssets || !strings.HasPrefix(path, "/Users/") {
+		return ""
+	}
+	if !ic.IsUserOrServicePrincipalDirectory(path, "/Users", false) {
+		return ignoreReasonDeletedUser
 	}
+	userOrSpName, _ := getUserOrSpNameAndDirectory(path, "/Users")
+	if ic.isInactiveUserOrSp(userOrSpName) {
+		return ignoreReasonInactiveUser
+	}
+	return ""
+}
+
+func (ic *importContext) maybeEmitWorkspaceObject(resourceType, path string) {
+	if reason := ic.pathSkipReason(path); reason != "" {
+		log.Printf("[WARN] Not emitting a workspace object %s that %s. Path='%s'", resourceType, reason, path)
+		ic.addIgnoredResource(ignoredResource{Resource: resourceType, Attribute: "path", Value: path,
+			Reason: reason})
+		return
+	}
+	ic.Emit(&resource{
+		Resource:    resourceType,
+		ID:          path,
+		Incremental: ic.incremental,
+	})
+}
+
+// dbsqlObjectOwner returns the email of the user who owns the query or dashboard from the list response
+func dbsqlObjectOwner(q map[string]any) string {
+	user, ok := q["user"].(map[string]any)
+	if !ok {
+		return ""
+	}
+	email, _ := user["email"].(string)
+	return email
+}
+
+// repoOwner returns the user name or application ID from the path of repo in the `/Repos/<owner>/<name>` form
+func repoOwner(path string) string {
+	parts := strings.Split(path, "/")
+	if len(parts) < 3 || parts[1] != "Repos" {
+		return ""
+	}
+	return parts[2]
 }
 
 func (ic *importContext) enableServices(services string) {
@@ -967,7 +2134,11 @@ func (ic *importContext) shouldSkipWorkspaceObject(object workspace.ObjectStatus
 			object.Path, modifiedAt, updatedSinceMs)
 		return true
 	}
-	if !ic.MatchesName(object.Path) {
+	resourceType := "databricks_notebook"
+	if object.ObjectType == workspace.File {
+		resourceType = "databricks_workspace_file"
+	}
+	if !ic.MatchesName(resourceType, object.Path) {
 		return true
 	}
 	return false
@@ -1050,6 +2221,51 @@ func (ic *importContext) getLastActiveMs() int64 {
 	return ic.lastActiveMs
 }
 
+// isInactive returns true if the last activity (in milliseconds) is known and is older than `-last-active-days`
+func (ic *importContext) isInactive(lastActivityMs int64) bool {
+	return lastActivityMs > 0 && lastActivityMs < ic.getLastActiveMs()
+}
+
+// pipelineLastActivityMs returns the creation time of the latest update of DLT pipeline, or 0 if it's unknown.
+// Pipelines that are running now, like continuous ones with an old latest update, are active at the current time
+func pipelineLastActivityMs(p pipelines.PipelineStateInfo) (lastActivityMs int64) {
+	if p.State != nil {
+		switch *p.State {
+		case pipelines.StateIdle, pipelines.StateFailed, pipelines.StateDeleted:
+		default:
+			return time.Now().UnixMilli()
+		}
+	}
+	for _, update := range p.LatestUpdates {
+		created, err := time.Parse(time.RFC3339, update.CreationTime)
+		if err != nil {
+			continue
+		}
+		lastActivityMs = max(lastActivityMs, created.UnixMilli())
+	}
+	return
+}
+
+// isWarehouseInactive checks the query history of the stopped SQL warehouse for queries since `-last-active-days`.
+// It's done only when `-last-active-days` is changed from the default, as it requires an API call per warehouse
+func (ic *importContext) isWarehouseInactive(warehouse sql.EndpointInfo) bool {
+	if ic.lastActiveDays <= 0 || ic.lastActiveDays >= defaultLastActiveDays || warehouse.State != sql.StateStopped {
+		return false
+	}
+	queries, err := ic.workspaceClient.QueryHistory.ListAll(ic.Context, sql.ListQueryHistoryRequest{
+		FilterBy: &sql.QueryFilter{
+			QueryStartTimeRange: &sql.TimeRange{StartTimeMs: int(ic.getLastActiveMs())},
+			WarehouseIds:        []string{warehouse.Id},
+		},
+		MaxResults: 1,
+	})
+	if err != nil {
+		log.Printf("[WARN] can't get query history of SQL warehouse %s: %v", warehouse.Name, err)
+		return false
+	}
+	return len(queries) == 0
+}
+
 func (ic *importContext) getUpdatedSinceStr() string {
 	return ic.updatedSinceStr
 }
@@ -1205,3 +2421,51 @@ func runWithRetries[ERR any](runFunc func() ERR, msg string) ERR {
 	}
 	return err
 }
+
+// isDefaultWorkspaceConf checks if the value of workspace configuration key, as returned by API, is the documented default
+func isDefaultWorkspaceConf(defaults map[string]any, key, value string) bool {
+	defaultValue, ok := defaults[key]
+	if !ok {
+		return false
+	}
+	return strings.EqualFold(fmt.Sprintf("%v", defaultValue), value)
+}
+
+// tokenLifetimeSeconds returns lifetime of a token, or zero for tokens that don't expire
+func tokenLifetimeSeconds(creationTime, expiryTime int64) int64 {
+	if expiryTime <= 0 || expiryTime <= creationTime {
+		return 0
+	}
+	return (expiryTime - creationTime) / 1000
+}
+
+func tokenName(ic *importContext, d *schema.ResourceData) string {
+	comment := d.Get("comment").(string)
+	if comment == "" {
+		return d.Id()
+	}
+	return comment + "_" + d.Id()
+}
+
+// generateOboTokenBody references the service principal of the token when it's exported, and generates
+// a variable for its application ID otherwise, so principals could be mapped in the target workspace
+func generateOboTokenBody(ic *importContext, body *hclwrite.Body, r *resource) error {
+	ir := ic.Importables[r.Resource]
+	resourceBlock := body.AppendNewBlock("resource", []string{r.Resource, r.Name})
+	err := ic.dataToHcl(ir, []string{}, ic.Resources[r.Resource], r.Data, resourceBlock.Body())
+	if err != nil {
+		return err
+	}
+	applicationID := r.Data.Get("application_id").(string)
+	if ic.State.Get("databricks_service_principal", "application_id", applicationID) == nil {
+		resourceBlock.Body().SetAttributeRaw("application_id", ic.variable("application_id_"+r.Name,
+			fmt.Sprintf("Application ID of the service principal, for which the %s token is created", r.Name)))
+	}
+	return nil
+}
+
+// schemaFullName returns ID of `databricks_schema` referenced by the `schema_name` field of UC objects,
+// as schema names are unique only within a catalog
+func schemaFullName(d *schema.ResourceData, schemaName string) string {
+	return d.Get("catalog_name").(string) + "." + schemaName
+}
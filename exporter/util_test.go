@@ -3,13 +3,18 @@ package exporter
 import (
 	"context"
 	"os"
+	"regexp"
 	"testing"
+	"time"
 
+	"github.com/databricks/databricks-sdk-go/apierr"
 	"github.com/databricks/databricks-sdk-go/service/iam"
 	"github.com/databricks/terraform-provider-databricks/clusters"
 	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/pipelines"
 	"github.com/databricks/terraform-provider-databricks/qa"
 	"github.com/databricks/terraform-provider-databricks/scim"
+	"github.com/databricks/terraform-provider-databricks/storage"
 	"github.com/databricks/terraform-provider-databricks/workspace"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -41,15 +46,114 @@ func TestAddAwsMounts(t *testing.T) {
 	assert.Equal(t, 2, len(ic.mountMap))
 }
 
+func TestRefreshMountsWithoutCluster(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/dbfs/list?path=%2Fmnt",
+			Response: storage.FileList{
+				Files: []storage.FileInfo{
+					{Path: "/mnt/data", IsDir: true},
+					{Path: "/mnt/logs", IsDir: true},
+					{Path: "/mnt/new", IsDir: true},
+					{Path: "/mnt/readme.txt"},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTestWithClient(ctx, client)
+		ic.Directory = t.TempDir()
+		ic.mountsNoCluster = true
+		err := os.WriteFile(ic.Directory+"/terraform.tfstate", []byte(`{"resources": [
+			{"mode": "managed", "type": "databricks_mount", "instances": [
+				{"attributes": {"id": "data", "source": "s3a://bucket", "cluster_id": "abc",
+					"s3": [{"bucket_name": "bucket", "instance_profile": "arn:aws:iam::123:instance-profile/a"}]}},
+				{"attributes": {"id": "logs", "source": "abfss://logs@account.dfs.core.windows.net/",
+					"cluster_id": "abc", "s3": []}},
+				{"attributes": {"id": "removed", "source": "gs://bucket"}}
+			]},
+			{"mode": "data", "type": "databricks_mount", "instances": [{"attributes": {"id": "x", "source": "y"}}]}
+		]}`), 0644)
+		require.NoError(t, err)
+
+		require.NoError(t, ic.refreshMounts())
+		assert.Equal(t, map[string]mount{
+			"data": {URL: "s3a://bucket", InstanceProfile: "arn:aws:iam::123:instance-profile/a"},
+			"logs": {URL: "abfss://logs@account.dfs.core.windows.net/", ClusterID: "abc"},
+		}, ic.mountMap)
+		assert.Equal(t, ignoreReasonMountNoSource, ic.ignoredResources["databricks_mount. name=new"].Reason)
+	})
+}
+
+func TestRefreshMountsWithoutClusterNoMntDirectory(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/dbfs/list?path=%2Fmnt",
+			Status:   404,
+			Response: apierr.APIErrorBody{
+				ErrorCode: "RESOURCE_DOES_NOT_EXIST",
+				Message:   "No file or directory exists on path /mnt.",
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTestWithClient(ctx, client)
+		ic.Directory = t.TempDir()
+		ic.mountsNoCluster = true
+
+		require.NoError(t, ic.refreshMounts())
+		assert.Len(t, ic.mountMap, 0)
+	})
+}
+
+func TestRefreshMountsOnDesignatedCluster(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/clusters/get?cluster_id=designated",
+			Response: clusters.ClusterInfo{
+				ClusterID: "designated",
+				State:     clusters.ClusterStateRunning,
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/instance-profiles/list",
+			Response: map[string]any{},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		client.WithCommandMock(func(commandStr string) common.CommandResults {
+			return common.CommandResults{
+				ResultType: "text",
+				Data:       `{"data": "s3a://bucket"}`,
+			}
+		})
+		client.WithCommandExecutorConfig(common.CommandExecutorConfig{ClusterID: "designated"})
+		ic := importContextForTestWithClient(ctx, client)
+
+		require.NoError(t, ic.refreshMounts())
+		assert.Equal(t, map[string]mount{
+			"data": {URL: "s3a://bucket", ClusterID: "designated"},
+		}, ic.mountMap)
+	})
+}
+
+func TestLoadMountsFromMissingState(t *testing.T) {
+	mounts, err := loadMountsFromState(t.TempDir() + "/terraform.tfstate")
+	assert.NoError(t, err)
+	assert.Len(t, mounts, 0)
+}
+
 var (
 	userListIdUsernameFixture = qa.HTTPFixture{
 		Method:   "GET",
-		Resource: "/api/2.0/preview/scim/v2/Users?attributes=id%2CuserName&count=100&startIndex=1",
+		Resource: "/api/2.0/preview/scim/v2/Users?attributes=id%2CuserName%2Cactive&count=100&startIndex=1",
 		Response: iam.ListUsersResponse{
 			Resources: []iam.User{
 				{
 					Id:       "id",
 					UserName: "user@domain.com",
+					Active:   true,
 				},
 			},
 			TotalResults: 1,
@@ -59,7 +163,7 @@ var (
 	}
 	userListIdUsernameFixture2 = qa.HTTPFixture{
 		Method:   "GET",
-		Resource: "/api/2.0/preview/scim/v2/Users?attributes=id%2CuserName&count=100&startIndex=2",
+		Resource: "/api/2.0/preview/scim/v2/Users?attributes=id%2CuserName%2Cactive&count=100&startIndex=2",
 		Response: iam.ListUsersResponse{
 			Resources:    []iam.User{},
 			TotalResults: 1,
@@ -85,12 +189,13 @@ var (
 	}
 	spListIdUsernameFixture = qa.HTTPFixture{
 		Method:   "GET",
-		Resource: "/api/2.0/preview/scim/v2/ServicePrincipals?attributes=id%2CuserName&count=100&startIndex=1",
+		Resource: "/api/2.0/preview/scim/v2/ServicePrincipals?attributes=id%2CuserName%2Cactive&count=100&startIndex=1",
 		Response: iam.ListServicePrincipalResponse{
 			Resources: []iam.ServicePrincipal{
 				{
 					Id:            "id",
 					ApplicationId: "21aab5a7-ee70-4385-34d4-a77278be5cb6",
+					Active:        true,
 				},
 			},
 			TotalResults: 1,
@@ -99,7 +204,7 @@ var (
 	}
 	spListIdUsernameFixture2 = qa.HTTPFixture{
 		Method:   "GET",
-		Resource: "/api/2.0/preview/scim/v2/ServicePrincipals?attributes=id%2CuserName&count=100&startIndex=2",
+		Resource: "/api/2.0/preview/scim/v2/ServicePrincipals?attributes=id%2CuserName%2Cactive&count=100&startIndex=2",
 		Response: iam.ListServicePrincipalResponse{
 			Resources:    []iam.ServicePrincipal{},
 			TotalResults: 1,
@@ -108,7 +213,7 @@ var (
 	}
 	spListFixture = qa.HTTPFixture{
 		Method:   "GET",
-		Resource: "/api/2.0/preview/scim/v2/ServicePrincipals?attributes=id%2CuserName&startIndex=1",
+		Resource: "/api/2.0/preview/scim/v2/ServicePrincipals?attributes=id%2CuserName%2Cactive&startIndex=1",
 		Response: scim.UserList{
 			Resources: []scim.User{
 				{
@@ -163,7 +268,7 @@ func TestEmitUserError(t *testing.T) {
 	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
 		{
 			Method:   "GET",
-			Resource: "/api/2.0/preview/scim/v2/Users?attributes=id%2CuserName&count=100&startIndex=1",
+			Resource: "/api/2.0/preview/scim/v2/Users?attributes=id%2CuserName%2Cactive&count=100&startIndex=1",
 			Response: iam.ListUsersResponse{
 				Resources: []iam.User{},
 			},
@@ -180,7 +285,7 @@ func TestEmitUserServiceNotEnabled(t *testing.T) {
 	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
 		{
 			Method:   "GET",
-			Resource: "/api/2.0/preview/scim/v2/Users?attributes=id%2CuserName&count=100&startIndex=1",
+			Resource: "/api/2.0/preview/scim/v2/Users?attributes=id%2CuserName%2Cactive&count=100&startIndex=1",
 			Response: iam.ListUsersResponse{
 				Resources: []iam.User{},
 			},
@@ -246,6 +351,62 @@ func TestEmitNotebookOrRepo(t *testing.T) {
 	})
 }
 
+func TestSkipAssetsOfInactiveUsers(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/Users?attributes=id%2CuserName%2Cactive&count=100&startIndex=1",
+			Response: iam.ListUsersResponse{
+				Resources: []iam.User{
+					{Id: "1", UserName: "active@domain.com", Active: true},
+					{Id: "2", UserName: "inactive@domain.com"},
+				},
+				TotalResults: 2,
+				StartIndex:   1,
+			},
+			ReuseRequest: true,
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/Users?attributes=id%2CuserName%2Cactive&count=100&startIndex=3",
+			Response: iam.ListUsersResponse{
+				TotalResults: 2,
+				StartIndex:   3,
+			},
+			ReuseRequest: true,
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTestWithClient(ctx, client)
+		ic.enableServices("notebooks")
+		ic.maybeEmitWorkspaceObject("databricks_notebook", "/Users/inactive@domain.com/abc")
+		ic.maybeEmitWorkspaceObject("databricks_notebook", "/Users/deleted@domain.com/abc")
+		ic.maybeEmitWorkspaceObject("databricks_notebook", "/Users/active@domain.com/abc")
+		assert.Len(t, ic.testEmits, 1)
+		assert.True(t, ic.testEmits["databricks_notebook[<unknown>] (id: /Users/active@domain.com/abc)"])
+		assert.Equal(t, ignoreReasonInactiveUser,
+			ic.ignoredResources["databricks_notebook. path=/Users/inactive@domain.com/abc"].Reason)
+		assert.Equal(t, ignoreReasonDeletedUser,
+			ic.ignoredResources["databricks_notebook. path=/Users/deleted@domain.com/abc"].Reason)
+
+		assert.Equal(t, ignoreReasonInactiveUser, ic.ownerSkipReason("inactive@domain.com"))
+		assert.Equal(t, ignoreReasonDeletedUser, ic.ownerSkipReason("deleted@domain.com"))
+		assert.Equal(t, "", ic.ownerSkipReason("active@domain.com"))
+		assert.Equal(t, "", ic.ownerSkipReason(""))
+
+		ic.exportDeletedUsersAssets = true
+		assert.Equal(t, "", ic.ownerSkipReason("inactive@domain.com"))
+		ic.maybeEmitWorkspaceObject("databricks_notebook", "/Users/inactive@domain.com/abc")
+		assert.True(t, ic.testEmits["databricks_notebook[<unknown>] (id: /Users/inactive@domain.com/abc)"])
+	})
+}
+
+func TestDbsqlObjectOwner(t *testing.T) {
+	assert.Equal(t, "user@domain.com", dbsqlObjectOwner(map[string]any{
+		"user": map[string]any{"email": "user@domain.com"},
+	}))
+	assert.Equal(t, "", dbsqlObjectOwner(map[string]any{}))
+}
+
 func TestIsUserOrServicePrincipalDirectory(t *testing.T) {
 	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
 		userListIdUsernameFixture,
@@ -390,3 +551,145 @@ func TestParallelListing(t *testing.T) {
 	require.Equal(t, 4, len(objects))
 
 }
+
+func TestExcludeRegex(t *testing.T) {
+	ic := importContextForTest()
+	ic.excludeRegexes = map[string][]*regexp.Regexp{
+		"databricks_notebook": {regexp.MustCompile("^/Users/")},
+		"jobs":                {regexp.MustCompile("^tmp_")},
+	}
+	// excluded by ID before reading the object
+	ic.waitGroup.Add(1)
+	(&resource{Resource: "databricks_notebook", ID: "/Users/user@example.com/abc"}).ImportResource(ic)
+
+	// excluded by name after reading the object
+	d := ic.Resources["databricks_job"].TestResourceData()
+	d.SetId("123")
+	d.Set("name", "tmp_experiment")
+	ic.waitGroup.Add(1)
+	(&resource{Resource: "databricks_job", ID: "123", Data: d}).ImportResource(ic)
+
+	assert.Equal(t, 0, ic.Scope.Len())
+	assert.Equal(t, map[string]ignoredResource{
+		"databricks_notebook. id=/Users/user@example.com/abc": {Resource: "databricks_notebook", Attribute: "id",
+			Value: "/Users/user@example.com/abc", Reason: ignoreReasonExcluded, Message: "/Users/user@example.com/abc"},
+		"databricks_job. id=123": {Resource: "databricks_job", Attribute: "id", Value: "123",
+			Reason: ignoreReasonExcluded, Message: "tmp_experiment"},
+	}, ic.ignoredResources)
+
+	d.Set("name", "etl")
+	assert.False(t, ic.isExcluded(ic.Importables["databricks_job"], &resource{Resource: "databricks_job", ID: "123"},
+		excludableAttributes(d)...))
+	assert.False(t, ic.isExcluded(ic.Importables["databricks_notebook"],
+		&resource{Resource: "databricks_notebook", ID: "/Shared/abc"}, "/Shared/abc"))
+}
+
+func TestOptOutTag(t *testing.T) {
+	ic := importContextForTest()
+	importWithData := func(resourceType, id string, attrs map[string]any) {
+		d := ic.Resources[resourceType].TestResourceData()
+		d.SetId(id)
+		for k, v := range attrs {
+			require.NoError(t, d.Set(k, v))
+		}
+		ic.waitGroup.Add(1)
+		(&resource{Resource: resourceType, ID: id, Data: d}).ImportResource(ic)
+	}
+	importWithData("databricks_job", "1", map[string]any{"tags": map[string]any{"tf-exporter": "SKIP"}})
+	importWithData("databricks_job", "2", map[string]any{"description": "Experiment, tf-exporter:skip"})
+	importWithData("databricks_cluster", "3", map[string]any{"custom_tags": map[string]any{"tf-exporter": "skip"}})
+	importWithData("databricks_pipeline", "4", map[string]any{"configuration": map[string]any{"tf-exporter": "skip"}})
+	importWithData("databricks_sql_endpoint", "5", map[string]any{"tags": []any{map[string]any{
+		"custom_tags": []any{map[string]any{"key": "tf-exporter", "value": "skip"}}}}})
+
+	assert.Equal(t, 0, ic.Scope.Len())
+	assert.Len(t, ic.ignoredResources, 5)
+	assert.Equal(t, ignoreReasonOptedOut, ic.ignoredResources["databricks_sql_endpoint. id=5"].Reason)
+
+	assert.False(t, hasOptOutTag(map[string]any{"tf-exporter": "keep"}))
+	assert.False(t, hasOptOutTag([]any{map[string]any{"key": "team", "value": "skip"}}))
+	assert.False(t, hasOptOutTag(nil))
+}
+
+func TestFormatGeneratedFiles(t *testing.T) {
+	ic := importContextForTest()
+	ic.Directory = t.TempDir()
+	err := os.WriteFile(ic.Directory+"/jobs.tf", []byte(`resource "databricks_job" "a" {
+name = "a"
+  max_concurrent_runs     = 1
+}
+`), 0644)
+	require.NoError(t, err)
+	err = os.WriteFile(ic.Directory+"/README.md", []byte("name   = 1\n"), 0644)
+	require.NoError(t, err)
+
+	require.NoError(t, ic.formatGeneratedFiles())
+	content, err := os.ReadFile(ic.Directory + "/jobs.tf")
+	require.NoError(t, err)
+	assert.Equal(t, `resource "databricks_job" "a" {
+  name                = "a"
+  max_concurrent_runs = 1
+}
+`, string(content))
+	content, err = os.ReadFile(ic.Directory + "/README.md")
+	require.NoError(t, err)
+	assert.Equal(t, "name   = 1\n", string(content))
+
+	err = os.WriteFile(ic.Directory+"/broken.tf", []byte(`resource "databricks_job" "a" {`), 0644)
+	require.NoError(t, err)
+	assert.ErrorContains(t, ic.formatGeneratedFiles(), "can't parse "+ic.Directory+"/broken.tf")
+}
+
+func TestRepoOwner(t *testing.T) {
+	assert.Equal(t, "user@domain.com", repoOwner("/Repos/user@domain.com/repo"))
+	assert.Equal(t, "", repoOwner("/Workspace/Shared/repo"))
+	assert.Equal(t, "", repoOwner("/Repos"))
+}
+
+func TestIsInactive(t *testing.T) {
+	ic := importContextForTest()
+	ic.lastActiveDays = 30
+	assert.True(t, ic.isInactive(1))
+	assert.False(t, ic.isInactive(0))
+	assert.False(t, ic.isInactive(time.Now().UnixMilli()))
+}
+
+func TestPipelineLastActivityMs(t *testing.T) {
+	assert.Equal(t, int64(0), pipelineLastActivityMs(pipelines.PipelineStateInfo{}))
+	assert.Equal(t, int64(1700000000000), pipelineLastActivityMs(pipelines.PipelineStateInfo{
+		LatestUpdates: []pipelines.PipelineUpdateStateInfo{
+			{CreationTime: "2023-11-14T22:13:20Z"},
+			{CreationTime: "2023-11-01T00:00:00Z"},
+			{CreationTime: "invalid"},
+		},
+	}))
+	idle, running := pipelines.StateIdle, pipelines.StateRunning
+	assert.Equal(t, int64(1700000000000), pipelineLastActivityMs(pipelines.PipelineStateInfo{
+		State:         &idle,
+		LatestUpdates: []pipelines.PipelineUpdateStateInfo{{CreationTime: "2023-11-14T22:13:20Z"}},
+	}))
+	// continuous pipeline that is running since its old update
+	ic := importContextForTest()
+	ic.lastActiveDays = 30
+	assert.False(t, ic.isInactive(pipelineLastActivityMs(pipelines.PipelineStateInfo{
+		State:         &running,
+		LatestUpdates: []pipelines.PipelineUpdateStateInfo{{CreationTime: "2023-11-14T22:13:20Z"}},
+	})))
+}
+
+func TestSecretStore(t *testing.T) {
+	ic := importContextForTest()
+	assert.Equal(t, "", ic.secretStore("prod-kv"))
+	ic.secretStoreStubs = true
+	assert.Equal(t, "azurerm_key_vault_secret", ic.secretStore("prod-kv"))
+	assert.Equal(t, "azurerm_key_vault_secret", ic.secretStore("KeyVault_team"))
+	assert.Equal(t, "aws_secretsmanager_secret_version", ic.secretStore("aws.prod"))
+	assert.Equal(t, "aws_secretsmanager_secret_version", ic.secretStore("team-secrets-manager"))
+	assert.Equal(t, "", ic.secretStore("kvstore"))
+	assert.Equal(t, "", ic.secretStore("prod"))
+}
+
+func TestWorkspacePathFileName(t *testing.T) {
+	assert.Equal(t, "Users/user@example.com/My notebook", workspacePathFileName("/Users/user@example.com/My notebook"))
+	assert.Equal(t, "Shared/a_b_c_.py", workspacePathFileName("/Shared/a:b?c*.py"))
+}
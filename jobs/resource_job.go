@@ -2,6 +2,7 @@ package jobs
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
@@ -302,6 +303,37 @@ func (js *JobSettings) sortWebhooksByID() {
 	sortWebhookNotifications(js.WebhookNotifications)
 }
 
+// emptyToNil returns nil for empty structures, that are sent back by the Jobs API even if they weren't specified
+func emptyToNil[T any](v *T) *T {
+	if v == nil {
+		return nil
+	}
+	raw, err := json.Marshal(v)
+	if err != nil || string(raw) != "{}" {
+		return v
+	}
+	return nil
+}
+
+// removeServerDefaults cleans up the settings returned by the API, so that imported jobs don't have noisy diffs
+func (js *JobSettings) removeServerDefaults() {
+	js.EmailNotifications = emptyToNil(js.EmailNotifications)
+	js.WebhookNotifications = emptyToNil(js.WebhookNotifications)
+	js.NotificationSettings = emptyToNil(js.NotificationSettings)
+	if js.Health != nil && len(js.Health.Rules) == 0 {
+		js.Health = nil
+	}
+	for i := range js.Tasks {
+		task := &js.Tasks[i]
+		task.EmailNotifications = emptyToNil(task.EmailNotifications)
+		task.WebhookNotifications = emptyToNil(task.WebhookNotifications)
+		task.NotificationSettings = emptyToNil(task.NotificationSettings)
+		if task.Health != nil && len(task.Health.Rules) == 0 {
+			task.Health = nil
+		}
+	}
+}
+
 // JobListResponse returns a list of all jobs
 type JobListResponse struct {
 	Jobs          []Job  `json:"jobs"`
@@ -562,6 +594,7 @@ func (a JobsAPI) Read(id string) (job Job, err error) {
 	if job.Settings != nil {
 		job.Settings.adjustTasks()
 		job.Settings.sortWebhooksByID()
+		job.Settings.removeServerDefaults()
 	}
 
 	// Populate the `run_as` field. In the settings struct it can only be set on write and is not
@@ -655,6 +688,12 @@ var jobSchema = common.StructToSchema(JobSettings{},
 		if p, err := common.SchemaPath(s, "schedule", "pause_status"); err == nil {
 			p.ValidateFunc = validation.StringInSlice([]string{"PAUSED", "UNPAUSED"}, false)
 		}
+		if p, err := common.SchemaPath(s, "schedule", "quartz_cron_expression"); err == nil {
+			p.ValidateFunc = common.ValidateQuartzCronExpression
+		}
+		if p, err := common.SchemaPath(s, "schedule", "timezone_id"); err == nil {
+			p.ValidateFunc = common.ValidateTimezoneID
+		}
 		if p, err := common.SchemaPath(s, "trigger", "pause_status"); err == nil {
 			p.ValidateFunc = validation.StringInSlice([]string{"PAUSED", "UNPAUSED"}, false)
 		}
@@ -903,6 +942,18 @@ func ResourceJob() common.Resource {
 			if err != nil {
 				return err
 			}
+			if ctx.Value(common.Api) != common.API_2_1 && job.Settings != nil && job.Settings.isMultiTask() {
+				// imported multi-task jobs have no tasks in the state yet, so they have to be read with Jobs API 2.1
+				job, err = NewJobsAPI(context.WithValue(ctx, common.Api, common.API_2_1), c).Read(d.Id())
+				if err != nil {
+					return err
+				}
+			}
+			// attributes that exist only in Terraform are explicitly stored with their default values,
+			// otherwise imported jobs would have a diff for them
+			for _, k := range []string{"always_running", "control_run_state"} {
+				d.Set(k, d.Get(k))
+			}
 			d.Set("url", c.FormatURL("#job/", d.Id()))
 			return common.StructToData(*job.Settings, jobSchema, d)
 		},
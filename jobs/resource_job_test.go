@@ -1851,6 +1851,91 @@ func TestResourceJobRead(t *testing.T) {
 	assert.Equal(t, "abc", d.Get("existing_cluster_id"))
 }
 
+func TestResourceJobImport_MultiTask(t *testing.T) {
+	response := `{
+		"job_id": 789,
+		"creator_user_name": "user@example.com",
+		"run_as_user_name": "user@example.com",
+		"settings": {
+			"name": "Featurizer",
+			"email_notifications": {},
+			"webhook_notifications": {},
+			"notification_settings": {},
+			"timeout_seconds": 0,
+			"max_concurrent_runs": 1,
+			"format": "MULTI_TASK",
+			"tasks": [
+				{
+					"task_key": "b",
+					"depends_on": [{"task_key": "a"}],
+					"run_if": "ALL_SUCCESS",
+					"notebook_task": {"notebook_path": "/Shared/b", "source": "WORKSPACE"},
+					"existing_cluster_id": "abc",
+					"timeout_seconds": 0,
+					"email_notifications": {},
+					"webhook_notifications": {},
+					"notification_settings": {}
+				},
+				{
+					"task_key": "a",
+					"run_if": "ALL_SUCCESS",
+					"spark_jar_task": {"main_class_name": "com.labs.BarMain", "run_as_repl": true},
+					"existing_cluster_id": "abc",
+					"timeout_seconds": 0,
+					"email_notifications": {},
+					"webhook_notifications": {"on_start": []},
+					"health": {"rules": []}
+				}
+			]
+		}
+	}`
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/get?job_id=789",
+				Response: Job{
+					JobID: 789,
+					Settings: &JobSettings{
+						Name:   "Featurizer",
+						Format: "MULTI_TASK",
+					},
+				},
+				ReuseRequest: true,
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.1/jobs/get?job_id=789",
+				Response:     response,
+				ReuseRequest: true,
+			},
+		},
+		Resource: ResourceJob(),
+		ID:       "789",
+		HCL: `
+		name = "Featurizer"
+
+		task {
+			task_key = "a"
+			existing_cluster_id = "abc"
+			spark_jar_task {
+				main_class_name = "com.labs.BarMain"
+			}
+		}
+
+		task {
+			task_key = "b"
+			existing_cluster_id = "abc"
+			depends_on {
+				task_key = "a"
+			}
+			notebook_task {
+				notebook_path = "/Shared/b"
+			}
+		}`,
+	}.ExpectNoDiffAfterImport(t)
+}
+
 func TestResourceJobRead_NotFound(t *testing.T) {
 	qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -2651,3 +2736,16 @@ func TestJobResource_SparkConfDiffSuppress(t *testing.T) {
 	assert.True(t, scs.DiffSuppressFunc("new_cluster.0.spark_conf.%", "1", "0", nil))
 	assert.False(t, scs.DiffSuppressFunc("new_cluster.0.spark_conf.%", "1", "1", nil))
 }
+
+func TestResourceJobCreate_InvalidSchedule(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `schedule {
+			quartz_cron_expression = "0 0 12 * *"
+			timezone_id = "Europe/Amsterdm"
+		}`,
+	}.ExpectError(t, "invalid config supplied. "+
+		"[schedule.#.quartz_cron_expression] schedule.0.quartz_cron_expression: expected 6 or 7 fields in Quartz cron expression, got 5: 0 0 12 * *. "+
+		"[schedule.#.timezone_id] schedule.0.timezone_id: unknown time zone ID 'Europe/Amsterdm'")
+}
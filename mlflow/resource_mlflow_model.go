@@ -2,12 +2,61 @@ package mlflow
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
 
+	"github.com/databricks/databricks-sdk-go/apierr"
 	"github.com/databricks/databricks-sdk-go/service/ml"
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// ucTarget describes the equivalent of the workspace model in Unity Catalog. The UC model itself and copies of
+// versions are managed outside of this resource, i.e. with databricks_registered_model
+type ucTarget struct {
+	CatalogName string `json:"catalog_name"`
+	SchemaName  string `json:"schema_name"`
+	Name        string `json:"name,omitempty"`
+}
+
+var ucModelNameRegex = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// ucRegisteredModelName returns the full name of the UC registered model for the workspace model, with
+// the name of the workspace model normalized to the valid UC identifier if the name isn't specified
+func ucRegisteredModelName(modelName string, uc ucTarget) string {
+	name := uc.Name
+	if name == "" {
+		name = ucModelNameRegex.ReplaceAllString(strings.ToLower(modelName), "_")
+	}
+	return fmt.Sprintf("%s.%s.%s", uc.CatalogName, uc.SchemaName, name)
+}
+
+func getUcTarget(d interface{ GetOk(string) (any, bool) }) (ucTarget, bool) {
+	v, ok := d.GetOk("uc_target.0")
+	if !ok {
+		return ucTarget{}, false
+	}
+	m := v.(map[string]any)
+	return ucTarget{
+		CatalogName: m["catalog_name"].(string),
+		SchemaName:  m["schema_name"].(string),
+		Name:        m["name"].(string),
+	}, true
+}
+
+// legacyRegistryError explains failures of workspaces where the Workspace Model Registry is disabled
+func legacyRegistryError(err error) error {
+	var apiErr *apierr.APIError
+	if errors.As(err, &apiErr) && (apiErr.ErrorCode == "FEATURE_DISABLED" ||
+		strings.Contains(apiErr.Message, "Workspace Model Registry is disabled")) {
+		return fmt.Errorf("workspace model registry is disabled in this workspace, use databricks_registered_model "+
+			"in Unity Catalog instead: %w", err)
+	}
+	return err
+}
+
 func ResourceMlflowModel() common.Resource {
 	s := common.StructToSchema(
 		ml.CreateModelRequest{},
@@ -17,10 +66,32 @@ func ResourceMlflowModel() common.Resource {
 				Computed: true,
 				Type:     schema.TypeString,
 			}
+			s["uc_target"] = &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: common.StructToSchema(ucTarget{}, common.NoCustomize),
+				},
+			}
+			s["uc_registered_model_name"] = &schema.Schema{
+				Computed: true,
+				Type:     schema.TypeString,
+			}
 			return s
 		})
 
 	return common.Resource{
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff) error {
+			ucName := ""
+			if uc, ok := getUcTarget(d); ok {
+				ucName = ucRegisteredModelName(d.Get("name").(string), uc)
+			}
+			if d.Get("uc_registered_model_name").(string) != ucName {
+				return d.SetNew("uc_registered_model_name", ucName)
+			}
+			return nil
+		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
 			if err != nil {
@@ -30,7 +101,7 @@ func ResourceMlflowModel() common.Resource {
 			common.DataToStructPointer(d, s, &req)
 			res, err := w.ModelRegistry.CreateModel(ctx, req)
 			if err != nil {
-				return err
+				return legacyRegistryError(err)
 			}
 			d.SetId(res.RegisteredModel.Name)
 			return nil
@@ -45,13 +116,16 @@ func ResourceMlflowModel() common.Resource {
 			req.Name = d.Id()
 			res, err := w.ModelRegistry.GetModel(ctx, req)
 			if err != nil {
-				return err
+				return legacyRegistryError(err)
 			}
 			err = common.StructToData(res.RegisteredModelDatabricks, s, d)
 			if err != nil {
 				return err
 			}
 			d.Set("registered_model_id", res.RegisteredModelDatabricks.Id) // alias
+			if uc, ok := getUcTarget(d); ok {
+				d.Set("uc_registered_model_name", ucRegisteredModelName(d.Id(), uc))
+			}
 			return nil
 		},
 		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
@@ -61,7 +135,8 @@ func ResourceMlflowModel() common.Resource {
 			}
 			var req ml.UpdateModelRequest
 			common.DataToStructPointer(d, s, &req)
-			return w.ModelRegistry.UpdateModel(ctx, req)
+			err = w.ModelRegistry.UpdateModel(ctx, req)
+			return legacyRegistryError(err)
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
@@ -71,7 +146,7 @@ func ResourceMlflowModel() common.Resource {
 			var req ml.DeleteModelRequest
 			common.DataToStructPointer(d, s, &req)
 			req.Name = d.Id()
-			return w.ModelRegistry.DeleteModel(ctx, req)
+			return legacyRegistryError(w.ModelRegistry.DeleteModel(ctx, req))
 		},
 		Schema: s,
 	}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/databricks/databricks-sdk-go/apierr"
 	"github.com/databricks/databricks-sdk-go/service/ml"
 	"github.com/databricks/terraform-provider-databricks/qa"
 	"github.com/stretchr/testify/assert"
@@ -54,6 +55,78 @@ func TestModelCreateMVP(t *testing.T) {
 	}.ApplyAndExpectData(t, map[string]any{"id": "xyz", "registered_model_id": "123"})
 }
 
+func TestUcRegisteredModelName(t *testing.T) {
+	assert.Equal(t, "main.ml.my_churn_model_v2", ucRegisteredModelName("My Churn-Model v2",
+		ucTarget{CatalogName: "main", SchemaName: "ml"}))
+	assert.Equal(t, "main.ml.churn", ucRegisteredModelName("My Churn-Model v2",
+		ucTarget{CatalogName: "main", SchemaName: "ml", Name: "churn"}))
+}
+
+func TestModelCreateUcTarget(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/mlflow/registered-models/create",
+				ExpectedRequest: ml.CreateModelRequest{
+					Name:        "Churn Model",
+					Description: "predicts churn",
+				},
+				Response: ml.CreateModelResponse{
+					RegisteredModel: &ml.Model{
+						Name: "Churn Model",
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/mlflow/databricks/registered-models/get?name=Churn+Model",
+				Response: ml.GetModelResponse{
+					RegisteredModelDatabricks: &ml.ModelDatabricks{
+						Name:        "Churn Model",
+						Description: "predicts churn",
+						Id:          "123",
+					},
+				},
+				ReuseRequest: true,
+			},
+		},
+		Resource: ResourceMlflowModel(),
+		Create:   true,
+		HCL: `
+		name = "Churn Model"
+		description = "predicts churn"
+		uc_target {
+			catalog_name = "main"
+			schema_name = "ml"
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                       "Churn Model",
+		"uc_registered_model_name": "main.ml.churn_model",
+	})
+}
+
+func TestModelReadLegacyRegistryDisabled(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/mlflow/databricks/registered-models/get?name=xyz",
+				Status:   400,
+				Response: apierr.APIErrorBody{
+					ErrorCode: "FEATURE_DISABLED",
+					Message:   "Workspace Model Registry is disabled.",
+				},
+			},
+		},
+		Resource: ResourceMlflowModel(),
+		Read:     true,
+		ID:       "xyz",
+	}.ExpectError(t, "workspace model registry is disabled in this workspace, use databricks_registered_model "+
+		"in Unity Catalog instead: Workspace Model Registry is disabled.")
+}
+
 func TestModelCreateWithTags(t *testing.T) {
 	model := m()
 	d, err := qa.ResourceFixture{
@@ -0,0 +1,92 @@
+package mws
+
+import (
+	"context"
+
+	"github.com/databricks/databricks-sdk-go/service/billing"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Budget extends the API struct with the read-only fields of the budget
+type Budget struct {
+	billing.Budget
+
+	BudgetID     string `json:"budget_id,omitempty" tf:"computed"`
+	CreationTime string `json:"creation_time,omitempty" tf:"computed"`
+	UpdateTime   string `json:"update_time,omitempty" tf:"computed"`
+}
+
+func budgetFromStatus(b billing.BudgetWithStatus) Budget {
+	return Budget{
+		Budget: billing.Budget{
+			Alerts:       b.Alerts,
+			EndDate:      b.EndDate,
+			Filter:       b.Filter,
+			Name:         b.Name,
+			Period:       b.Period,
+			StartDate:    b.StartDate,
+			TargetAmount: b.TargetAmount,
+		},
+		BudgetID:     b.BudgetId,
+		CreationTime: b.CreationTime,
+		UpdateTime:   b.UpdateTime,
+	}
+}
+
+// ResourceBudget manages budgets of the account, together with their alerts
+func ResourceBudget() common.Resource {
+	s := common.StructToSchema(Budget{}, func(m map[string]*schema.Schema) map[string]*schema.Schema {
+		common.CustomizeSchemaPath(m, "alerts", "min_percentage").SetRequired()
+		return m
+	})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			acc, err := c.AccountClient()
+			if err != nil {
+				return err
+			}
+			var b Budget
+			common.DataToStructPointer(d, s, &b)
+			res, err := acc.Budgets.Create(ctx, billing.WrappedBudget{
+				Budget: b.Budget,
+			})
+			if err != nil {
+				return err
+			}
+			d.SetId(res.Budget.BudgetId)
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			acc, err := c.AccountClient()
+			if err != nil {
+				return err
+			}
+			res, err := acc.Budgets.GetByBudgetId(ctx, d.Id())
+			if err != nil {
+				return err
+			}
+			return common.StructToData(budgetFromStatus(res.Budget), s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			acc, err := c.AccountClient()
+			if err != nil {
+				return err
+			}
+			var b Budget
+			common.DataToStructPointer(d, s, &b)
+			return acc.Budgets.Update(ctx, billing.WrappedBudget{
+				Budget:   b.Budget,
+				BudgetId: d.Id(),
+			})
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			acc, err := c.AccountClient()
+			if err != nil {
+				return err
+			}
+			return acc.Budgets.DeleteByBudgetId(ctx, d.Id())
+		},
+	}
+}
@@ -0,0 +1,133 @@
+package mws
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/billing"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/mock"
+)
+
+var testBudget = billing.Budget{
+	Name:         "team",
+	Filter:       "tag.tagName = 'team'",
+	Period:       "1 month",
+	StartDate:    "2024-01-01",
+	TargetAmount: "100",
+	Alerts: []billing.BudgetAlert{
+		{
+			EmailNotifications: []string{"me@example.com"},
+			MinPercentage:      50,
+		},
+	},
+}
+
+var testBudgetWithStatus = billing.BudgetWithStatus{
+	BudgetId:     "abc",
+	Name:         "team",
+	Filter:       "tag.tagName = 'team'",
+	Period:       "1 month",
+	StartDate:    "2024-01-01",
+	TargetAmount: "100",
+	Alerts: []billing.BudgetAlert{
+		{
+			EmailNotifications: []string{"me@example.com"},
+			MinPercentage:      50,
+		},
+	},
+	CreationTime: "2024-01-01T00:00:00Z",
+}
+
+const testBudgetHCL = `
+name = "team"
+filter = "tag.tagName = 'team'"
+period = "1 month"
+start_date = "2024-01-01"
+target_amount = "100"
+alerts {
+	email_notifications = ["me@example.com"]
+	min_percentage = 50
+}`
+
+func TestResourceBudgetCreate(t *testing.T) {
+	qa.ResourceFixture{
+		MockAccountClientFunc: func(a *mocks.MockAccountClient) {
+			e := a.GetMockBudgetsAPI().EXPECT()
+			e.Create(mock.Anything, billing.WrappedBudget{
+				Budget: testBudget,
+			}).Return(&billing.WrappedBudgetWithStatus{
+				Budget: billing.BudgetWithStatus{BudgetId: "abc"},
+			}, nil)
+			e.GetByBudgetId(mock.Anything, "abc").Return(&billing.WrappedBudgetWithStatus{
+				Budget: testBudgetWithStatus,
+			}, nil)
+		},
+		Resource:  ResourceBudget(),
+		AccountID: "abc",
+		Create:    true,
+		HCL:       testBudgetHCL,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":            "abc",
+		"budget_id":     "abc",
+		"target_amount": "100",
+		"creation_time": "2024-01-01T00:00:00Z",
+	})
+}
+
+func TestResourceBudgetRead_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		MockAccountClientFunc: func(a *mocks.MockAccountClient) {
+			a.GetMockBudgetsAPI().EXPECT().GetByBudgetId(mock.Anything, "abc").Return(nil, &apierr.APIError{
+				ErrorCode:  "NOT_FOUND",
+				StatusCode: 404,
+				Message:    "Budget not found",
+			})
+		},
+		Resource:  ResourceBudget(),
+		AccountID: "abc",
+		Read:      true,
+		Removed:   true,
+		ID:        "abc",
+	}.ApplyNoError(t)
+}
+
+func TestResourceBudgetUpdate(t *testing.T) {
+	qa.ResourceFixture{
+		MockAccountClientFunc: func(a *mocks.MockAccountClient) {
+			e := a.GetMockBudgetsAPI().EXPECT()
+			e.Update(mock.Anything, billing.WrappedBudget{
+				Budget:   testBudget,
+				BudgetId: "abc",
+			}).Return(nil)
+			e.GetByBudgetId(mock.Anything, "abc").Return(&billing.WrappedBudgetWithStatus{
+				Budget: testBudgetWithStatus,
+			}, nil)
+		},
+		Resource:  ResourceBudget(),
+		AccountID: "abc",
+		Update:    true,
+		ID:        "abc",
+		InstanceState: map[string]string{
+			"name":          "team",
+			"filter":        "tag.tagName = 'team'",
+			"period":        "1 month",
+			"start_date":    "2024-01-01",
+			"target_amount": "50",
+		},
+		HCL: testBudgetHCL,
+	}.ApplyNoError(t)
+}
+
+func TestResourceBudgetDelete(t *testing.T) {
+	qa.ResourceFixture{
+		MockAccountClientFunc: func(a *mocks.MockAccountClient) {
+			a.GetMockBudgetsAPI().EXPECT().DeleteByBudgetId(mock.Anything, "abc").Return(nil)
+		},
+		Resource:  ResourceBudget(),
+		AccountID: "abc",
+		Delete:    true,
+		ID:        "abc",
+	}.ApplyNoError(t)
+}
@@ -97,12 +97,10 @@ func ResourceMwsPermissionAssignment() common.Resource {
 	}
 	s := common.StructToSchema(entity{},
 		common.NoCustomize)
-	pair := common.NewPairID("workspace_id", "principal_id").Schema(
-		func(m map[string]*schema.Schema) map[string]*schema.Schema {
-			return s
-		})
+	assignmentID := common.NewCompositeID("|", "workspace_id", "principal_id")
 	return common.Resource{
-		Schema: s,
+		Schema:      s,
+		CompositeID: assignmentID,
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			var assignment entity
 			common.DataToStructPointer(d, s, &assignment)
@@ -112,30 +110,30 @@ func ResourceMwsPermissionAssignment() common.Resource {
 			if err != nil {
 				return err
 			}
-			pair.Pack(d)
+			assignmentID.PackData(d)
 			return nil
 		},
 		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
-			workspaceId, principalId, err := pair.Unpack(d)
+			parts, err := assignmentID.UnpackData(d, s)
 			if err != nil {
 				return fmt.Errorf("parse id: %w", err)
 			}
-			list, err := NewPermissionAssignmentAPI(ctx, c).List(mustInt64(workspaceId))
+			list, err := NewPermissionAssignmentAPI(ctx, c).List(mustInt64(parts[0]))
 			if err != nil {
 				return err
 			}
-			permissions, err := list.ForPrincipal(mustInt64(principalId))
+			permissions, err := list.ForPrincipal(mustInt64(parts[1]))
 			if err != nil {
 				return err
 			}
 			return common.StructToData(permissions, s, d)
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
-			workspaceId, principalId, err := pair.Unpack(d)
+			parts, err := assignmentID.Unpack(d.Id())
 			if err != nil {
 				return fmt.Errorf("parse id: %w", err)
 			}
-			return NewPermissionAssignmentAPI(ctx, c).Remove(workspaceId, principalId)
+			return NewPermissionAssignmentAPI(ctx, c).Remove(parts[0], parts[1])
 		},
 	}
 }
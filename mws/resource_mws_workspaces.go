@@ -190,27 +190,25 @@ func generateWorkspaceHostname(client *common.DatabricksClient, ws Workspace) st
 	return strings.Join(chunks, ".")
 }
 
-func (a WorkspacesAPI) verifyWorkspaceReachable(ws Workspace) *resource.RetryError {
+// verifyWorkspaceReachable returns the reason why the workspace isn't yet reachable, or an empty string
+func (a WorkspacesAPI) verifyWorkspaceReachable(ws Workspace) (string, error) {
 	ctx, cancel := context.WithTimeout(a.context, 10*time.Second)
 	defer cancel()
 	// wait for DNS caches to refresh, as sometimes we cannot make
 	// API calls to new workspaces immediately after it's created
 	wsClient, err := a.client.ClientForHost(a.context, ws.WorkspaceURL)
 	if err != nil {
-		return resource.NonRetryableError(err)
+		return "", err
 	}
 	// make a request to SCIM API, just to verify there are no errors
 	var response map[string]any
 	err = wsClient.Get(ctx, "/preview/scim/v2/Me", nil, &response)
 	var dnsError *net.DNSError
 	if errors.As(err, &dnsError) {
-		err = fmt.Errorf("workspace %s is not yet reachable: %s",
-			ws.WorkspaceURL, dnsError)
-		log.Printf("[INFO] %s", err)
 		// expected to retry on: dial tcp: lookup XXX: no such host
-		return resource.RetryableError(err)
+		return fmt.Sprintf("workspace %s is not yet reachable: %s", ws.WorkspaceURL, dnsError), nil
 	}
-	return nil
+	return "", nil
 }
 
 func (a WorkspacesAPI) explainWorkspaceFailure(ws Workspace) error {
@@ -232,10 +230,13 @@ func (a WorkspacesAPI) explainWorkspaceFailure(ws Workspace) error {
 
 // WaitForRunning will wait until workspace is running, otherwise will try to explain why it failed
 func (a WorkspacesAPI) WaitForRunning(ws Workspace, timeout time.Duration) error {
-	return resource.RetryContext(a.context, timeout, func() *resource.RetryError {
+	return common.WaitConfig{
+		Name:    fmt.Sprintf("workspace %d to be running", ws.WorkspaceID),
+		Timeout: timeout,
+	}.Poll(a.context, func() (bool, string, error) {
 		workspace, err := a.Read(ws.AccountID, fmt.Sprintf("%d", ws.WorkspaceID))
 		if err != nil {
-			return resource.NonRetryableError(err)
+			return false, "", err
 		}
 		switch workspace.WorkspaceStatus {
 		case WorkspaceStatusRunning:
@@ -243,17 +244,16 @@ func (a WorkspacesAPI) WaitForRunning(ws Workspace, timeout time.Duration) error
 			if strings.Contains(ws.DeploymentName, "900150983cd24fb0") {
 				// nobody would probably name workspace as 900150983cd24fb0,
 				// so we'll use it as unit testing shim
-				return nil
+				return true, "", nil
 			}
-			return a.verifyWorkspaceReachable(workspace)
+			status, err := a.verifyWorkspaceReachable(workspace)
+			return status == "", status, err
 		case WorkspaceStatusCanceled, WorkspaceStatusFailed:
 			log.Printf("[ERROR] Cannot start workspace: %s", workspace.WorkspaceStatusMessage)
-			err = a.explainWorkspaceFailure(workspace)
-			return resource.NonRetryableError(err)
+			return false, "", a.explainWorkspaceFailure(workspace)
 		default:
-			log.Printf("[INFO] Workspace %s is %s: %s", workspace.DeploymentName,
-				workspace.WorkspaceStatus, workspace.WorkspaceStatusMessage)
-			return resource.RetryableError(fmt.Errorf(workspace.WorkspaceStatusMessage))
+			return false, fmt.Sprintf("workspace %s is %s: %s", workspace.DeploymentName,
+				workspace.WorkspaceStatus, workspace.WorkspaceStatusMessage), nil
 		}
 	})
 }
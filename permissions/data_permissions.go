@@ -0,0 +1,103 @@
+package permissions
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// EffectiveAccessControl describes a single permission of a principal, either direct or inherited
+type EffectiveAccessControl struct {
+	UserName             string   `json:"user_name,omitempty"`
+	GroupName            string   `json:"group_name,omitempty"`
+	ServicePrincipalName string   `json:"service_principal_name,omitempty"`
+	PermissionLevel      string   `json:"permission_level"`
+	Inherited            bool     `json:"inherited,omitempty"`
+	InheritedFromObject  []string `json:"inherited_from_object,omitempty"`
+}
+
+type permissionsData struct {
+	ObjectType        string                   `json:"object_type,omitempty" tf:"computed"`
+	AccessControlList []EffectiveAccessControl `json:"access_control,omitempty" tf:"computed"`
+}
+
+// effectiveAccessControls returns all permissions of the object, including inherited permissions and
+// permissions of admins, that can't be managed by the databricks_permissions resource
+func (oa ObjectACL) effectiveAccessControls() []EffectiveAccessControl {
+	result := []EffectiveAccessControl{}
+	for _, ac := range oa.AccessControlList {
+		if len(ac.AllPermissions) == 0 && ac.PermissionLevel != "" {
+			result = append(result, EffectiveAccessControl{
+				UserName:             ac.UserName,
+				GroupName:            ac.GroupName,
+				ServicePrincipalName: ac.ServicePrincipalName,
+				PermissionLevel:      ac.PermissionLevel,
+			})
+		}
+		for _, permission := range ac.AllPermissions {
+			result = append(result, EffectiveAccessControl{
+				UserName:             ac.UserName,
+				GroupName:            ac.GroupName,
+				ServicePrincipalName: ac.ServicePrincipalName,
+				PermissionLevel:      permission.PermissionLevel,
+				Inherited:            permission.Inherited,
+				InheritedFromObject:  permission.InheritedFromObject,
+			})
+		}
+	}
+	return result
+}
+
+// DataSourcePermissions returns the current access control list of any object supported by databricks_permissions
+func DataSourcePermissions() common.Resource {
+	fields := []string{}
+	for _, mapping := range permissionsResourceIDFields() {
+		if !stringInSlice(mapping.field, fields) {
+			fields = append(fields, mapping.field)
+		}
+	}
+	sort.Strings(fields)
+	s := common.StructToSchema(permissionsData{}, func(s map[string]*schema.Schema) map[string]*schema.Schema {
+		for _, field := range fields {
+			s[field] = &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: fields,
+			}
+		}
+		return s
+	})
+	return common.Resource{
+		Schema: s,
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			for _, mapping := range permissionsResourceIDFields() {
+				v, ok := d.GetOk(mapping.field)
+				if !ok {
+					continue
+				}
+				id, err := mapping.idRetriever(ctx, w, v.(string))
+				if err != nil {
+					return err
+				}
+				objectID := fmt.Sprintf("/%s/%s", mapping.resourceType, id)
+				objectACL, err := NewPermissionsAPI(ctx, c).Read(objectID)
+				if err != nil {
+					return err
+				}
+				d.SetId(objectID)
+				return common.StructToData(permissionsData{
+					ObjectType:        objectACL.ObjectType,
+					AccessControlList: objectACL.effectiveAccessControls(),
+				}, s, d)
+			}
+			return fmt.Errorf("at least one type of resource identifiers must be set")
+		},
+	}
+}
@@ -0,0 +1,122 @@
+package permissions
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourcePermissions(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/clusters/abc",
+				Response: ObjectACL{
+					ObjectID:   "/clusters/abc",
+					ObjectType: "cluster",
+					AccessControlList: []AccessControl{
+						{
+							UserName: TestingUser,
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_RESTART",
+								},
+							},
+						},
+						{
+							GroupName: "admins",
+							AllPermissions: []Permission{
+								{
+									PermissionLevel:     "CAN_MANAGE",
+									Inherited:           true,
+									InheritedFromObject: []string{"/clusters/"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource:    DataSourcePermissions(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL:         `cluster_id = "abc"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                                       "/clusters/abc",
+		"object_type":                              "cluster",
+		"access_control.#":                         2,
+		"access_control.0.user_name":               TestingUser,
+		"access_control.0.permission_level":        "CAN_RESTART",
+		"access_control.0.inherited":               false,
+		"access_control.1.group_name":              "admins",
+		"access_control.1.permission_level":        "CAN_MANAGE",
+		"access_control.1.inherited":               true,
+		"access_control.1.inherited_from_object.0": "/clusters/",
+	})
+}
+
+func TestDataSourcePermissions_SqlObject(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/queries/q1",
+				Response: ObjectACL{
+					ObjectID:   "queries/q1",
+					ObjectType: "query",
+					AccessControlList: []AccessControl{
+						{
+							UserName:        TestingOwner,
+							PermissionLevel: "IS_OWNER",
+						},
+					},
+				},
+			},
+		},
+		Resource:    DataSourcePermissions(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL:         `sql_query_id = "q1"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"object_type":                       "query",
+		"access_control.0.user_name":        TestingOwner,
+		"access_control.0.permission_level": "IS_OWNER",
+	})
+}
+
+func TestDataSourcePermissions_NoIdentifier(t *testing.T) {
+	_, err := qa.ResourceFixture{
+		Resource:    DataSourcePermissions(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL:         `object_type = "cluster"`,
+	}.Apply(t)
+	assert.Error(t, err)
+}
+
+func TestDataSourcePermissions_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/jobs/123",
+				Response: map[string]any{
+					"error_code": "NOT_FOUND",
+					"message":    "Job 123 does not exist",
+				},
+				Status: 404,
+			},
+		},
+		Resource:    DataSourcePermissions(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL:         `job_id = "123"`,
+	}.ExpectError(t, "Job 123 does not exist")
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 	"path"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -88,6 +90,10 @@ type AccessControlChange struct {
 	PermissionLevel      string `json:"permission_level"`
 }
 
+func (acc AccessControlChange) principal() string {
+	return fmt.Sprintf("%s%s%s", acc.UserName, acc.GroupName, acc.ServicePrincipalName)
+}
+
 func (acc AccessControlChange) String() string {
 	return fmt.Sprintf("%v%v%v %s", acc.UserName, acc.GroupName, acc.ServicePrincipalName,
 		acc.PermissionLevel)
@@ -107,16 +113,27 @@ type PermissionsAPI struct {
 	context context.Context
 }
 
-func isDbsqlPermissionsWorkaroundNecessary(objectID string) bool {
-	return strings.HasPrefix(objectID, "/sql/") && !strings.HasPrefix(objectID, "/sql/warehouses")
+// legacySqlObjectPrefixes maps identifiers of SQL objects in the legacy preview permissions API
+// to identifiers of the same objects in the consolidated permissions API
+var legacySqlObjectPrefixes = map[string]string{
+	"/sql/dashboards/": "/dbsql-dashboards/",
+	"/sql/queries/":    "/queries/",
+	"/sql/alerts/":     "/alerts/",
 }
 
-func urlPathForObjectID(objectID string) string {
-	if isDbsqlPermissionsWorkaroundNecessary(objectID) {
-		// Permissions for SQLA entities are routed differently from the others.
-		return "/preview/sql/permissions" + objectID[4:]
+// migrateLegacyObjectID converts identifiers of SQL objects, that were created before the migration
+// to the consolidated permissions API, to the new format
+func migrateLegacyObjectID(objectID string) string {
+	for legacy, current := range legacySqlObjectPrefixes {
+		if strings.HasPrefix(objectID, legacy) {
+			return current + strings.TrimPrefix(objectID, legacy)
+		}
 	}
-	return "/permissions" + objectID
+	return objectID
+}
+
+func urlPathForObjectID(objectID string) string {
+	return "/permissions" + migrateLegacyObjectID(objectID)
 }
 
 // As described in https://github.com/databricks/terraform-provider-databricks/issues/1504,
@@ -124,12 +141,14 @@ func urlPathForObjectID(objectID string) string {
 // permissions when POSTing permissions changes through the REST API, to avoid accidentally
 // revoking the calling user's ability to manage the current object.
 func (a PermissionsAPI) shouldExplicitlyGrantCallingUserManagePermissions(objectID string) bool {
-	for _, prefix := range [...]string{"/registered-models/", "/clusters/", "/instance-pools/", "/serving-endpoints/", "/queries/", "/sql/warehouses"} {
+	objectID = migrateLegacyObjectID(objectID)
+	for _, prefix := range [...]string{"/registered-models/", "/clusters/", "/instance-pools/", "/serving-endpoints/",
+		"/queries/", "/alerts/", "/dbsql-dashboards/", "/sql/warehouses"} {
 		if strings.HasPrefix(objectID, prefix) {
 			return true
 		}
 	}
-	return isDbsqlPermissionsWorkaroundNecessary(objectID)
+	return false
 }
 
 func (a PermissionsAPI) ensureCurrentUserCanManageObject(objectID string, objectACL AccessControlChangeList) (AccessControlChangeList, error) {
@@ -159,13 +178,45 @@ func (a PermissionsAPI) put(objectID string, objectACL AccessControlChangeList)
 	if err != nil {
 		return err
 	}
-	if isDbsqlPermissionsWorkaroundNecessary(objectID) {
-		// SQLA entities use POST for permission updates.
-		return a.client.Post(a.context, urlPathForObjectID(objectID), objectACL, nil)
-	}
 	return a.client.Put(a.context, urlPathForObjectID(objectID), objectACL)
 }
 
+// splitOwner separates IS_OWNER entry from the rest of access control list
+func splitOwner(objectACL AccessControlChangeList) (owner *AccessControlChange, rest AccessControlChangeList) {
+	rest.AccessControlList = []AccessControlChange{}
+	for _, v := range objectACL.AccessControlList {
+		if v.PermissionLevel == "IS_OWNER" {
+			owner = &AccessControlChange{
+				UserName:             v.UserName,
+				ServicePrincipalName: v.ServicePrincipalName,
+				PermissionLevel:      v.PermissionLevel,
+			}
+			continue
+		}
+		rest.AccessControlList = append(rest.AccessControlList, v)
+	}
+	return
+}
+
+// TransferOwnership grants IS_OWNER to the new owner while keeping CAN_MANAGE for the previous owner,
+// so that the entry of the previous owner could be safely removed by the subsequent update.
+func (a PermissionsAPI) TransferOwnership(objectID string, previousOwner AccessControlChange,
+	objectACL AccessControlChangeList) error {
+	interim := AccessControlChangeList{}
+	for _, v := range objectACL.AccessControlList {
+		if v.principal() == previousOwner.principal() {
+			continue
+		}
+		interim.AccessControlList = append(interim.AccessControlList, v)
+	}
+	interim.AccessControlList = append(interim.AccessControlList, AccessControlChange{
+		UserName:             previousOwner.UserName,
+		ServicePrincipalName: previousOwner.ServicePrincipalName,
+		PermissionLevel:      "CAN_MANAGE",
+	})
+	return a.Update(objectID, interim)
+}
+
 // Update updates object permissions. Technically, it's using method named SetOrDelete, but here we do more
 func (a PermissionsAPI) Update(objectID string, objectACL AccessControlChangeList) error {
 	if objectID == "/authorization/tokens" || objectID == "/registered-models/root" || objectID == "/directories/0" {
@@ -303,9 +354,10 @@ func permissionsResourceIDFields() []permissionsIDFieldMapping {
 		{"authorization", "tokens", "authorization", []string{"CAN_USE"}, SIMPLE},
 		{"authorization", "passwords", "authorization", []string{"CAN_USE"}, SIMPLE},
 		{"sql_endpoint_id", "warehouses", "sql/warehouses", []string{"CAN_USE", "CAN_MANAGE", "IS_OWNER"}, SIMPLE},
-		{"sql_dashboard_id", "dashboard", "sql/dashboards", []string{"CAN_EDIT", "CAN_RUN", "CAN_MANAGE", "CAN_VIEW"}, SIMPLE},
-		{"sql_alert_id", "alert", "sql/alerts", []string{"CAN_EDIT", "CAN_RUN", "CAN_MANAGE", "CAN_VIEW"}, SIMPLE},
-		{"sql_query_id", "query", "sql/queries", []string{"CAN_EDIT", "CAN_RUN", "CAN_MANAGE", "CAN_VIEW"}, SIMPLE},
+		{"sql_dashboard_id", "dashboard", "dbsql-dashboards", []string{"CAN_EDIT", "CAN_RUN", "CAN_MANAGE", "CAN_VIEW", "IS_OWNER"}, SIMPLE},
+		{"dashboard_id", "dashboard", "dashboards", []string{"CAN_READ", "CAN_RUN", "CAN_EDIT", "CAN_MANAGE"}, SIMPLE},
+		{"sql_alert_id", "alert", "alerts", []string{"CAN_EDIT", "CAN_RUN", "CAN_MANAGE", "CAN_VIEW", "IS_OWNER"}, SIMPLE},
+		{"sql_query_id", "query", "queries", []string{"CAN_EDIT", "CAN_RUN", "CAN_MANAGE", "CAN_VIEW", "IS_OWNER"}, SIMPLE},
 		{"experiment_id", "mlflowExperiment", "experiments", []string{"CAN_READ", "CAN_EDIT", "CAN_MANAGE"}, SIMPLE},
 		{"registered_model_id", "registered-model", "registered-models", []string{
 			"CAN_READ", "CAN_EDIT", "CAN_MANAGE_STAGING_VERSIONS", "CAN_MANAGE_PRODUCTION_VERSIONS", "CAN_MANAGE"}, SIMPLE},
@@ -338,6 +390,11 @@ func (oa *ObjectACL) ToPermissionsEntity(d *schema.ResourceData, me string) (Per
 		if mapping.objectType != oa.ObjectType {
 			continue
 		}
+		isLakeviewDashboard := strings.HasPrefix(d.Id(), "/dashboards/")
+		if mapping.objectType == "dashboard" && (mapping.field == "dashboard_id") != isLakeviewDashboard {
+			// SQL and Lakeview dashboards have the same object type
+			continue
+		}
 		entity.ObjectType = mapping.objectType
 		var pathVariant any
 		if mapping.objectType == "file" {
@@ -355,6 +412,73 @@ func (oa *ObjectACL) ToPermissionsEntity(d *schema.ResourceData, me string) (Per
 	return entity, fmt.Errorf("unknown object type %s", oa.ObjectType)
 }
 
+// checkAdmins prevents restricting permissions of `admins` group, that is always CAN_MANAGE
+func checkAdmins(resourceType string, accessControlList []AccessControlChange) error {
+	for _, v := range accessControlList {
+		if v.GroupName == "admins" && resourceType != "authorization" {
+			// should allow setting admins permissions for passwords and tokens usage
+			return fmt.Errorf("it is not possible to restrict any permissions from `admins`")
+		}
+	}
+	return nil
+}
+
+// validate performs hermetic checks of the access control list, that would otherwise
+// fail only during apply with less precise error messages from the platform. Values unknown
+// at plan time are read as empty strings, so such entries are skipped.
+func (m permissionsIDFieldMapping) validate(accessControlList []AccessControlChange) error {
+	if err := checkAdmins(m.resourceType, accessControlList); err != nil {
+		return err
+	}
+	owners := []string{}
+	for _, v := range accessControlList {
+		if v.PermissionLevel == "" {
+			continue
+		}
+		if !stringInSlice(v.PermissionLevel, m.allowedPermissionLevels) {
+			return fmt.Errorf(`permission_level %s is not supported with %s objects, allowed levels: %s`,
+				v.PermissionLevel, m.field, strings.Join(m.allowedPermissionLevels, ", "))
+		}
+		if v.PermissionLevel != "IS_OWNER" {
+			continue
+		}
+		if v.GroupName != "" {
+			return fmt.Errorf("IS_OWNER permission can be granted only to a user or a service principal, "+
+				"not to group %s on %s objects", v.GroupName, m.field)
+		}
+		owner := v.UserName + v.ServicePrincipalName
+		if owner == "" {
+			continue
+		}
+		owners = append(owners, owner)
+	}
+	if len(owners) > 1 {
+		sort.Strings(owners)
+		return fmt.Errorf("%s objects can have only one IS_OWNER, but got %d: %s",
+			m.field, len(owners), strings.Join(owners, ", "))
+	}
+	return nil
+}
+
+func ownerFromSet(v any) *AccessControlChange {
+	set, ok := v.(*schema.Set)
+	if !ok {
+		return nil
+	}
+	for _, accessControl := range set.List() {
+		m := accessControl.(map[string]any)
+		if m["permission_level"] != "IS_OWNER" {
+			continue
+		}
+		return &AccessControlChange{
+			UserName:             m["user_name"].(string),
+			ServicePrincipalName: m["service_principal_name"].(string),
+			PermissionLevel:      "IS_OWNER",
+		}
+	}
+	return nil
+}
+
 func stringInSlice(a string, list []string) bool {
 	for _, b := range list {
 		if b == a {
@@ -386,25 +510,30 @@ func ResourcePermissions() common.Resource {
 	return common.Resource{
 		Schema: s,
 		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff) error {
-			// Plan time validation for object permission levels
+			// Plan time validation for object permission levels. IDs of objects created together
+			// with permissions are unknown at plan time, but the access control list is validated anyway
 			for _, mapping := range permissionsResourceIDFields() {
-				if _, ok := diff.GetOk(mapping.field); !ok {
+				if _, ok := diff.GetOk(mapping.field); !ok && diff.NewValueKnown(mapping.field) {
 					continue
 				}
-				access_control_list := diff.Get("access_control").(*schema.Set).List()
-				for _, access_control := range access_control_list {
-					m := access_control.(map[string]any)
-					permission_level := m["permission_level"].(string)
-					if !stringInSlice(permission_level, mapping.allowedPermissionLevels) {
-						return fmt.Errorf(`permission_level %s is not supported with %s objects`,
-							permission_level, mapping.field)
-					}
+				var accessControlList []AccessControlChange
+				for _, accessControl := range diff.Get("access_control").(*schema.Set).List() {
+					m := accessControl.(map[string]any)
+					accessControlList = append(accessControlList, AccessControlChange{
+						UserName:             m["user_name"].(string),
+						GroupName:            m["group_name"].(string),
+						ServicePrincipalName: m["service_principal_name"].(string),
+						PermissionLevel:      m["permission_level"].(string),
+					})
 				}
+				return mapping.validate(accessControlList)
 			}
 			return nil
 		},
 		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
-			id := d.Id()
+			// permissions of SQL objects created with the legacy API get identifiers of the consolidated API
+			id := migrateLegacyObjectID(d.Id())
+			d.SetId(id)
 			w, err := c.WorkspaceClient()
 			if err != nil {
 				return err
@@ -454,11 +583,10 @@ func ResourcePermissions() common.Resource {
 							format := "it is not possible to decrease administrative permissions for the current user: %s"
 							return fmt.Errorf(format, me.UserName)
 						}
-
-						if v.GroupName == "admins" && mapping.resourceType != "authorization" {
-							// should allow setting admins permissions for passwords and tokens usage
-							return fmt.Errorf("it is not possible to restrict any permissions from `admins`")
-						}
+					}
+					err = checkAdmins(mapping.resourceType, entity.AccessControlList)
+					if err != nil {
+						return err
 					}
 					err = NewPermissionsAPI(ctx, c).Update(objectID, AccessControlChangeList{
 						AccessControlList: entity.AccessControlList,
@@ -475,9 +603,28 @@ func ResourcePermissions() common.Resource {
 		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			var entity PermissionsEntity
 			common.DataToStructPointer(d, s, &entity)
-			return NewPermissionsAPI(ctx, c).Update(d.Id(), AccessControlChangeList{
+			objectACL := AccessControlChangeList{
 				AccessControlList: entity.AccessControlList,
-			})
+			}
+			// the same check as in Create, as plan-time validation may be skipped for unknown values
+			resourceType, _, _ := strings.Cut(strings.TrimPrefix(d.Id(), "/"), "/")
+			err := checkAdmins(resourceType, entity.AccessControlList)
+			if err != nil {
+				return err
+			}
+			permissionsAPI := NewPermissionsAPI(ctx, c)
+			o, _ := d.GetChange("access_control")
+			previousOwner := ownerFromSet(o)
+			newOwner, _ := splitOwner(objectACL)
+			if previousOwner != nil && newOwner != nil && previousOwner.principal() != newOwner.principal() {
+				log.Printf("[INFO] Transferring ownership of %s from %s to %s",
+					d.Id(), previousOwner.principal(), newOwner.principal())
+				err := permissionsAPI.TransferOwnership(d.Id(), *previousOwner, objectACL)
+				if err != nil {
+					return err
+				}
+			}
+			return permissionsAPI.Update(d.Id(), objectACL)
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			return NewPermissionsAPI(ctx, c).Delete(d.Id())
@@ -2,6 +2,7 @@ package permissions
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"testing"
 
@@ -334,9 +335,9 @@ func TestResourcePermissionsRead_SQLA_Asset(t *testing.T) {
 			me,
 			{
 				Method:   http.MethodGet,
-				Resource: "/api/2.0/preview/sql/permissions/dashboards/abc",
+				Resource: "/api/2.0/permissions/dbsql-dashboards/abc",
 				Response: ObjectACL{
-					ObjectID:   "/sql/dashboards/abc",
+					ObjectID:   "/dbsql-dashboards/abc",
 					ObjectType: "dashboard",
 					AccessControlList: []AccessControl{
 						{
@@ -357,7 +358,46 @@ func TestResourcePermissionsRead_SQLA_Asset(t *testing.T) {
 		ID:       "/sql/dashboards/abc",
 	}.Apply(t)
 	assert.NoError(t, err)
-	assert.Equal(t, "/sql/dashboards/abc", d.Id())
+	// identifier of the legacy API is migrated to the one of the consolidated API
+	assert.Equal(t, "/dbsql-dashboards/abc", d.Id())
+	ac := d.Get("access_control").(*schema.Set)
+	require.Equal(t, 1, len(ac.List()))
+	firstElem := ac.List()[0].(map[string]any)
+	assert.Equal(t, TestingUser, firstElem["user_name"])
+	assert.Equal(t, "CAN_READ", firstElem["permission_level"])
+}
+
+func TestResourcePermissionsRead_LakeviewDashboard(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			me,
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/dashboards/abc",
+				Response: ObjectACL{
+					ObjectID:   "dashboards/abc",
+					ObjectType: "dashboard",
+					AccessControlList: []AccessControl{
+						{
+							UserName: TestingUser,
+							AllPermissions: []Permission{
+								{
+									PermissionLevel: "CAN_READ",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourcePermissions(),
+		Read:     true,
+		New:      true,
+		ID:       "/dashboards/abc",
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", d.Get("dashboard_id"))
+	assert.Equal(t, "", d.Get("sql_dashboard_id"))
 	ac := d.Get("access_control").(*schema.Set)
 	require.Equal(t, 1, len(ac.List()))
 	firstElem := ac.List()[0].(map[string]any)
@@ -417,7 +457,7 @@ func TestResourcePermissionsCustomizeDiff_ErrorOnCreate(t *testing.T) {
 		access_control {
 			permission_level = "WHATEVER"
 		}`,
-	}.ExpectError(t, "permission_level WHATEVER is not supported with cluster_id objects")
+	}.ExpectError(t, "permission_level WHATEVER is not supported with cluster_id objects, allowed levels: CAN_ATTACH_TO, CAN_RESTART, CAN_MANAGE")
 }
 
 func TestResourcePermissionsCustomizeDiff_ErrorOnPermissionsDecreate(t *testing.T) {
@@ -755,8 +795,8 @@ func TestResourcePermissionsCreate_SQLA_Asset(t *testing.T) {
 		Fixtures: []qa.HTTPFixture{
 			me,
 			{
-				Method:   http.MethodPost,
-				Resource: "/api/2.0/preview/sql/permissions/dashboards/abc",
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/permissions/dbsql-dashboards/abc",
 				ExpectedRequest: AccessControlChangeList{
 					AccessControlList: []AccessControlChange{
 						{
@@ -772,9 +812,9 @@ func TestResourcePermissionsCreate_SQLA_Asset(t *testing.T) {
 			},
 			{
 				Method:   http.MethodGet,
-				Resource: "/api/2.0/preview/sql/permissions/dashboards/abc",
+				Resource: "/api/2.0/permissions/dbsql-dashboards/abc",
 				Response: ObjectACL{
-					ObjectID:   "/sql/dashboards/abc",
+					ObjectID:   "/dbsql-dashboards/abc",
 					ObjectType: "dashboard",
 					AccessControlList: []AccessControl{
 						{
@@ -833,7 +873,7 @@ func TestResourcePermissionsCreate_SQLA_Endpoint(t *testing.T) {
 				Method:   http.MethodGet,
 				Resource: "/api/2.0/permissions/sql/warehouses/abc",
 				Response: ObjectACL{
-					ObjectID:   "/sql/dashboards/abc",
+					ObjectID:   "/dbsql-dashboards/abc",
 					ObjectType: "dashboard",
 					AccessControlList: []AccessControl{
 						{
@@ -896,7 +936,7 @@ func TestResourcePermissionsCreate_SQLA_Endpoint_WithOwner(t *testing.T) {
 				Method:   http.MethodGet,
 				Resource: "/api/2.0/permissions/sql/warehouses/abc",
 				Response: ObjectACL{
-					ObjectID:   "/sql/dashboards/abc",
+					ObjectID:   "/dbsql-dashboards/abc",
 					ObjectType: "dashboard",
 					AccessControlList: []AccessControl{
 						{
@@ -1161,7 +1201,7 @@ func TestResourcePermissionsCreate_error(t *testing.T) {
 			},
 		},
 		Create: true,
-	}.ExpectError(t, "permission_level CAN_USE is not supported with cluster_id objects")
+	}.ExpectError(t, "permission_level CAN_USE is not supported with cluster_id objects, allowed levels: CAN_ATTACH_TO, CAN_RESTART, CAN_MANAGE")
 }
 
 func TestResourcePermissionsCreate_PathIdRetriever_Error(t *testing.T) {
@@ -1594,8 +1634,8 @@ func TestResourcePermissionsCreate_Sql_Queries(t *testing.T) {
 		Fixtures: []qa.HTTPFixture{
 			me,
 			{
-				Method:   http.MethodPost,
-				Resource: "/api/2.0/preview/sql/permissions/queries/id111",
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/permissions/queries/id111",
 				ExpectedRequest: AccessControlChangeList{
 					AccessControlList: []AccessControlChange{
 						{
@@ -1611,9 +1651,9 @@ func TestResourcePermissionsCreate_Sql_Queries(t *testing.T) {
 			},
 			{
 				Method:   http.MethodGet,
-				Resource: "/api/2.0/preview/sql/permissions/queries/id111",
+				Resource: "/api/2.0/permissions/queries/id111",
 				Response: ObjectACL{
-					ObjectID:   "/sql/queries/id111",
+					ObjectID:   "/queries/id111",
 					ObjectType: "query",
 					AccessControlList: []AccessControl{
 						{
@@ -1654,8 +1694,8 @@ func TestResourcePermissionsUpdate_Sql_Queries(t *testing.T) {
 		Fixtures: []qa.HTTPFixture{
 			me,
 			{
-				Method:   http.MethodPost,
-				Resource: "/api/2.0/preview/sql/permissions/queries/id111",
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/permissions/queries/id111",
 				ExpectedRequest: AccessControlChangeList{
 					AccessControlList: []AccessControlChange{
 						{
@@ -1671,9 +1711,9 @@ func TestResourcePermissionsUpdate_Sql_Queries(t *testing.T) {
 			},
 			{
 				Method:   http.MethodGet,
-				Resource: "/api/2.0/preview/sql/permissions/queries/id111",
+				Resource: "/api/2.0/permissions/queries/id111",
 				Response: ObjectACL{
-					ObjectID:   "/sql/queries/id111",
+					ObjectID:   "/queries/id111",
 					ObjectType: "query",
 					AccessControlList: []AccessControl{
 						{
@@ -1701,7 +1741,7 @@ func TestResourcePermissionsUpdate_Sql_Queries(t *testing.T) {
 		`,
 		Resource: ResourcePermissions(),
 		Update:   true,
-		ID:       "/sql/queries/id111",
+		ID:       "/queries/id111",
 	}.Apply(t)
 	assert.NoError(t, err)
 	ac := d.Get("access_control").(*schema.Set)
@@ -1899,3 +1939,318 @@ func TestResourcePermissionsRootDirectory(t *testing.T) {
 	assert.Equal(t, TestingUser, firstElem["user_name"])
 	assert.Equal(t, "CAN_READ", firstElem["permission_level"])
 }
+
+func TestResourcePermissionsCustomizeDiff_OwnerIsGroup(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourcePermissions(),
+		Create:   true,
+		HCL: `
+		job_id = "123"
+		access_control {
+			group_name = "data-engineers"
+			permission_level = "IS_OWNER"
+		}`,
+	}.ExpectError(t, "IS_OWNER permission can be granted only to a user or a service principal, not to group data-engineers on job_id objects")
+}
+
+func TestResourcePermissionsCustomizeDiff_MultipleOwners(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourcePermissions(),
+		Create:   true,
+		HCL: `
+		sql_endpoint_id = "abc"
+		access_control {
+			user_name = "a@example.com"
+			permission_level = "IS_OWNER"
+		}
+		access_control {
+			service_principal_name = "00000000-0000-0000-0000-000000000000"
+			permission_level = "IS_OWNER"
+		}`,
+	}.ExpectError(t, "sql_endpoint_id objects can have only one IS_OWNER, but got 2: "+
+		"00000000-0000-0000-0000-000000000000, a@example.com")
+}
+
+func TestResourcePermissionsCustomizeDiff_InstancePoolLevels(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourcePermissions(),
+		Create:   true,
+		HCL: `
+		instance_pool_id = "abc"
+		access_control {
+			group_name = "users"
+			permission_level = "CAN_USE"
+		}`,
+	}.ExpectError(t, "permission_level CAN_USE is not supported with instance_pool_id objects, "+
+		"allowed levels: CAN_ATTACH_TO, CAN_MANAGE")
+}
+
+func accessControlInstanceState(entries ...AccessControlChange) map[string]string {
+	hash := schema.HashResource(ResourcePermissions().Schema["access_control"].Elem.(*schema.Resource))
+	state := map[string]string{
+		"access_control.#": fmt.Sprintf("%d", len(entries)),
+	}
+	for _, v := range entries {
+		h := hash(map[string]any{
+			"user_name":              v.UserName,
+			"group_name":             v.GroupName,
+			"service_principal_name": v.ServicePrincipalName,
+			"permission_level":       v.PermissionLevel,
+		})
+		state[fmt.Sprintf("access_control.%d.user_name", h)] = v.UserName
+		state[fmt.Sprintf("access_control.%d.group_name", h)] = v.GroupName
+		state[fmt.Sprintf("access_control.%d.service_principal_name", h)] = v.ServicePrincipalName
+		state[fmt.Sprintf("access_control.%d.permission_level", h)] = v.PermissionLevel
+	}
+	return state
+}
+
+func TestResourcePermissionsUpdate_JobOwnerTransfer(t *testing.T) {
+	state := accessControlInstanceState(
+		AccessControlChange{UserName: "previous", PermissionLevel: "IS_OWNER"},
+		AccessControlChange{UserName: TestingUser, PermissionLevel: "CAN_VIEW"})
+	state["job_id"] = "9"
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			me,
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/permissions/jobs/9",
+				ExpectedRequest: AccessControlChangeList{
+					AccessControlList: []AccessControlChange{
+						{
+							UserName:        TestingOwner,
+							PermissionLevel: "IS_OWNER",
+						},
+						{
+							UserName:        TestingUser,
+							PermissionLevel: "CAN_VIEW",
+						},
+						{
+							UserName:        "previous",
+							PermissionLevel: "CAN_MANAGE",
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/permissions/jobs/9",
+				ExpectedRequest: AccessControlChangeList{
+					AccessControlList: []AccessControlChange{
+						{
+							UserName:        TestingOwner,
+							PermissionLevel: "IS_OWNER",
+						},
+						{
+							UserName:        TestingUser,
+							PermissionLevel: "CAN_VIEW",
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/jobs/9",
+				Response: ObjectACL{
+					ObjectID:   "/jobs/9",
+					ObjectType: "job",
+					AccessControlList: []AccessControl{
+						{
+							UserName:       TestingUser,
+							AllPermissions: []Permission{{PermissionLevel: "CAN_VIEW"}},
+						},
+						{
+							UserName:       TestingOwner,
+							AllPermissions: []Permission{{PermissionLevel: "IS_OWNER"}},
+						},
+					},
+				},
+			},
+		},
+		InstanceState: state,
+		HCL: `
+		job_id = 9
+		access_control {
+			user_name = "ben"
+			permission_level = "CAN_VIEW"
+		}
+		access_control {
+			user_name = "testOwner"
+			permission_level = "IS_OWNER"
+		}`,
+		Resource: ResourcePermissions(),
+		Update:   true,
+		ID:       "/jobs/9",
+	}.ApplyAndExpectData(t, map[string]any{
+		"access_control.#": 2,
+	})
+}
+
+func TestResourcePermissionsUpdate_SQLA_QueryOwnerTransfer(t *testing.T) {
+	state := accessControlInstanceState(
+		AccessControlChange{UserName: "previous", PermissionLevel: "IS_OWNER"},
+		AccessControlChange{UserName: TestingUser, PermissionLevel: "CAN_RUN"})
+	state["sql_query_id"] = "id111"
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			me,
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/permissions/queries/id111",
+				ExpectedRequest: AccessControlChangeList{
+					AccessControlList: []AccessControlChange{
+						{
+							UserName:        TestingUser,
+							PermissionLevel: "CAN_RUN",
+						},
+						{
+							UserName:        TestingOwner,
+							PermissionLevel: "IS_OWNER",
+						},
+						{
+							UserName:        "previous",
+							PermissionLevel: "CAN_MANAGE",
+						},
+						{
+							UserName:        TestingAdminUser,
+							PermissionLevel: "CAN_MANAGE",
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/permissions/queries/id111",
+				ExpectedRequest: AccessControlChangeList{
+					AccessControlList: []AccessControlChange{
+						{
+							UserName:        TestingUser,
+							PermissionLevel: "CAN_RUN",
+						},
+						{
+							UserName:        TestingOwner,
+							PermissionLevel: "IS_OWNER",
+						},
+						{
+							UserName:        TestingAdminUser,
+							PermissionLevel: "CAN_MANAGE",
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/permissions/queries/id111",
+				Response: ObjectACL{
+					ObjectID:   "/queries/id111",
+					ObjectType: "query",
+					AccessControlList: []AccessControl{
+						{
+							UserName:       TestingUser,
+							AllPermissions: []Permission{{PermissionLevel: "CAN_RUN"}},
+						},
+						{
+							UserName:       TestingOwner,
+							AllPermissions: []Permission{{PermissionLevel: "IS_OWNER"}},
+						},
+						{
+							UserName:       TestingAdminUser,
+							AllPermissions: []Permission{{PermissionLevel: "CAN_MANAGE"}},
+						},
+					},
+				},
+			},
+		},
+		InstanceState: state,
+		HCL: `
+		sql_query_id = "id111"
+		access_control {
+			user_name = "ben"
+			permission_level = "CAN_RUN"
+		}
+		access_control {
+			user_name = "testOwner"
+			permission_level = "IS_OWNER"
+		}`,
+		Resource: ResourcePermissions(),
+		Update:   true,
+		ID:       "/queries/id111",
+	}.ApplyAndExpectData(t, map[string]any{
+		"access_control.#": 2,
+	})
+}
+
+func TestResourcePermissionsUpdate_SQLA_LegacyID(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		me,
+		{
+			Method:   http.MethodPut,
+			Resource: "/api/2.0/permissions/dbsql-dashboards/abc",
+			ExpectedRequest: AccessControlChangeList{
+				AccessControlList: []AccessControlChange{
+					{
+						UserName:        TestingUser,
+						PermissionLevel: "CAN_RUN",
+					},
+					{
+						UserName:        TestingAdminUser,
+						PermissionLevel: "CAN_MANAGE",
+					},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		err := NewPermissionsAPI(ctx, client).Update("/sql/dashboards/abc", AccessControlChangeList{
+			AccessControlList: []AccessControlChange{
+				{
+					UserName:        TestingUser,
+					PermissionLevel: "CAN_RUN",
+				},
+			},
+		})
+		assert.NoError(t, err)
+	})
+}
+
+// unknownValue is how Terraform represents values that are known only after apply
+const unknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+func TestResourcePermissionsCustomizeDiff_UnknownObjectID(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourcePermissions(),
+		Create:   true,
+		State: map[string]any{
+			"job_id": unknownValue,
+			"access_control": []any{
+				map[string]any{
+					"group_name":       "admins",
+					"permission_level": "CAN_VIEW",
+				},
+			},
+		},
+	}.ExpectError(t, "it is not possible to restrict any permissions from `admins`")
+}
+
+func TestResourcePermissionsValidate_SkipsUnknownValues(t *testing.T) {
+	for _, mapping := range permissionsResourceIDFields() {
+		if mapping.field != "job_id" {
+			continue
+		}
+		// values unknown at plan time are read as empty strings
+		assert.NoError(t, mapping.validate([]AccessControlChange{
+			{UserName: TestingUser, PermissionLevel: ""},
+			{UserName: "a@example.com", PermissionLevel: "IS_OWNER"},
+			{PermissionLevel: "IS_OWNER"},
+		}))
+	}
+}
+
+func TestResourcePermissionsUpdate_AdminsThrowError(t *testing.T) {
+	assert.EqualError(t, checkAdmins("jobs", []AccessControlChange{
+		{GroupName: "admins", PermissionLevel: "CAN_VIEW"},
+	}), "it is not possible to restrict any permissions from `admins`")
+	assert.NoError(t, checkAdmins("authorization", []AccessControlChange{
+		{GroupName: "admins", PermissionLevel: "CAN_USE"},
+	}))
+}
@@ -4,10 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"regexp"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -96,6 +94,11 @@ type Notification struct {
 	Alerts          []string `json:"alerts" tf:"min_items:1"`
 }
 
+type Deployment struct {
+	Kind             string `json:"kind,omitempty"`
+	MetadataFilePath string `json:"metadata_file_path,omitempty"`
+}
+
 type PipelineSpec struct {
 	ID                  string            `json:"id,omitempty" tf:"computed"`
 	Name                string            `json:"name,omitempty"`
@@ -114,6 +117,7 @@ type PipelineSpec struct {
 	Channel             string            `json:"channel,omitempty" tf:"suppress_diff,default:CURRENT"`
 	Notifications       []Notification    `json:"notifications,omitempty" tf:"alias:notification"`
 	Serverless          bool              `json:"serverless" tf:"optional"`
+	Deployment          *Deployment       `json:"deployment,omitempty"`
 }
 
 type createPipelineResponse struct {
@@ -245,19 +249,19 @@ func (a PipelinesAPI) Delete(id string, timeout time.Duration) error {
 	if err != nil {
 		return err
 	}
-	return resource.RetryContext(a.ctx, timeout,
-		func() *resource.RetryError {
-			i, err := a.Read(id)
-			if err != nil {
-				if apierr.IsMissing(err) {
-					return nil
-				}
-				return resource.NonRetryableError(err)
-			}
-			message := fmt.Sprintf("Pipeline %s is in state %s, not yet deleted", id, *i.State)
-			log.Printf("[DEBUG] %s", message)
-			return resource.RetryableError(fmt.Errorf(message))
-		})
+	return common.WaitConfig{
+		Name:    fmt.Sprintf("pipeline %s to be deleted", id),
+		Timeout: timeout,
+	}.Poll(a.ctx, func() (bool, string, error) {
+		i, err := a.Read(id)
+		if apierr.IsMissing(err) {
+			return true, "", nil
+		}
+		if err != nil {
+			return false, "", err
+		}
+		return false, fmt.Sprintf("pipeline %s is in state %s", id, *i.State), nil
+	})
 }
 
 // List returns a list of the DLT pipelines. List could be filtered by name
@@ -285,46 +289,39 @@ func (a PipelinesAPI) List(pageSize int, filter string) ([]PipelineStateInfo, er
 }
 
 func (a PipelinesAPI) waitForState(id string, timeout time.Duration, desiredState PipelineState) error {
-	return resource.RetryContext(a.ctx, timeout,
-		func() *resource.RetryError {
-			i, err := a.Read(id)
-			if err != nil {
-				return resource.NonRetryableError(err)
-			}
-			state := *i.State
-			if state == desiredState {
-				return nil
-			}
-			if state == StateFailed {
-				return resource.NonRetryableError(fmt.Errorf("pipeline %s has failed", id))
-			}
-			if !i.Spec.Continuous {
-				// continuous pipelines just need a non-FAILED check
-				return nil
-			}
-			message := fmt.Sprintf("Pipeline %s is in state %s, not yet in state %s", id, state, desiredState)
-			log.Printf("[DEBUG] %s", message)
-			return resource.RetryableError(fmt.Errorf(message))
-		})
+	return common.WaitConfig{
+		Name:    fmt.Sprintf("pipeline %s to be in state %s", id, desiredState),
+		Timeout: timeout,
+	}.Poll(a.ctx, func() (bool, string, error) {
+		i, err := a.Read(id)
+		if err != nil {
+			return false, "", err
+		}
+		state := *i.State
+		if state == desiredState {
+			return true, "", nil
+		}
+		if state == StateFailed {
+			return false, "", fmt.Errorf("pipeline %s has failed", id)
+		}
+		if !i.Spec.Continuous {
+			// continuous pipelines just need a non-FAILED check
+			return true, "", nil
+		}
+		return false, fmt.Sprintf("pipeline %s is in state %s", id, state), nil
+	})
 }
 
-func suppressStorageDiff(k, old, new string, d *schema.ResourceData) bool {
-	defaultStorageRegex := regexp.MustCompile(
-		`^dbfs:/pipelines/[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
-	res := defaultStorageRegex.MatchString(old)
-	if new == "" && res {
-		log.Printf("[DEBUG] Suppressing diff for %v: platform=%#v config=%#v", k, old, new)
-		return true
-	}
-	return false
-}
+// suppressStorageDiff suppresses diffs of storage locations that are generated by the backend
+var suppressStorageDiff = common.ServerDefaultDiffSuppress("databricks_pipeline", "storage")
 
 func adjustPipelineResourceSchema(m map[string]*schema.Schema) map[string]*schema.Schema {
 	cluster, _ := m["cluster"].Elem.(*schema.Resource)
 	clustersSchema := cluster.Schema
 	clustersSchema["spark_conf"].DiffSuppressFunc = clusters.SparkConfDiffSuppressFunc
 	common.MustSchemaPath(clustersSchema,
-		"aws_attributes", "zone_id").DiffSuppressFunc = clusters.ZoneDiffSuppress
+		"aws_attributes", "zone_id").DiffSuppressFunc = common.ServerDefaultDiffSuppress("databricks_pipeline",
+		"cluster.aws_attributes.zone_id")
 	common.MustSchemaPath(clustersSchema, "autoscale", "mode").DiffSuppressFunc = common.EqualFoldDiffSuppress
 
 	common.MustSchemaPath(clustersSchema, "init_scripts", "dbfs").Deprecated = clusters.DbfsDeprecationWarning
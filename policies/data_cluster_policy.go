@@ -2,29 +2,99 @@ package policies
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
 
 	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/compute"
 	"github.com/databricks/terraform-provider-databricks/common"
 )
 
-// DataSourceClusterPolicy returns information about cluster policy specified by name
+// definitionToMap parses policy definition into a map from attribute paths to JSON of their rules
+func definitionToMap(definition string) (map[string]string, error) {
+	if definition == "" {
+		return nil, nil
+	}
+	var rules map[string]json.RawMessage
+	err := json.Unmarshal([]byte(definition), &rules)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse policy definition: %w", err)
+	}
+	result := make(map[string]string, len(rules))
+	for path, rule := range rules {
+		result[path] = string(rule)
+	}
+	return result, nil
+}
+
+// findPolicyByFamily returns the only policy that inherits from the given policy family
+func findPolicyByFamily(ctx context.Context, w *databricks.WorkspaceClient, familyId string) (*compute.Policy, error) {
+	policies, err := w.ClusterPolicies.ListAll(ctx, compute.ListClusterPoliciesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	var found []compute.Policy
+	for _, policy := range policies {
+		if policy.PolicyFamilyId == familyId {
+			found = append(found, policy)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("there is no cluster policy of policy family '%s'", familyId)
+	case 1:
+		return &found[0], nil
+	}
+	names := []string{}
+	for _, policy := range found {
+		names = append(names, policy.Name)
+	}
+	return nil, fmt.Errorf("there are %d cluster policies of policy family '%s': %s. Specify name of the policy",
+		len(found), familyId, strings.Join(names, ", "))
+}
+
+// DataSourceClusterPolicy returns information about cluster policy specified by name or by policy family
 func DataSourceClusterPolicy() common.Resource {
 	return common.WorkspaceData(func(ctx context.Context, data *struct {
-		Id                              string `json:"id,omitempty" tf:"computed"`
-		Name                            string `json:"name,omitempty" tf:"computed"`
-		Definition                      string `json:"definition,omitempty" tf:"computed"`
-		Description                     string `json:"description,omitempty" tf:"computed"`
-		PolicyFamilyId                  string `json:"policy_family_id,omitempty" tf:"computed"`
-		PolicyFamilyDefinitionOverrides string `json:"policy_family_definition_overrides,omitempty" tf:"computed"`
-		IsDefault                       bool   `json:"is_default,omitempty" tf:"computed"`
-		MaxClustersPerUser              int    `json:"max_clusters_per_user,omitempty" tf:"computed"`
+		Id                              string            `json:"id,omitempty" tf:"computed"`
+		Name                            string            `json:"name,omitempty" tf:"computed"`
+		Definition                      string            `json:"definition,omitempty" tf:"computed"`
+		DefinitionMap                   map[string]string `json:"definition_map,omitempty" tf:"computed"`
+		Description                     string            `json:"description,omitempty" tf:"computed"`
+		PolicyFamilyId                  string            `json:"policy_family_id,omitempty" tf:"computed"`
+		PolicyFamilyDefinitionOverrides string            `json:"policy_family_definition_overrides,omitempty" tf:"computed"`
+		IsDefault                       bool              `json:"is_default,omitempty" tf:"computed"`
+		MaxClustersPerUser              int               `json:"max_clusters_per_user,omitempty" tf:"computed"`
 	}, w *databricks.WorkspaceClient) error {
-		policy, err := w.ClusterPolicies.GetByName(ctx, data.Name)
+		var policy *compute.Policy
+		var err error
+		switch {
+		case data.Name != "":
+			policy, err = w.ClusterPolicies.GetByName(ctx, data.Name)
+			if err != nil {
+				return err
+			}
+			if data.PolicyFamilyId != "" && policy.PolicyFamilyId != data.PolicyFamilyId {
+				return fmt.Errorf("policy named '%s' has policy family '%s' instead of '%s'",
+					data.Name, policy.PolicyFamilyId, data.PolicyFamilyId)
+			}
+		case data.PolicyFamilyId != "":
+			policy, err = findPolicyByFamily(ctx, w, data.PolicyFamilyId)
+			if err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("either name or policy_family_id must be specified")
+		}
+		definitionMap, err := definitionToMap(policy.Definition)
 		if err != nil {
 			return err
 		}
 		data.Id = policy.PolicyId
+		data.Name = policy.Name
 		data.Definition = policy.Definition
+		data.DefinitionMap = definitionMap
 		data.Description = policy.Description
 		data.PolicyFamilyId = policy.PolicyFamilyId
 		data.PolicyFamilyDefinitionOverrides = policy.PolicyFamilyDefinitionOverrides
@@ -43,6 +43,7 @@ func TestDataSourceClusterPolicy(t *testing.T) {
 		"policy_family_definition_overrides": `{"def":"456"}`,
 		"is_default":                         true,
 		"max_clusters_per_user":              42,
+		"definition_map.abc":                 `"123"`,
 	})
 }
 
@@ -82,3 +83,96 @@ func TestDataSourceClusterPolicyNotFound(t *testing.T) {
 		HCL:         `name = "policy"`,
 	}.ExpectError(t, "Policy named 'policy' does not exist")
 }
+
+var policiesOfFamilies = qa.HTTPFixture{
+	Method:   "GET",
+	Resource: "/api/2.0/policies/clusters/list?",
+	Response: compute.ListPoliciesResponse{
+		Policies: []compute.Policy{
+			{
+				PolicyId:       "abc",
+				Name:           "Personal Compute",
+				Definition:     `{"spark_version":{"type":"fixed","value":"auto:latest"},"node_type_id":{"type":"unlimited"}}`,
+				PolicyFamilyId: "personal-vm",
+			},
+			{
+				PolicyId:       "def",
+				Name:           "Job Compute",
+				PolicyFamilyId: "job-cluster",
+			},
+			{
+				PolicyId:       "ghi",
+				Name:           "Another Job Compute",
+				PolicyFamilyId: "job-cluster",
+			},
+		},
+	},
+	ReuseRequest: true,
+}
+
+func TestDataSourceClusterPolicyByFamily(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    []qa.HTTPFixture{policiesOfFamilies},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceClusterPolicy(),
+		ID:          ".",
+		HCL:         `policy_family_id = "personal-vm"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                           "abc",
+		"name":                         "Personal Compute",
+		"definition_map.%":             "2",
+		"definition_map.spark_version": `{"type":"fixed","value":"auto:latest"}`,
+		"definition_map.node_type_id":  `{"type":"unlimited"}`,
+	})
+}
+
+func TestDataSourceClusterPolicyByFamilyAmbiguous(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    []qa.HTTPFixture{policiesOfFamilies},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceClusterPolicy(),
+		ID:          ".",
+		HCL:         `policy_family_id = "job-cluster"`,
+	}.ExpectError(t, "there are 2 cluster policies of policy family 'job-cluster': "+
+		"Job Compute, Another Job Compute. Specify name of the policy")
+}
+
+func TestDataSourceClusterPolicyByNameAndFamily(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    []qa.HTTPFixture{policiesOfFamilies},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceClusterPolicy(),
+		ID:          ".",
+		HCL: `
+		name = "Another Job Compute"
+		policy_family_id = "job-cluster"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id": "ghi",
+	})
+}
+
+func TestDataSourceClusterPolicyFamilyMismatch(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    []qa.HTTPFixture{policiesOfFamilies},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceClusterPolicy(),
+		ID:          ".",
+		HCL: `
+		name = "Personal Compute"
+		policy_family_id = "job-cluster"`,
+	}.ExpectError(t, "policy named 'Personal Compute' has policy family 'personal-vm' instead of 'job-cluster'")
+}
+
+func TestDataSourceClusterPolicyNoArguments(t *testing.T) {
+	qa.ResourceFixture{
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceClusterPolicy(),
+		ID:          ".",
+		HCL:         ``,
+	}.ExpectError(t, "either name or policy_family_id must be specified")
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/databricks/terraform-provider-databricks/common"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func isBuiltinPolicyFamily(ctx context.Context, w *databricks.WorkspaceClient, familyId, familyName string) (bool, error) {
@@ -38,6 +39,8 @@ func ResourceClusterPolicy() common.Resource {
 			m["policy_family_definition_overrides"].ConflictsWith = []string{"definition"}
 			m["policy_family_id"].ConflictsWith = []string{"definition"}
 			m["policy_family_definition_overrides"].RequiredWith = []string{"policy_family_id"}
+			common.CustomizeSchemaPath(m, "max_clusters_per_user").SetValidateFunc(validation.IntAtLeast(1))
+			common.CustomizeSchemaPath(m, "description").SetValidateFunc(validation.StringLenBetween(0, 1000))
 
 			return m
 		})
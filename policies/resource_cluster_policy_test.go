@@ -316,6 +316,18 @@ func TestResourceClusterPolicyCreateConflict(t *testing.T) {
 	}.ExpectError(t, "invalid config supplied. [definition] Conflicting configuration arguments. [policy_family_definition_overrides] Conflicting configuration arguments. [policy_family_id] Conflicting configuration arguments")
 }
 
+func TestResourceClusterPolicyCreateInvalidMaxClustersPerUser(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceClusterPolicy(),
+		HCL: `
+		name = "Dummy"
+		definition = "{}"
+		max_clusters_per_user = 0
+		`,
+		Create: true,
+	}.ExpectError(t, "invalid config supplied. [max_clusters_per_user] expected max_clusters_per_user to be at least (1), got 0")
+}
+
 func TestResourceClusterPolicyCreate_Error(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
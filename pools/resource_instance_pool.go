@@ -2,7 +2,7 @@ package pools
 
 import (
 	"context"
-	"strings"
+	"fmt"
 
 	"github.com/databricks/terraform-provider-databricks/clusters"
 	"github.com/databricks/terraform-provider-databricks/common"
@@ -23,8 +23,19 @@ type InstancePoolAwsAttributes struct {
 type InstancePoolAzureAttributes struct {
 	Availability    clusters.Availability `json:"availability,omitempty" tf:"force_new"`
 	SpotBidMaxPrice float64               `json:"spot_bid_max_price,omitempty" tf:"force_new"`
+	EvictionPolicy  AzureEvictionPolicy   `json:"eviction_policy,omitempty" tf:"force_new"`
 }
 
+// AzureEvictionPolicy is the policy applied to Azure spot instances when they are evicted
+type AzureEvictionPolicy string
+
+const (
+	// AzureEvictionPolicyDeallocate stops evicted spot instances and keeps their disks
+	AzureEvictionPolicyDeallocate = "DEALLOCATE"
+	// AzureEvictionPolicyDelete deletes evicted spot instances together with their disks
+	AzureEvictionPolicyDelete = "DELETE"
+)
+
 // InstancePoolGcpAttributes contains aws attributes for GCP Databricks deployments for instance pools
 // https://docs.gcp.databricks.com/dev-tools/api/latest/instance-pools.html#instancepoolgcpattributes
 type InstancePoolGcpAttributes struct {
@@ -62,6 +73,7 @@ type AwsFleetOption struct {
 type AwsFleetLaunchTemplateOverride struct {
 	AvailabilityZone string `json:"availability_zone" tf:"force_new,suppress_diff"`
 	InstanceType     string `json:"instance_type" tf:"force_new,suppress_diff"`
+	WeightedCapacity int32  `json:"weighted_capacity,omitempty" tf:"force_new,suppress_diff"`
 }
 
 type AwsInstancePoolFleetAttributes struct {
@@ -185,9 +197,8 @@ func ResourceInstancePool() common.Resource {
 		if v, err := common.SchemaPath(s, "aws_attributes", "spot_bid_price_percent"); err == nil {
 			v.Default = 100
 		}
-		common.MustSchemaPath(s, "aws_attributes", "zone_id").DiffSuppressFunc = func(k, oldValue, newValue string, d *schema.ResourceData) bool {
-			return oldValue != "" && strings.ToLower(newValue) == "auto"
-		}
+		common.MustSchemaPath(s, "aws_attributes", "zone_id").DiffSuppressFunc = common.ServerDefaultDiffSuppress(
+			"databricks_instance_pool", "aws_attributes.zone_id")
 
 		if v, err := common.SchemaPath(s, "azure_attributes", "availability"); err == nil {
 			v.Default = clusters.AzureAvailabilityOnDemand
@@ -196,6 +207,12 @@ func ResourceInstancePool() common.Resource {
 				clusters.AzureAvailabilityOnDemand,
 			}, false)
 		}
+		if v, err := common.SchemaPath(s, "azure_attributes", "eviction_policy"); err == nil {
+			v.ValidateFunc = validation.StringInSlice([]string{
+				AzureEvictionPolicyDeallocate,
+				AzureEvictionPolicyDelete,
+			}, false)
+		}
 		if v, err := common.SchemaPath(s, "gcp_attributes", "gcp_availability"); err == nil {
 			v.Default = clusters.GcpAvailabilityOnDemand
 			v.ValidateFunc = validation.StringInSlice([]string{
@@ -227,6 +244,9 @@ func ResourceInstancePool() common.Resource {
 				AwsAllocationStrategyCapacityOptimized,
 			}, false)
 		}
+		if v, err := common.SchemaPath(s, "instance_pool_fleet_attributes", "launch_template_override", "weighted_capacity"); err == nil {
+			v.ValidateFunc = validation.IntAtLeast(1)
+		}
 		if v, err := common.SchemaPath(s, "preloaded_docker_image", "url"); err == nil {
 			v.ForceNew = true
 		}
@@ -244,6 +264,15 @@ func ResourceInstancePool() common.Resource {
 	})
 	return common.Resource{
 		Schema: s,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff) error {
+			availability := d.Get("azure_attributes.0.availability").(string)
+			evictionPolicy := d.Get("azure_attributes.0.eviction_policy").(string)
+			if evictionPolicy != "" && availability != clusters.AzureAvailabilitySpot {
+				return fmt.Errorf("azure_attributes.eviction_policy can only be set with %s availability",
+					clusters.AzureAvailabilitySpot)
+			}
+			return nil
+		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			var ip InstancePool
 			common.DataToStructPointer(d, s, &ip)
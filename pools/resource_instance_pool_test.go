@@ -5,6 +5,7 @@ import (
 
 	"github.com/databricks/databricks-sdk-go/apierr"
 
+	"github.com/databricks/terraform-provider-databricks/clusters"
 	"github.com/databricks/terraform-provider-databricks/qa"
 	"github.com/stretchr/testify/assert"
 )
@@ -270,3 +271,150 @@ func TestResourceInstancePoolDelete_Error(t *testing.T) {
 	qa.AssertErrorStartsWith(t, err, "Internal error happened")
 	assert.Equal(t, "abc", d.Id())
 }
+
+func TestResourceInstancePoolCreate_FleetWeights(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/instance-pools/create",
+				ExpectedRequest: InstancePool{
+					InstancePoolName:                   "Fleet Pool",
+					IdleInstanceAutoTerminationMinutes: 15,
+					EnableElasticDisk:                  true,
+					AwsAttributes: &InstancePoolAwsAttributes{
+						Availability:        clusters.AwsAvailabilitySpot,
+						SpotBidPricePercent: 100,
+					},
+					AwsInstancePoolFleetAttributes: &AwsInstancePoolFleetAttributes{
+						FleetSpotOption: &AwsFleetOption{
+							AllocationStrategy: AwsAllocationStrategyCapacityOptimized,
+						},
+						FleetLaunchTemplateOverride: []AwsFleetLaunchTemplateOverride{
+							{
+								AvailabilityZone: "us-west-2a",
+								InstanceType:     "m5.xlarge",
+								WeightedCapacity: 2,
+							},
+						},
+					},
+				},
+				Response: InstancePoolAndStats{
+					InstancePoolID: "abc",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/instance-pools/get?instance_pool_id=abc",
+				Response: InstancePool{
+					InstancePoolID:                     "abc",
+					InstancePoolName:                   "Fleet Pool",
+					IdleInstanceAutoTerminationMinutes: 15,
+					EnableElasticDisk:                  true,
+					AwsInstancePoolFleetAttributes: &AwsInstancePoolFleetAttributes{
+						FleetSpotOption: &AwsFleetOption{
+							AllocationStrategy:      AwsAllocationStrategyCapacityOptimized,
+							InstancePoolsToUseCount: 1,
+						},
+						FleetLaunchTemplateOverride: []AwsFleetLaunchTemplateOverride{
+							{
+								AvailabilityZone: "us-west-2a",
+								InstanceType:     "m5.xlarge",
+								WeightedCapacity: 2,
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceInstancePool(),
+		HCL: `
+		instance_pool_name = "Fleet Pool"
+		idle_instance_autotermination_minutes = 15
+		aws_attributes {}
+		instance_pool_fleet_attributes {
+			fleet_spot_option {
+				allocation_strategy = "CAPACITY_OPTIMIZED"
+			}
+			launch_template_override {
+				availability_zone = "us-west-2a"
+				instance_type = "m5.xlarge"
+				weighted_capacity = 2
+			}
+		}`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id": "abc",
+		"instance_pool_fleet_attributes.0.fleet_spot_option.0.instance_pools_to_use_count": 1,
+	})
+}
+
+func TestResourceInstancePoolCreate_AzureEvictionPolicy(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/instance-pools/create",
+				ExpectedRequest: InstancePool{
+					InstancePoolName:                   "Spot Pool",
+					NodeTypeID:                         "Standard_DS3_v2",
+					IdleInstanceAutoTerminationMinutes: 15,
+					EnableElasticDisk:                  true,
+					AzureAttributes: &InstancePoolAzureAttributes{
+						Availability:    clusters.AzureAvailabilitySpot,
+						SpotBidMaxPrice: -1,
+						EvictionPolicy:  AzureEvictionPolicyDelete,
+					},
+				},
+				Response: InstancePoolAndStats{
+					InstancePoolID: "abc",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/instance-pools/get?instance_pool_id=abc",
+				Response: InstancePool{
+					InstancePoolID:                     "abc",
+					InstancePoolName:                   "Spot Pool",
+					NodeTypeID:                         "Standard_DS3_v2",
+					IdleInstanceAutoTerminationMinutes: 15,
+					EnableElasticDisk:                  true,
+					AzureAttributes: &InstancePoolAzureAttributes{
+						Availability:    clusters.AzureAvailabilitySpot,
+						SpotBidMaxPrice: -1,
+						EvictionPolicy:  AzureEvictionPolicyDelete,
+					},
+				},
+			},
+		},
+		Resource: ResourceInstancePool(),
+		HCL: `
+		instance_pool_name = "Spot Pool"
+		node_type_id = "Standard_DS3_v2"
+		idle_instance_autotermination_minutes = 15
+		azure_attributes {
+			availability = "SPOT_AZURE"
+			spot_bid_max_price = -1
+			eviction_policy = "DELETE"
+		}`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                                 "abc",
+		"azure_attributes.0.eviction_policy": "DELETE",
+	})
+}
+
+func TestResourceInstancePoolCreate_EvictionPolicyRequiresSpot(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceInstancePool(),
+		HCL: `
+		instance_pool_name = "On-demand Pool"
+		node_type_id = "Standard_DS3_v2"
+		idle_instance_autotermination_minutes = 15
+		azure_attributes {
+			availability = "ON_DEMAND_AZURE"
+			eviction_policy = "DEALLOCATE"
+		}`,
+		Create: true,
+	}.ExpectError(t, "azure_attributes.eviction_policy can only be set with SPOT_AZURE availability")
+}
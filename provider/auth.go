@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/databricks/databricks-sdk-go/config"
+)
+
+// providerCredentials tries authentication methods, that aren't supported by Go SDK yet,
+// before the default credentials chain and adds hints to common cloud errors
+type providerCredentials struct {
+	oidc     azureOIDCCredentials
+	google   googleDelegatedCredentials
+	defaults config.DefaultCredentials
+	name     string
+}
+
+func (c *providerCredentials) Name() string {
+	if c.name != "" {
+		return c.name
+	}
+	return c.defaults.Name()
+}
+
+func (c *providerCredentials) Configure(ctx context.Context, cfg *config.Config) (func(*http.Request) error, error) {
+	if cfg.AuthType == azureOIDCAuthType {
+		c.name = azureOIDCAuthType
+		if !c.oidc.configured() {
+			return nil, errors.New("azure_oidc_token or azure_oidc_token_file_path is required")
+		}
+		return c.oidc.Configure(ctx, cfg)
+	}
+	if cfg.AuthType == "" && c.oidc.configured() {
+		visitor, err := c.oidc.Configure(ctx, cfg)
+		if err != nil || visitor != nil {
+			c.name = azureOIDCAuthType
+			return visitor, err
+		}
+	}
+	if (cfg.AuthType == "" || cfg.AuthType == c.google.Name()) && c.google.configured() {
+		visitor, err := c.google.Configure(ctx, cfg)
+		if err != nil || visitor != nil {
+			c.name = c.google.Name()
+			return visitor, err
+		}
+	}
+	visitor, err := c.defaults.Configure(ctx, cfg)
+	if err != nil {
+		return nil, withAzureTenantHint(err, cfg.AzureTenantID)
+	}
+	if visitor == nil || !cfg.IsAzure() {
+		return visitor, nil
+	}
+	return func(r *http.Request) error {
+		return withAzureTenantHint(visitor(r), cfg.AzureTenantID)
+	}, nil
+}
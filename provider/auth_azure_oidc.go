@@ -0,0 +1,223 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/databricks/databricks-sdk-go/config"
+	"github.com/databricks/databricks-sdk-go/logger"
+	"github.com/golang-jwt/jwt/v4"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+const (
+	azureOIDCAuthType = "azure-oidc"
+
+	// audience, that Azure AD expects in federated tokens. Sovereign clouds use
+	// suffixed variants, like api://AzureADTokenExchangeUSGov
+	azureFederatedTokenAudience = "api://AzureADTokenExchange"
+	jwtBearerAssertionType      = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+	azureSpManagementTokenHeader = "X-Databricks-Azure-SP-Management-Token"
+	azureWorkspaceResourceHeader = "X-Databricks-Azure-Workspace-Resource-Id"
+)
+
+// AAD error codes, that usually mean that the service principal is registered
+// in a different tenant than the one used to request the token
+var crossTenantErrorCodes = []string{"AADSTS700016", "AADSTS90002", "AADSTS900023"}
+
+// azureOIDCCredentials exchanges workload identity federation tokens, like the ones
+// issued by GitHub Actions or Kubernetes, for AAD tokens of the service principal
+type azureOIDCCredentials struct {
+	token         string
+	tokenFilePath string
+}
+
+func (c azureOIDCCredentials) Name() string {
+	return azureOIDCAuthType
+}
+
+func (c azureOIDCCredentials) configured() bool {
+	return c.token != "" || c.tokenFilePath != ""
+}
+
+// federatedToken reads the token every time, as it's rotated by the identity provider
+func (c azureOIDCCredentials) federatedToken() (string, error) {
+	if c.token != "" {
+		return c.token, nil
+	}
+	raw, err := os.ReadFile(c.tokenFilePath)
+	if err != nil {
+		return "", fmt.Errorf("cannot read OIDC token file: %w", err)
+	}
+	token := strings.TrimSpace(string(raw))
+	if token == "" {
+		return "", fmt.Errorf("OIDC token file %s is empty", c.tokenFilePath)
+	}
+	return token, nil
+}
+
+func (c azureOIDCCredentials) tokenSourceFor(ctx context.Context, cfg *config.Config, aadEndpoint, resource string) oauth2.TokenSource {
+	return oauth2.ReuseTokenSourceWithExpiry(nil, &azureOIDCTokenSource{
+		ctx:       ctx,
+		clientID:  cfg.AzureClientID,
+		tenantID:  cfg.AzureTenantID,
+		tokenURL:  fmt.Sprintf("%s%s/oauth2/token", aadEndpoint, cfg.AzureTenantID),
+		resource:  resource,
+		assertion: c.federatedToken,
+	}, 40*time.Second)
+}
+
+func (c azureOIDCCredentials) Configure(ctx context.Context, cfg *config.Config) (func(*http.Request) error, error) {
+	if !c.configured() || cfg.AzureClientID == "" {
+		return nil, nil
+	}
+	if cfg.AzureTenantID == "" {
+		return nil, fmt.Errorf("azure_tenant_id is required to exchange OIDC token")
+	}
+	if cfg.Host == "" {
+		return nil, fmt.Errorf("host is required to exchange OIDC token")
+	}
+	if !cfg.IsAzure() {
+		return nil, nil
+	}
+	assertion, err := c.federatedToken()
+	if err != nil {
+		return nil, err
+	}
+	err = checkFederatedTokenAudience(assertion)
+	if err != nil {
+		return nil, err
+	}
+	logger.Infof(ctx, "Exchanging OIDC token for AAD token of Service Principal (%s)", cfg.AzureClientID)
+	env := cfg.Environment()
+	aadEndpoint := env.AzureActiveDirectoryEndpoint()
+	inner := c.tokenSourceFor(ctx, cfg, aadEndpoint, env.AzureApplicationID)
+	management := c.tokenSourceFor(ctx, cfg, aadEndpoint, env.AzureServiceManagementEndpoint())
+	return func(r *http.Request) error {
+		if cfg.AzureResourceID != "" {
+			r.Header.Set(azureWorkspaceResourceHeader, cfg.AzureResourceID)
+		}
+		token, err := inner.Token()
+		if err != nil {
+			return fmt.Errorf("inner token: %w", err)
+		}
+		token.SetAuthHeader(r)
+		cloud, err := management.Token()
+		if err != nil {
+			return fmt.Errorf("cloud token: %w", err)
+		}
+		r.Header.Set(azureSpManagementTokenHeader, cloud.AccessToken)
+		return nil
+	}, nil
+}
+
+type azureOIDCTokenSource struct {
+	ctx       context.Context
+	clientID  string
+	tenantID  string
+	tokenURL  string
+	resource  string
+	assertion func() (string, error)
+}
+
+func (ts *azureOIDCTokenSource) Token() (*oauth2.Token, error) {
+	assertion, err := ts.assertion()
+	if err != nil {
+		return nil, err
+	}
+	token, err := (&clientcredentials.Config{
+		ClientID:  ts.clientID,
+		TokenURL:  ts.tokenURL,
+		AuthStyle: oauth2.AuthStyleInParams,
+		EndpointParams: url.Values{
+			"resource":              []string{ts.resource},
+			"client_assertion_type": []string{jwtBearerAssertionType},
+			"client_assertion":      []string{assertion},
+		},
+	}).Token(ts.ctx)
+	if err != nil {
+		return nil, withAzureTenantHint(err, ts.tenantID)
+	}
+	err = checkAccessTokenAudience(token.AccessToken, ts.resource)
+	if err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+func unverifiedClaims(token string) (jwt.MapClaims, bool) {
+	parser := jwt.Parser{SkipClaimsValidation: true}
+	parsed, _, err := parser.ParseUnverified(token, jwt.MapClaims{})
+	if err != nil {
+		return nil, false
+	}
+	claims, ok := parsed.Claims.(jwt.MapClaims)
+	return claims, ok
+}
+
+func audienceString(claims jwt.MapClaims) string {
+	switch aud := claims["aud"].(type) {
+	case string:
+		return aud
+	case []any:
+		values := []string{}
+		for _, v := range aud {
+			values = append(values, fmt.Sprint(v))
+		}
+		return strings.Join(values, ", ")
+	}
+	return ""
+}
+
+// checkFederatedTokenAudience fails early, when the identity provider issues tokens
+// for the audience, that Azure AD would reject with a cryptic error
+func checkFederatedTokenAudience(token string) error {
+	claims, ok := unverifiedClaims(token)
+	if !ok {
+		// opaque tokens are validated by Azure AD
+		return nil
+	}
+	if strings.HasPrefix(audienceString(claims), azureFederatedTokenAudience) {
+		return nil
+	}
+	return fmt.Errorf("OIDC token is issued for audience '%s', but Azure AD expects '%s'. "+
+		"Configure the identity provider to issue tokens for this audience",
+		audienceString(claims), azureFederatedTokenAudience)
+}
+
+// checkAccessTokenAudience verifies that AAD token is issued for the requested resource,
+// which isn't the case when azure_environment doesn't match the cloud of the workspace
+func checkAccessTokenAudience(token, resource string) error {
+	claims, ok := unverifiedClaims(token)
+	if !ok {
+		return nil
+	}
+	aud := audienceString(claims)
+	if aud == "" || strings.TrimSuffix(aud, "/") == strings.TrimSuffix(resource, "/") {
+		return nil
+	}
+	return fmt.Errorf("AAD token is issued for audience '%s' instead of '%s'. "+
+		"Check that azure_environment matches the cloud of the workspace", aud, resource)
+}
+
+// withAzureTenantHint explains AAD errors, that are caused by the wrong tenant
+func withAzureTenantHint(err error, tenantID string) error {
+	if err == nil {
+		return nil
+	}
+	for _, code := range crossTenantErrorCodes {
+		if strings.Contains(err.Error(), code) {
+			return fmt.Errorf("%w. The service principal is not found in tenant '%s'. "+
+				"For cross-tenant service principals set azure_tenant_id to the tenant, "+
+				"where the service principal is registered", err, tenantID)
+		}
+	}
+	return err
+}
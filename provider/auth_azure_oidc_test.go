@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/config"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testJWT(t *testing.T, aud string) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"aud": aud,
+	}).SignedString([]byte("secret"))
+	require.NoError(t, err)
+	return token
+}
+
+func aadServer(t *testing.T, assertion, issuedAudience string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "/def/oauth2/token", r.URL.Path)
+		assert.Equal(t, "abc", r.PostForm.Get("client_id"))
+		assert.Equal(t, "", r.PostForm.Get("client_secret"))
+		assert.Equal(t, jwtBearerAssertionType, r.PostForm.Get("client_assertion_type"))
+		assert.Equal(t, assertion, r.PostForm.Get("client_assertion"))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token": "%s", "token_type": "Bearer", "expires_in": 3600}`,
+			testJWT(t, issuedAudience))
+	}))
+}
+
+func TestAzureOIDCTokenFromFile(t *testing.T) {
+	assertion := testJWT(t, azureFederatedTokenAudience)
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte(assertion+"\n"), 0600))
+
+	server := aadServer(t, assertion, "resource-id")
+	defer server.Close()
+
+	c := azureOIDCCredentials{tokenFilePath: tokenFile}
+	ts := c.tokenSourceFor(context.Background(), &config.Config{
+		AzureClientID: "abc",
+		AzureTenantID: "def",
+	}, server.URL+"/", "resource-id")
+	token, err := ts.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer", token.TokenType)
+}
+
+func TestAzureOIDCTokenWrongAudience(t *testing.T) {
+	assertion := testJWT(t, azureFederatedTokenAudience)
+	server := aadServer(t, assertion, "https://management.core.windows.net/")
+	defer server.Close()
+
+	c := azureOIDCCredentials{token: assertion}
+	ts := c.tokenSourceFor(context.Background(), &config.Config{
+		AzureClientID: "abc",
+		AzureTenantID: "def",
+	}, server.URL+"/", "resource-id")
+	_, err := ts.Token()
+	assert.EqualError(t, err, "AAD token is issued for audience "+
+		"'https://management.core.windows.net/' instead of 'resource-id'. "+
+		"Check that azure_environment matches the cloud of the workspace")
+}
+
+func TestAzureOIDCEmptyTokenFile(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte(" "), 0600))
+	_, err := azureOIDCCredentials{tokenFilePath: tokenFile}.federatedToken()
+	assert.EqualError(t, err, fmt.Sprintf("OIDC token file %s is empty", tokenFile))
+}
+
+func TestCheckFederatedTokenAudience(t *testing.T) {
+	assert.NoError(t, checkFederatedTokenAudience(testJWT(t, "api://AzureADTokenExchangeUSGov")))
+	assert.NoError(t, checkFederatedTokenAudience("opaque"))
+	assert.EqualError(t, checkFederatedTokenAudience(testJWT(t, "sts.amazonaws.com")),
+		"OIDC token is issued for audience 'sts.amazonaws.com', but Azure AD expects "+
+			"'api://AzureADTokenExchange'. Configure the identity provider to issue tokens for this audience")
+}
+
+func TestWithAzureTenantHint(t *testing.T) {
+	assert.NoError(t, withAzureTenantHint(nil, "def"))
+	assert.EqualError(t, withAzureTenantHint(errors.New("nope"), "def"), "nope")
+	assert.EqualError(t, withAzureTenantHint(errors.New("AADSTS700016: Application not found"), "def"),
+		"AADSTS700016: Application not found. The service principal is not found in tenant 'def'. "+
+			"For cross-tenant service principals set azure_tenant_id to the tenant, "+
+			"where the service principal is registered")
+}
+
+func TestConfig_AzureOIDCRequiresToken(t *testing.T) {
+	providerFixture{
+		host:          "https://adb-xxx.y.azuredatabricks.net/",
+		azureClientID: "abc",
+		azureTenantID: "def",
+		authType:      "azure-oidc",
+		assertError:   "azure-oidc auth: azure_oidc_token or azure_oidc_token_file_path is required",
+	}.apply(t)
+}
+
+func TestConfig_AzureOIDCWrongFederatedAudience(t *testing.T) {
+	providerFixture{
+		host:          "https://adb-xxx.y.azuredatabricks.net/",
+		azureClientID: "abc",
+		azureTenantID: "def",
+		env: map[string]string{
+			"ARM_OIDC_TOKEN": testJWT(t, "sts.amazonaws.com"),
+		},
+		assertError: "azure-oidc auth: OIDC token is issued for audience 'sts.amazonaws.com'",
+	}.apply(t)
+}
@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go/config"
+	"github.com/databricks/databricks-sdk-go/logger"
+	"golang.org/x/oauth2"
+	"google.golang.org/api/impersonate"
+	"google.golang.org/api/option"
+)
+
+// googleDelegatedCredentials impersonates google_service_account through a chain of
+// delegates, starting from Application Default Credentials. Go SDK supports only
+// direct impersonation.
+type googleDelegatedCredentials struct {
+	delegates []string
+	// options used to enable unit testing
+	opts []option.ClientOption
+}
+
+func (c googleDelegatedCredentials) Name() string {
+	return "google-id"
+}
+
+func (c googleDelegatedCredentials) configured() bool {
+	return len(c.delegates) > 0
+}
+
+// chain returns human-readable delegation chain, i.e. `ADC -> a@... -> b@...`
+func (c googleDelegatedCredentials) chain(target string) string {
+	return strings.Join(append(append([]string{"Application Default Credentials"}, c.delegates...), target), " -> ")
+}
+
+func (c googleDelegatedCredentials) validate(cfg *config.Config) error {
+	if cfg.GoogleServiceAccount == "" {
+		return fmt.Errorf("google_impersonate_delegates require google_service_account")
+	}
+	for _, delegate := range c.delegates {
+		if !strings.Contains(delegate, "@") {
+			return fmt.Errorf("google_impersonate_delegates must contain service account emails, got: %s", delegate)
+		}
+	}
+	return nil
+}
+
+// explain converts impersonation failures into actionable errors
+func (c googleDelegatedCredentials) explain(err error, target string) error {
+	if err == nil {
+		return nil
+	}
+	msg := err.Error()
+	switch {
+	case strings.Contains(msg, "could not find default credentials"):
+		return fmt.Errorf("cannot find Application Default Credentials to start delegation chain %s: %w. "+
+			"Running 'gcloud auth application-default login' may help", c.chain(target), err)
+	case strings.Contains(msg, "status code 403") || strings.Contains(msg, "PERMISSION_DENIED"):
+		return fmt.Errorf("cannot impersonate %s through delegation chain %s: %w. "+
+			"Every identity in the chain must have Service Account Token Creator role "+
+			"(roles/iam.serviceAccountTokenCreator) on the next service account", target, c.chain(target), err)
+	case strings.Contains(msg, "status code 404") || strings.Contains(msg, "NOT_FOUND"):
+		return fmt.Errorf("service account in delegation chain %s does not exist: %w", c.chain(target), err)
+	}
+	return fmt.Errorf("cannot impersonate %s through delegation chain %s: %w", target, c.chain(target), err)
+}
+
+type explainedTokenSource struct {
+	inner   oauth2.TokenSource
+	explain func(error) error
+}
+
+func (ts explainedTokenSource) Token() (*oauth2.Token, error) {
+	token, err := ts.inner.Token()
+	if err != nil {
+		return nil, ts.explain(err)
+	}
+	return token, nil
+}
+
+func (c googleDelegatedCredentials) Configure(ctx context.Context, cfg *config.Config) (func(*http.Request) error, error) {
+	if !c.configured() {
+		return nil, nil
+	}
+	if err := c.validate(cfg); err != nil {
+		return nil, err
+	}
+	if !cfg.IsGcp() {
+		return nil, nil
+	}
+	target := cfg.GoogleServiceAccount
+	explain := func(err error) error {
+		return c.explain(err, target)
+	}
+	idTokens, err := impersonate.IDTokenSource(ctx, impersonate.IDTokenConfig{
+		Audience:        cfg.Host,
+		TargetPrincipal: target,
+		IncludeEmail:    true,
+		Delegates:       c.delegates,
+	}, c.opts...)
+	if err != nil {
+		return nil, explain(err)
+	}
+	inner := explainedTokenSource{idTokens, explain}
+	if cfg.IsAccountClient() {
+		accessTokens, err := impersonate.CredentialsTokenSource(ctx, impersonate.CredentialsConfig{
+			TargetPrincipal: target,
+			Delegates:       c.delegates,
+			Scopes: []string{
+				"https://www.googleapis.com/auth/cloud-platform",
+				"https://www.googleapis.com/auth/compute",
+			},
+		}, c.opts...)
+		if err != nil {
+			return nil, explain(err)
+		}
+		platform := explainedTokenSource{accessTokens, explain}
+		logger.Infof(ctx, "Using Google Default Application Credentials with %d delegates for Accounts API", len(c.delegates))
+		return func(r *http.Request) error {
+			token, err := inner.Token()
+			if err != nil {
+				return fmt.Errorf("inner token: %w", err)
+			}
+			token.SetAuthHeader(r)
+			cloud, err := platform.Token()
+			if err != nil {
+				return fmt.Errorf("cloud token: %w", err)
+			}
+			r.Header.Set("X-Databricks-GCP-SA-Access-Token", cloud.AccessToken)
+			return nil
+		}, nil
+	}
+	logger.Infof(ctx, "Using Google Default Application Credentials with %d delegates for Workspace", len(c.delegates))
+	return func(r *http.Request) error {
+		token, err := inner.Token()
+		if err != nil {
+			return fmt.Errorf("inner token: %w", err)
+		}
+		token.SetAuthHeader(r)
+		return nil
+	}, nil
+}
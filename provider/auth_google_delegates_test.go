@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/option"
+)
+
+type roundTripFunc func(r *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func iamCredentialsClient(t *testing.T, status int, body string) option.ClientOption {
+	return option.WithHTTPClient(&http.Client{
+		Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			assert.Equal(t, "/v1/projects/-/serviceAccounts/target@b.iam.gserviceaccount.com:generateIdToken", r.URL.Path)
+			var req struct {
+				Delegates []string `json:"delegates"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			assert.Equal(t, []string{"projects/-/serviceAccounts/first@a.iam.gserviceaccount.com"}, req.Delegates)
+			return &http.Response{
+				StatusCode: status,
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Header:     http.Header{},
+			}, nil
+		}),
+	})
+}
+
+func TestGoogleDelegatedCredentials(t *testing.T) {
+	c := googleDelegatedCredentials{
+		delegates: []string{"first@a.iam.gserviceaccount.com"},
+		opts:      []option.ClientOption{iamCredentialsClient(t, 200, `{"token": "abc"}`)},
+	}
+	visitor, err := c.Configure(context.Background(), &config.Config{
+		Host:                 "https://123.4.gcp.databricks.com",
+		GoogleServiceAccount: "target@b.iam.gserviceaccount.com",
+	})
+	require.NoError(t, err)
+	r, _ := http.NewRequest("GET", "https://123.4.gcp.databricks.com", nil)
+	require.NoError(t, visitor(r))
+	assert.Equal(t, "Bearer abc", r.Header.Get("Authorization"))
+}
+
+func TestGoogleDelegatedCredentialsPermissionDenied(t *testing.T) {
+	c := googleDelegatedCredentials{
+		delegates: []string{"first@a.iam.gserviceaccount.com"},
+		opts:      []option.ClientOption{iamCredentialsClient(t, 403, `PERMISSION_DENIED`)},
+	}
+	visitor, err := c.Configure(context.Background(), &config.Config{
+		Host:                 "https://123.4.gcp.databricks.com",
+		GoogleServiceAccount: "target@b.iam.gserviceaccount.com",
+	})
+	require.NoError(t, err)
+	r, _ := http.NewRequest("GET", "https://123.4.gcp.databricks.com", nil)
+	assert.EqualError(t, visitor(r), "inner token: cannot impersonate target@b.iam.gserviceaccount.com "+
+		"through delegation chain Application Default Credentials -> first@a.iam.gserviceaccount.com -> "+
+		"target@b.iam.gserviceaccount.com: impersonate: status code 403: PERMISSION_DENIED. "+
+		"Every identity in the chain must have Service Account Token Creator role "+
+		"(roles/iam.serviceAccountTokenCreator) on the next service account")
+}
+
+func TestGoogleDelegatedCredentialsValidation(t *testing.T) {
+	_, err := googleDelegatedCredentials{
+		delegates: []string{"first@a.iam.gserviceaccount.com"},
+	}.Configure(context.Background(), &config.Config{
+		Host: "https://123.4.gcp.databricks.com",
+	})
+	assert.EqualError(t, err, "google_impersonate_delegates require google_service_account")
+
+	_, err = googleDelegatedCredentials{
+		delegates: []string{"first"},
+	}.Configure(context.Background(), &config.Config{
+		Host:                 "https://123.4.gcp.databricks.com",
+		GoogleServiceAccount: "target@b.iam.gserviceaccount.com",
+	})
+	assert.EqualError(t, err, "google_impersonate_delegates must contain service account emails, got: first")
+}
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+type driftCheckObject struct {
+	Type         string `json:"type"`
+	ID           string `json:"id"`
+	SnapshotJSON string `json:"snapshot_json"`
+}
+
+type driftCheckDrift struct {
+	Type     string `json:"type"`
+	ID       string `json:"id"`
+	Path     string `json:"path"`
+	Expected string `json:"expected,omitempty"`
+	Actual   string `json:"actual,omitempty"`
+}
+
+type driftCheckData struct {
+	Objects []driftCheckObject `json:"object" tf:"slice_set"`
+	Drifts  []driftCheckDrift  `json:"drifts,omitempty" tf:"computed"`
+	Drifted bool               `json:"drifted,omitempty" tf:"computed"`
+}
+
+// driftCheckReaders fetch live objects by their type
+var driftCheckReaders = map[string]func(ctx context.Context, w *databricks.WorkspaceClient, id string) (any, error){
+	"job": func(ctx context.Context, w *databricks.WorkspaceClient, id string) (any, error) {
+		jobID, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("job id %s is not a number: %w", id, err)
+		}
+		job, err := w.Jobs.GetByJobId(ctx, jobID)
+		if err != nil {
+			return nil, err
+		}
+		return job.Settings, nil
+	},
+	"cluster": func(ctx context.Context, w *databricks.WorkspaceClient, id string) (any, error) {
+		return w.Clusters.GetByClusterId(ctx, id)
+	},
+	"cluster_policy": func(ctx context.Context, w *databricks.WorkspaceClient, id string) (any, error) {
+		return w.ClusterPolicies.GetByPolicyId(ctx, id)
+	},
+}
+
+// toGeneric converts any JSON-serializable value into maps, slices and scalars
+func toGeneric(v any) (any, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var generic any
+	err = json.Unmarshal(raw, &generic)
+	return generic, err
+}
+
+// asEmbeddedJSON returns decoded value, if the string contains JSON object or array,
+// like cluster policy definitions do.
+func asEmbeddedJSON(v any) (any, bool) {
+	s, ok := v.(string)
+	if !ok || len(s) == 0 || (s[0] != '{' && s[0] != '[') {
+		return nil, false
+	}
+	var decoded any
+	if err := json.Unmarshal([]byte(s), &decoded); err != nil {
+		return nil, false
+	}
+	return decoded, true
+}
+
+func driftValue(v any) string {
+	if v == nil {
+		return ""
+	}
+	raw, _ := json.Marshal(v)
+	return string(raw)
+}
+
+// compareSnapshot walks only the fields present in the snapshot, so that
+// computed fields returned by the platform are not reported as drift.
+func compareSnapshot(path string, expected, actual any, report func(path string, expected, actual any)) {
+	if e, ok := asEmbeddedJSON(expected); ok {
+		if a, ok := asEmbeddedJSON(actual); ok {
+			compareSnapshot(path, e, a, report)
+			return
+		}
+	}
+	switch e := expected.(type) {
+	case map[string]any:
+		a, ok := actual.(map[string]any)
+		if !ok {
+			report(path, expected, actual)
+			return
+		}
+		keys := []string{}
+		for k := range e {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			nested := k
+			if path != "" {
+				nested = path + "." + k
+			}
+			compareSnapshot(nested, e[k], a[k], report)
+		}
+	case []any:
+		a, ok := actual.([]any)
+		if !ok || len(a) != len(e) {
+			report(path, expected, actual)
+			return
+		}
+		for i := range e {
+			compareSnapshot(fmt.Sprintf("%s[%d]", path, i), e[i], a[i], report)
+		}
+	default:
+		if !reflect.DeepEqual(expected, actual) {
+			report(path, expected, actual)
+		}
+	}
+}
+
+// DataSourceDriftCheck compares live objects with JSON snapshots from configuration
+func DataSourceDriftCheck() common.Resource {
+	return common.WorkspaceData(func(ctx context.Context, data *driftCheckData, w *databricks.WorkspaceClient) error {
+		objects := append([]driftCheckObject{}, data.Objects...)
+		sort.Slice(objects, func(i, j int) bool {
+			if objects[i].Type != objects[j].Type {
+				return objects[i].Type < objects[j].Type
+			}
+			return objects[i].ID < objects[j].ID
+		})
+		data.Drifts = []driftCheckDrift{}
+		for _, object := range objects {
+			read, ok := driftCheckReaders[object.Type]
+			if !ok {
+				return fmt.Errorf("unsupported object type: %s", object.Type)
+			}
+			var snapshot any
+			if err := json.Unmarshal([]byte(object.SnapshotJSON), &snapshot); err != nil {
+				return fmt.Errorf("invalid snapshot_json for %s %s: %w", object.Type, object.ID, err)
+			}
+			live, err := read(ctx, w, object.ID)
+			if err != nil {
+				return fmt.Errorf("cannot read %s %s: %w", object.Type, object.ID, err)
+			}
+			actual, err := toGeneric(live)
+			if err != nil {
+				return err
+			}
+			compareSnapshot("", snapshot, actual, func(path string, expected, actual any) {
+				data.Drifts = append(data.Drifts, driftCheckDrift{
+					Type:     object.Type,
+					ID:       object.ID,
+					Path:     path,
+					Expected: driftValue(expected),
+					Actual:   driftValue(actual),
+				})
+			})
+		}
+		data.Drifted = len(data.Drifts) > 0
+		return nil
+	})
+}
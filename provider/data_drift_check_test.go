@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareSnapshot(t *testing.T) {
+	drifts := []string{}
+	compareSnapshot("", map[string]any{
+		"name":       "a",
+		"tags":       map[string]any{"env": "prod"},
+		"list":       []any{1.0, 2.0},
+		"definition": `{"spark_version": {"type": "fixed", "value": "14.3.x-scala2.12"}}`,
+	}, map[string]any{
+		"name":       "a",
+		"computed":   "ignored",
+		"tags":       map[string]any{"env": "dev"},
+		"list":       []any{1.0},
+		"definition": `{"spark_version":{"value":"14.3.x-scala2.12","type":"fixed"}}`,
+	}, func(path string, expected, actual any) {
+		drifts = append(drifts, path)
+	})
+	assert.Equal(t, []string{"list", "tags.env"}, drifts)
+}
+
+func TestDataSourceDriftCheck(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=123",
+				Response: jobs.Job{
+					JobId: 123,
+					Settings: &jobs.JobSettings{
+						Name:              "Nightly",
+						MaxConcurrentRuns: 2,
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/clusters/get?cluster_id=abc",
+				Response: compute.ClusterDetails{
+					ClusterId:    "abc",
+					ClusterName:  "Shared",
+					SparkVersion: "14.3.x-scala2.12",
+					NumWorkers:   4,
+				},
+			},
+		},
+		Resource:    DataSourceDriftCheck(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL: `
+		object {
+			type = "job"
+			id = "123"
+			snapshot_json = "{\"name\": \"Nightly\", \"max_concurrent_runs\": 1}"
+		}
+		object {
+			type = "cluster"
+			id = "abc"
+			snapshot_json = "{\"cluster_name\": \"Shared\", \"num_workers\": 4}"
+		}`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"drifted":           true,
+		"drifts.#":          1,
+		"drifts.0.type":     "job",
+		"drifts.0.id":       "123",
+		"drifts.0.path":     "max_concurrent_runs",
+		"drifts.0.expected": "1",
+		"drifts.0.actual":   "2",
+	})
+}
+
+func TestDataSourceDriftCheck_UnsupportedType(t *testing.T) {
+	qa.ResourceFixture{
+		Resource:    DataSourceDriftCheck(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL: `
+		object {
+			type = "notebook"
+			id = "123"
+			snapshot_json = "{}"
+		}`,
+	}.ExpectError(t, "unsupported object type: notebook")
+}
+
+func TestDataSourceDriftCheck_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    qa.HTTPFailures,
+		Resource:    DataSourceDriftCheck(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL: `
+		object {
+			type = "cluster_policy"
+			id = "xyz"
+			snapshot_json = "{}"
+		}`,
+	}.ExpectError(t, "cannot read cluster_policy xyz: i'm a teapot")
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/settings"
+)
+
+type providerCapabilities struct {
+	ProviderVersion  string   `json:"provider_version,omitempty" tf:"computed"`
+	AuthType         string   `json:"auth_type,omitempty" tf:"computed"`
+	Mode             string   `json:"mode,omitempty" tf:"computed"`
+	IsAccount        bool     `json:"is_account,omitempty" tf:"computed"`
+	SettingResources []string `json:"setting_resources,omitempty" tf:"computed"`
+}
+
+// DataSourceProviderCapabilities describes the configured provider, so that shared modules
+// could decide which resources to use
+func DataSourceProviderCapabilities() common.Resource {
+	return common.DataResource(providerCapabilities{}, func(ctx context.Context, e any, c *common.DatabricksClient) error {
+		data := e.(*providerCapabilities)
+		data.ProviderVersion = common.Version()
+		data.IsAccount = c.Config.IsAccountClient()
+		data.Mode = "workspace"
+		if data.IsAccount {
+			data.Mode = "account"
+		}
+		data.AuthType = c.Config.AuthType
+		data.SettingResources = []string{}
+		for name, levels := range settings.AllSettingsLevels() {
+			for _, level := range levels {
+				if level == data.Mode {
+					data.SettingResources = append(data.SettingResources, fmt.Sprintf("databricks_%s_setting", name))
+				}
+			}
+		}
+		sort.Strings(data.SettingResources)
+		return nil
+	})
+}
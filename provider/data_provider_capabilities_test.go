@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestDataSourceProviderCapabilities(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    []qa.HTTPFixture{},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceProviderCapabilities(),
+		ID:          ".",
+	}.ApplyAndExpectData(t, map[string]any{
+		"provider_version":    common.Version(),
+		"mode":                "workspace",
+		"is_account":          false,
+		"setting_resources.#": 2,
+		"setting_resources.0": "databricks_default_namespace_setting",
+		"setting_resources.1": "databricks_generic_setting",
+	})
+}
+
+func TestDataSourceProviderCapabilitiesAccount(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    []qa.HTTPFixture{},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceProviderCapabilities(),
+		ID:          ".",
+		AccountID:   "123456",
+	}.ApplyAndExpectData(t, map[string]any{
+		"mode":                "account",
+		"is_account":          true,
+		"setting_resources.#": 1,
+		"setting_resources.0": "databricks_generic_setting",
+	})
+}
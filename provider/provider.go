@@ -22,6 +22,7 @@ import (
 	"github.com/databricks/terraform-provider-databricks/clusters"
 	"github.com/databricks/terraform-provider-databricks/commands"
 	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/dashboards"
 	"github.com/databricks/terraform-provider-databricks/jobs"
 	tflogger "github.com/databricks/terraform-provider-databricks/logger"
 	"github.com/databricks/terraform-provider-databricks/mlflow"
@@ -39,6 +40,7 @@ import (
 	"github.com/databricks/terraform-provider-databricks/sql"
 	"github.com/databricks/terraform-provider-databricks/storage"
 	"github.com/databricks/terraform-provider-databricks/tokens"
+	"github.com/databricks/terraform-provider-databricks/vectorsearch"
 	"github.com/databricks/terraform-provider-databricks/workspace"
 )
 
@@ -65,6 +67,7 @@ func DatabricksProvider() *schema.Provider {
 			"databricks_dbfs_file":               storage.DataSourceDbfsFile().ToResource(),
 			"databricks_dbfs_file_paths":         storage.DataSourceDbfsFilePaths().ToResource(),
 			"databricks_directory":               workspace.DataSourceDirectory().ToResource(),
+			"databricks_drift_check":             DataSourceDriftCheck().ToResource(),
 			"databricks_group":                   scim.DataSourceGroup().ToResource(),
 			"databricks_instance_pool":           pools.DataSourceInstancePool().ToResource(),
 			"databricks_instance_profiles":       aws.DataSourceInstanceProfiles().ToResource(),
@@ -78,8 +81,14 @@ func DatabricksProvider() *schema.Provider {
 			"databricks_node_type":               clusters.DataSourceNodeType().ToResource(),
 			"databricks_notebook":                workspace.DataSourceNotebook().ToResource(),
 			"databricks_notebook_paths":          workspace.DataSourceNotebookPaths().ToResource(),
+			"databricks_permissions":             permissions.DataSourcePermissions().ToResource(),
 			"databricks_pipelines":               pipelines.DataSourcePipelines().ToResource(),
+			"databricks_provider_capabilities":   DataSourceProviderCapabilities().ToResource(),
+			"databricks_provider_shares":         sharing.DataSourceProviderShares().ToResource(),
+			"databricks_recipient":               sharing.DataSourceRecipient().ToResource(),
+			"databricks_repos":                   repos.DataSourceRepos().ToResource(),
 			"databricks_schemas":                 catalog.DataSourceSchemas().ToResource(),
+			"databricks_serving_endpoint":        serving.DataSourceServingEndpoint().ToResource(),
 			"databricks_service_principal":       scim.DataSourceServicePrincipal().ToResource(),
 			"databricks_service_principals":      scim.DataSourceServicePrincipals().ToResource(),
 			"databricks_share":                   catalog.DataSourceShare().ToResource(),
@@ -91,6 +100,7 @@ func DatabricksProvider() *schema.Provider {
 			"databricks_views":                   catalog.DataSourceViews().ToResource(),
 			"databricks_volumes":                 catalog.DataSourceVolumes().ToResource(),
 			"databricks_user":                    scim.DataSourceUser().ToResource(),
+			"databricks_workspace_settings":      workspace.DataSourceWorkspaceSettings().ToResource(),
 			"databricks_zones":                   clusters.DataSourceClusterZones().ToResource(),
 		},
 		ResourcesMap: map[string]*schema.Resource{ // must be in alphabetical order
@@ -100,13 +110,18 @@ func DatabricksProvider() *schema.Provider {
 			"databricks_azure_adls_gen1_mount":       storage.ResourceAzureAdlsGen1Mount().ToResource(),
 			"databricks_azure_adls_gen2_mount":       storage.ResourceAzureAdlsGen2Mount().ToResource(),
 			"databricks_azure_blob_mount":            storage.ResourceAzureBlobMount().ToResource(),
+			"databricks_budget":                      mws.ResourceBudget().ToResource(),
 			"databricks_catalog":                     catalog.ResourceCatalog().ToResource(),
 			"databricks_catalog_workspace_binding":   catalog.ResourceCatalogWorkspaceBinding().ToResource(),
+			"databricks_catalog_workspace_bindings":  catalog.ResourceCatalogWorkspaceBindings().ToResource(),
 			"databricks_connection":                  catalog.ResourceConnection().ToResource(),
 			"databricks_cluster":                     clusters.ResourceCluster().ToResource(),
 			"databricks_cluster_policy":              policies.ResourceClusterPolicy().ToResource(),
+			"databricks_dashboard":                   dashboards.ResourceDashboard().ToResource(),
 			"databricks_dbfs_file":                   storage.ResourceDbfsFile().ToResource(),
+			"databricks_file":                        storage.ResourceFile().ToResource(),
 			"databricks_directory":                   workspace.ResourceDirectory().ToResource(),
+			"databricks_directory_tree":              workspace.ResourceDirectoryTree().ToResource(),
 			"databricks_entitlements":                scim.ResourceEntitlements().ToResource(),
 			"databricks_external_location":           catalog.ResourceExternalLocation().ToResource(),
 			"databricks_git_credential":              repos.ResourceGitCredential().ToResource(),
@@ -121,6 +136,7 @@ func DatabricksProvider() *schema.Provider {
 			"databricks_instance_profile":            aws.ResourceInstanceProfile().ToResource(),
 			"databricks_ip_access_list":              access.ResourceIPAccessList().ToResource(),
 			"databricks_job":                         jobs.ResourceJob().ToResource(),
+			"databricks_lakehouse_monitor":           catalog.ResourceLakehouseMonitor().ToResource(),
 			"databricks_library":                     clusters.ResourceLibrary().ToResource(),
 			"databricks_metastore":                   catalog.ResourceMetastore().ToResource(),
 			"databricks_metastore_assignment":        catalog.ResourceMetastoreAssignment().ToResource(),
@@ -140,6 +156,7 @@ func DatabricksProvider() *schema.Provider {
 			"databricks_mws_vpc_endpoint":            mws.ResourceMwsVpcEndpoint().ToResource(),
 			"databricks_mws_workspaces":              mws.ResourceMwsWorkspaces().ToResource(),
 			"databricks_notebook":                    workspace.ResourceNotebook().ToResource(),
+			"databricks_notification_destination":    settings.ResourceNotificationDestination().ToResource(),
 			"databricks_obo_token":                   tokens.ResourceOboToken().ToResource(),
 			"databricks_permission_assignment":       access.ResourcePermissionAssignment().ToResource(),
 			"databricks_permissions":                 permissions.ResourcePermissions().ToResource(),
@@ -172,6 +189,8 @@ func DatabricksProvider() *schema.Provider {
 			"databricks_user":                        scim.ResourceUser().ToResource(),
 			"databricks_user_instance_profile":       aws.ResourceUserInstanceProfile().ToResource(),
 			"databricks_user_role":                   aws.ResourceUserRole().ToResource(),
+			"databricks_vector_search_endpoint":      vectorsearch.ResourceVectorSearchEndpoint().ToResource(),
+			"databricks_vector_search_index":         vectorsearch.ResourceVectorSearchIndex().ToResource(),
 			"databricks_volume":                      catalog.ResourceVolume().ToResource(),
 			"databricks_workspace_conf":              workspace.ResourceWorkspaceConf().ToResource(),
 			"databricks_workspace_file":              workspace.ResourceWorkspaceFile().ToResource(),
@@ -214,6 +233,23 @@ func providerSchema() map[string]*schema.Schema {
 	// TODO: check if still relevant
 	ps["rate_limit"].DefaultFunc = schema.EnvDefaultFunc("DATABRICKS_RATE_LIMIT", 15)
 	ps["debug_truncate_bytes"].DefaultFunc = schema.EnvDefaultFunc("DATABRICKS_DEBUG_TRUNCATE_BYTES", 96)
+	// Azure workload identity federation and Google delegation chains aren't supported by Go SDK yet
+	ps["azure_oidc_token"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Sensitive:   true,
+		DefaultFunc: schema.EnvDefaultFunc("ARM_OIDC_TOKEN", nil),
+	}
+	ps["azure_oidc_token_file_path"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		DefaultFunc: schema.MultiEnvDefaultFunc([]string{"ARM_OIDC_TOKEN_FILE_PATH", "AZURE_FEDERATED_TOKEN_FILE"}, nil),
+	}
+	ps["google_impersonate_delegates"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
 	return ps
 }
 
@@ -251,6 +287,19 @@ func configureDatabricksClient(ctx context.Context, d *schema.ResourceData) (any
 			cfg.AuthType = newer
 		}
 	}
+	delegates := []string{}
+	for _, v := range d.Get("google_impersonate_delegates").([]any) {
+		delegates = append(delegates, v.(string))
+	}
+	cfg.Credentials = &providerCredentials{
+		oidc: azureOIDCCredentials{
+			token:         d.Get("azure_oidc_token").(string),
+			tokenFilePath: d.Get("azure_oidc_token_file_path").(string),
+		},
+		google: googleDelegatedCredentials{
+			delegates: delegates,
+		},
+	}
 	client, err := client.New(cfg)
 	if err != nil {
 		return nil, diag.FromErr(err)
@@ -392,6 +392,53 @@ func (f ResourceFixture) ExpectError(t *testing.T, msg string) {
 	assert.EqualError(t, err, msg)
 }
 
+// ExpectNoDiffAfterImport simulates `terraform import` of the resource with ID: the resource is read into an empty
+// state and the configuration from HCL or State must plan clean against it
+func (f ResourceFixture) ExpectNoDiffAfterImport(t *testing.T) {
+	require.NoError(t, f.validateMocks())
+	require.NotEmpty(t, f.ID, "ID must be set for import")
+	client, server, err := f.setupClient(t)
+	require.NoError(t, err)
+	defer server.Close()
+	client.Config.WithTesting()
+	f.setDatabricksEnvironmentForTest(client, server.URL)
+	if len(f.HCL) > 0 {
+		var out any
+		require.NoError(t, hcl.Decode(&out, f.HCL))
+		f.State = fixHCL(out).(map[string]any)
+	}
+	resourceConfig := terraform.NewResourceConfigRaw(f.State)
+	resource := f.Resource.ToResource()
+	diags := resource.Validate(resourceConfig)
+	require.False(t, diags.HasError(), diagsToString(diags))
+
+	ctx := context.Background()
+	imported, err := resource.Importer.StateContext(ctx, resource.Data(&terraform.InstanceState{ID: f.ID}), client)
+	require.NoError(t, err)
+	require.Len(t, imported, 1)
+	// `terraform plan` refreshes the imported state before computing the diff
+	d := resource.Data(imported[0].State())
+	diags = resource.ReadContext(ctx, d, client)
+	require.False(t, diags.HasError(), diagsToString(diags))
+	require.NotEmpty(t, d.Id(), "resource is not expected to be removed")
+
+	schemaMap := schema.InternalMap(f.Resource.Schema)
+	diff, err := schemaMap.Diff(ctx, d.State(), resourceConfig, resource.CustomizeDiff, client, true)
+	require.NoError(t, err)
+	if diff == nil {
+		return
+	}
+	changes := []string{}
+	for k, v := range diff.Attributes {
+		if v.Old == v.New && !v.NewRemoved {
+			continue
+		}
+		changes = append(changes, fmt.Sprintf("%s: %#v -> %#v", k, v.Old, v.New))
+	}
+	sort.Strings(changes)
+	assert.Empty(t, changes, "imported resource has changes in plan")
+}
+
 type CornerCase struct {
 	part  string
 	value string
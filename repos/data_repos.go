@@ -0,0 +1,52 @@
+package repos
+
+import (
+	"context"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/workspace"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+type repoData struct {
+	ID          int64  `json:"id"`
+	Path        string `json:"path"`
+	Url         string `json:"url"`
+	GitProvider string `json:"git_provider"`
+	Branch      string `json:"branch,omitempty"`
+	CommitHash  string `json:"commit_hash,omitempty"`
+}
+
+// DataSourceRepos lists repos, optionally filtered by path prefix and Git provider
+func DataSourceRepos() common.Resource {
+	return common.WorkspaceData(func(ctx context.Context, data *struct {
+		PathPrefix  string     `json:"path_prefix,omitempty"`
+		GitProvider string     `json:"git_provider,omitempty"`
+		Repos       []repoData `json:"repos,omitempty" tf:"computed"`
+		Ids         []string   `json:"ids,omitempty" tf:"computed"`
+	}, w *databricks.WorkspaceClient) error {
+		repos, err := w.Repos.ListAll(ctx, workspace.ListReposRequest{
+			PathPrefix: data.PathPrefix,
+		})
+		if err != nil {
+			return err
+		}
+		for _, repo := range repos {
+			if data.GitProvider != "" && !strings.EqualFold(data.GitProvider, repo.Provider) {
+				continue
+			}
+			rd := repoData{
+				ID:          repo.Id,
+				Path:        repo.Path,
+				Url:         repo.Url,
+				GitProvider: repo.Provider,
+				Branch:      repo.Branch,
+				CommitHash:  repo.HeadCommitId,
+			}
+			data.Repos = append(data.Repos, rd)
+			data.Ids = append(data.Ids, ReposInformation{ID: repo.Id}.RepoID())
+		}
+		return nil
+	})
+}
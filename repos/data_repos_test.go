@@ -0,0 +1,79 @@
+package repos
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/service/workspace"
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestDataSourceRepos(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/repos?path_prefix=%2FRepos%2Fteam",
+				Response: workspace.ListReposResponse{
+					Repos: []workspace.RepoInfo{
+						{
+							Id:           1,
+							Path:         "/Repos/team/a",
+							Url:          "https://github.com/user/a.git",
+							Provider:     "gitHub",
+							Branch:       "main",
+							HeadCommitId: "abc",
+						},
+						{
+							Id:       2,
+							Path:     "/Repos/team/b",
+							Url:      "https://gitlab.com/user/b.git",
+							Provider: "gitLab",
+							Branch:   "releases",
+						},
+					},
+					NextPageToken: "next",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/repos?next_page_token=next&path_prefix=%2FRepos%2Fteam",
+				Response: workspace.ListReposResponse{
+					Repos: []workspace.RepoInfo{
+						{
+							Id:       3,
+							Path:     "/Repos/team/c",
+							Url:      "https://github.com/user/c.git",
+							Provider: "gitHub",
+							Branch:   "dev",
+						},
+					},
+				},
+			},
+		},
+		Resource:    DataSourceRepos(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL: `
+		path_prefix = "/Repos/team"
+		git_provider = "github"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"ids":                  []any{"1", "3"},
+		"repos.#":              2,
+		"repos.0.path":         "/Repos/team/a",
+		"repos.0.branch":       "main",
+		"repos.0.commit_hash":  "abc",
+		"repos.1.git_provider": "gitHub",
+		"repos.1.url":          "https://github.com/user/c.git",
+	})
+}
+
+func TestDataSourceRepos_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    qa.HTTPFailures,
+		Resource:    DataSourceRepos(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+	}.ExpectError(t, "i'm a teapot")
+}
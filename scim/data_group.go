@@ -11,6 +11,58 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// GroupMemberInfo is a member of the group, returned when `expand_members` is set
+type GroupMemberInfo struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"display_name,omitempty"`
+}
+
+// expandGroupMembers returns all users, service principals and groups, that are members of the group
+// directly or via nested groups. Every nested group is read only once, so membership cycles are fine.
+func expandGroupMembers(groupsAPI GroupsAPI, group Group) (users, servicePrincipals, groups []GroupMemberInfo, err error) {
+	expanded := map[string]bool{group.ID: true}
+	seen := map[string]bool{}
+	queue := []Group{group}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, x := range current.Members {
+			if seen[x.Ref] {
+				continue
+			}
+			seen[x.Ref] = true
+			member := GroupMemberInfo{ID: x.Value, DisplayName: x.Display}
+			switch {
+			case strings.HasPrefix(x.Ref, "Users/"):
+				users = append(users, member)
+			case strings.HasPrefix(x.Ref, "ServicePrincipals/"):
+				servicePrincipals = append(servicePrincipals, member)
+			case strings.HasPrefix(x.Ref, "Groups/"):
+				if x.Value == group.ID {
+					// membership cycle back to the group itself
+					continue
+				}
+				groups = append(groups, member)
+				if expanded[x.Value] {
+					continue
+				}
+				expanded[x.Value] = true
+				nested, err := groupsAPI.Read(x.Value, "members")
+				if err != nil {
+					return nil, nil, nil, err
+				}
+				queue = append(queue, nested)
+			}
+		}
+	}
+	for _, members := range [][]GroupMemberInfo{users, servicePrincipals, groups} {
+		sort.Slice(members, func(i, j int) bool {
+			return members[i].ID < members[j].ID
+		})
+	}
+	return
+}
+
 // DataSourceGroup returns information about group specified by display name
 func DataSourceGroup() common.Resource {
 	type entity struct {
@@ -24,6 +76,11 @@ func DataSourceGroup() common.Resource {
 		InstanceProfiles  []string `json:"instance_profiles,omitempty" tf:"slice_set,computed"`
 		ExternalID        string   `json:"external_id,omitempty" tf:"computed"`
 		AclPrincipalID    string   `json:"acl_principal_id,omitempty" tf:"computed"`
+
+		ExpandMembers           bool              `json:"expand_members,omitempty"`
+		MemberUsers             []GroupMemberInfo `json:"member_users,omitempty" tf:"computed"`
+		MemberServicePrincipals []GroupMemberInfo `json:"member_service_principals,omitempty" tf:"computed"`
+		MemberGroups            []GroupMemberInfo `json:"member_groups,omitempty" tf:"computed"`
 	}
 
 	s := common.StructToSchema(entity{}, func(
@@ -79,6 +136,13 @@ func DataSourceGroup() common.Resource {
 					}
 				}
 			}
+			if this.ExpandMembers {
+				this.MemberUsers, this.MemberServicePrincipals, this.MemberGroups, err =
+					expandGroupMembers(groupsAPI, group)
+				if err != nil {
+					return err
+				}
+			}
 			this.ExternalID = group.ExternalID
 			this.AclPrincipalID = fmt.Sprintf("groups/%s", group.DisplayName)
 			sort.Strings(this.Groups)
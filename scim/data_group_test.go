@@ -18,7 +18,7 @@ func TestDataSourceGroup(t *testing.T) {
 		Fixtures: []qa.HTTPFixture{
 			{
 				Method:   "GET",
-				Resource: `/api/2.0/preview/scim/v2/Groups?filter=displayName%20eq%20%22ds%22`,
+				Resource: `/api/2.0/preview/scim/v2/Groups?count=100&filter=displayName%20eq%20%22ds%22&startIndex=1`,
 				Response: GroupList{
 					Resources: []Group{
 						{
@@ -104,3 +104,71 @@ func TestDataSourceGroup(t *testing.T) {
 	assertContains(t, d.Get("service_principals"), "1113")
 	assertContains(t, d.Get("child_groups"), "1114")
 }
+
+func TestDataSourceGroupExpandMembers(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: `/api/2.0/preview/scim/v2/Groups?count=100&filter=displayName%20eq%20%22ds%22&startIndex=1`,
+				Response: GroupList{
+					Resources: []Group{
+						{
+							DisplayName: "ds",
+							ID:          "eerste",
+							Members: []ComplexValue{
+								{Ref: "Users/1112", Value: "1112", Display: "Jane"},
+								{Ref: "Groups/1114", Value: "1114", Display: "analysts"},
+							},
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Groups/1114?attributes=members",
+				Response: Group{
+					DisplayName: "analysts",
+					ID:          "1114",
+					Members: []ComplexValue{
+						{Ref: "Users/1112", Value: "1112", Display: "Jane"},
+						{Ref: "ServicePrincipals/1113", Value: "1113", Display: "etl"},
+						{Ref: "Groups/1115", Value: "1115", Display: "interns"},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Groups/1115?attributes=members",
+				Response: Group{
+					DisplayName: "interns",
+					ID:          "1115",
+					Members: []ComplexValue{
+						{Ref: "Users/1111", Value: "1111", Display: "John"},
+						// cycle back to the top-level group
+						{Ref: "Groups/eerste", Value: "eerste", Display: "ds"},
+					},
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceGroup(),
+		ID:          ".",
+		HCL: `
+		display_name = "ds"
+		expand_members = true
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"member_users.#":                           2,
+		"member_users.0.id":                        "1111",
+		"member_users.0.display_name":              "John",
+		"member_users.1.id":                        "1112",
+		"member_service_principals.#":              1,
+		"member_service_principals.0.display_name": "etl",
+		"member_groups.#":                          2,
+		"member_groups.0.id":                       "1114",
+		"member_groups.1.display_name":             "interns",
+		"users.#":                                  1,
+	})
+}
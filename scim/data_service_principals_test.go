@@ -12,7 +12,7 @@ func TestDataServicePrincipalsReadByDisplayName(t *testing.T) {
 		Fixtures: []qa.HTTPFixture{
 			{
 				Method:   "GET",
-				Resource: "/api/2.0/preview/scim/v2/ServicePrincipals?excludedAttributes=roles&filter=displayName%20co%20%22def%22",
+				Resource: "/api/2.0/preview/scim/v2/ServicePrincipals?count=100&excludedAttributes=roles&filter=displayName%20co%20%22def%22&startIndex=1",
 				Response: UserList{
 					Resources: []User{
 						{
@@ -46,7 +46,7 @@ func TestDataServicePrincipalsReadNotFound(t *testing.T) {
 		Fixtures: []qa.HTTPFixture{
 			{
 				Method:   "GET",
-				Resource: "/api/2.0/preview/scim/v2/ServicePrincipals?excludedAttributes=roles&filter=displayName%20co%20%22def%22",
+				Resource: "/api/2.0/preview/scim/v2/ServicePrincipals?count=100&excludedAttributes=roles&filter=displayName%20co%20%22def%22&startIndex=1",
 				Response: UserList{},
 			},
 		},
@@ -64,7 +64,7 @@ func TestDataServicePrincipalsReadNoFilter(t *testing.T) {
 		Fixtures: []qa.HTTPFixture{
 			{
 				Method:   "GET",
-				Resource: "/api/2.0/preview/scim/v2/ServicePrincipals?excludedAttributes=roles",
+				Resource: "/api/2.0/preview/scim/v2/ServicePrincipals?count=100&excludedAttributes=roles&startIndex=1",
 				Response: UserList{
 					Resources: []User{
 						{
@@ -98,7 +98,7 @@ func TestDataServicePrincipalsReadError(t *testing.T) {
 		Fixtures: []qa.HTTPFixture{
 			{
 				Method:   "GET",
-				Resource: "/api/2.0/preview/scim/v2/ServicePrincipals?excludedAttributes=roles&filter=displayName%20co%20%22def%22",
+				Resource: "/api/2.0/preview/scim/v2/ServicePrincipals?count=100&excludedAttributes=roles&filter=displayName%20co%20%22def%22&startIndex=1",
 				Status:   500,
 			},
 		},
@@ -16,7 +16,7 @@ func TestDataSourceUser(t *testing.T) {
 		Fixtures: []qa.HTTPFixture{
 			{
 				Method:   "GET",
-				Resource: "/api/2.0/preview/scim/v2/Users?excludedAttributes=roles&filter=userName%20eq%20%22ds%22",
+				Resource: "/api/2.0/preview/scim/v2/Users?count=100&excludedAttributes=roles&filter=userName%20eq%20%22ds%22&startIndex=1",
 				Response: UserList{
 					Resources: []User{
 						{
@@ -54,7 +54,7 @@ func TestDataSourceUserGerUser(t *testing.T) {
 		},
 		{
 			Method:   "GET",
-			Resource: "/api/2.0/preview/scim/v2/Users?excludedAttributes=roles&filter=userName%20eq%20%22searching_error%22",
+			Resource: "/api/2.0/preview/scim/v2/Users?count=100&excludedAttributes=roles&filter=userName%20eq%20%22searching_error%22&startIndex=1",
 			Status:   404,
 			Response: apierr.APIError{
 				Message: "searching_error",
@@ -62,7 +62,7 @@ func TestDataSourceUserGerUser(t *testing.T) {
 		},
 		{
 			Method:   "GET",
-			Resource: "/api/2.0/preview/scim/v2/Users?excludedAttributes=roles&filter=userName%20eq%20%22empty_search%22",
+			Resource: "/api/2.0/preview/scim/v2/Users?count=100&excludedAttributes=roles&filter=userName%20eq%20%22empty_search%22&startIndex=1",
 			Response: UserList{},
 		},
 	}, func(ctx context.Context, client *common.DatabricksClient) {
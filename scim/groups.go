@@ -39,15 +39,19 @@ func (a GroupsAPI) Read(groupID, attributes string) (group Group, err error) {
 	return
 }
 
+// List returns the iterator over all groups matching the filter, with only requested attributes
+func (a GroupsAPI) List(filter, attributes string) *Iterator[Group] {
+	return newIterator[Group](a.context, a.client, "/preview/scim/v2/Groups", filter, attributes, "")
+}
+
 // Filter returns groups matching the filter
 func (a GroupsAPI) Filter(filter string) (GroupList, error) {
-	var groups GroupList
-	req := map[string]string{}
-	if filter != "" {
-		req["filter"] = filter
-	}
-	err := a.client.Scim(a.context, http.MethodGet, "/preview/scim/v2/Groups", req, &groups)
-	return groups, err
+	it := a.List(filter, "")
+	groups, err := it.All()
+	return GroupList{
+		TotalResults: int32(it.TotalResults),
+		Resources:    groups,
+	}, err
 }
 
 func (a GroupsAPI) ReadByDisplayName(displayName, attributes string) (group Group, err error) {
@@ -63,11 +67,17 @@ func (a GroupsAPI) ReadByDisplayName(displayName, attributes string) (group Grou
 	return
 }
 
+// groupsMutex serializes modifications of the same group, as PUT of name and entitlements
+// would otherwise overwrite members, that were added concurrently by other resources
+var groupsMutex common.NamedMutex
+
 func (a GroupsAPI) Patch(groupID string, r patchRequest) error {
+	defer groupsMutex.Lock(groupID)()
 	return a.client.Scim(a.context, http.MethodPatch, fmt.Sprintf("/preview/scim/v2/Groups/%v", groupID), r, nil)
 }
 
 func (a GroupsAPI) UpdateNameAndEntitlements(groupID string, name string, externalID string, e entitlements) error {
+	defer groupsMutex.Lock(groupID)()
 	g, err := a.Read(groupID, "displayName,entitlements,groups,members,externalId")
 	if err != nil {
 		return err
@@ -86,6 +96,7 @@ func (a GroupsAPI) UpdateNameAndEntitlements(groupID string, name string, extern
 }
 
 func (a GroupsAPI) UpdateEntitlements(groupID string, entitlements patchRequest) error {
+	defer groupsMutex.Lock(groupID)()
 	return a.client.Scim(a.context, http.MethodPatch,
 		fmt.Sprintf("/preview/scim/v2/Groups/%v", groupID), entitlements, nil)
 }
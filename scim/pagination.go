@@ -0,0 +1,99 @@
+package scim
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+// pageSize is the number of entities requested per page. Account-level SCIM API may return fewer entities
+// than requested, so iteration advances by the number of returned entities instead of the page size.
+var pageSize = 100
+
+type listResponse[T any] struct {
+	TotalResults int32 `json:"totalResults,omitempty"`
+	StartIndex   int32 `json:"startIndex,omitempty"`
+	ItemsPerPage int32 `json:"itemsPerPage,omitempty"`
+	Resources    []T   `json:"resources,omitempty"`
+}
+
+// Iterator fetches entities of SCIM listings page by page. It keeps the index of the next page, so
+// the iteration could be resumed after an error, i.e. when the rate limit is exceeded.
+type Iterator[T any] struct {
+	client  *common.DatabricksClient
+	context context.Context
+	path    string
+	request map[string]string
+	// StartIndex is the 1-based index of the first entity on the next page
+	StartIndex int
+	// TotalResults is the number of entities reported by the last fetched page
+	TotalResults int
+	done         bool
+}
+
+func newIterator[T any](ctx context.Context, client *common.DatabricksClient, path, filter, attributes,
+	excludedAttributes string) *Iterator[T] {
+	request := map[string]string{}
+	if filter != "" {
+		request["filter"] = filter
+	}
+	if attributes != "" {
+		request["attributes"] = attributes
+	}
+	if excludedAttributes != "" {
+		request["excludedAttributes"] = excludedAttributes
+	}
+	return &Iterator[T]{
+		client:     client,
+		context:    ctx,
+		path:       path,
+		request:    request,
+		StartIndex: 1,
+	}
+}
+
+// HasNext returns true if there could be more entities to fetch
+func (it *Iterator[T]) HasNext() bool {
+	return !it.done
+}
+
+// Next fetches the next page of entities. The same page is requested again after an error.
+func (it *Iterator[T]) Next() ([]T, error) {
+	if it.done {
+		return nil, nil
+	}
+	request := map[string]string{
+		"startIndex": strconv.Itoa(it.StartIndex),
+		"count":      strconv.Itoa(pageSize),
+	}
+	for k, v := range it.request {
+		request[k] = v
+	}
+	var page listResponse[T]
+	err := it.client.Scim(it.context, http.MethodGet, it.path, request, &page)
+	if err != nil {
+		return nil, err
+	}
+	it.TotalResults = int(page.TotalResults)
+	it.StartIndex += len(page.Resources)
+	// responses without the total number of results aren't paginated
+	if len(page.Resources) == 0 || it.StartIndex > it.TotalResults {
+		it.done = true
+	}
+	return page.Resources, nil
+}
+
+// All fetches all remaining entities
+func (it *Iterator[T]) All() ([]T, error) {
+	var all []T
+	for it.HasNext() {
+		page, err := it.Next()
+		if err != nil {
+			return all, err
+		}
+		all = append(all, page...)
+	}
+	return all, nil
+}
@@ -0,0 +1,93 @@
+package scim
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/qa"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupsListCappedPages(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/Groups?attributes=id&count=100&startIndex=1",
+			Response: GroupList{
+				Resources:    []Group{{ID: "a"}, {ID: "b"}},
+				TotalResults: 3,
+				StartIndex:   1,
+				ItemsPerPage: 2,
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/Groups?attributes=id&count=100&startIndex=3",
+			Response: GroupList{
+				Resources:    []Group{{ID: "c"}},
+				TotalResults: 3,
+				StartIndex:   3,
+				ItemsPerPage: 1,
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		groups, err := NewGroupsAPI(ctx, client).List("", "id").All()
+		require.NoError(t, err)
+		assert.Equal(t, []Group{{ID: "a"}, {ID: "b"}, {ID: "c"}}, groups)
+	})
+}
+
+func TestUsersListResumesAfterError(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/Users?count=100&filter=active%20eq%20true&startIndex=1",
+			Response: UserList{
+				Resources:    []User{{ID: "a"}},
+				TotalResults: 2,
+				StartIndex:   1,
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/Users?count=100&filter=active%20eq%20true&startIndex=2",
+			Response: apierr.APIErrorBody{
+				ErrorCode: "INVALID_STATE",
+				Message:   "Something went wrong",
+			},
+			Status: 400,
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		it := NewUsersAPI(ctx, client).List("active eq true", "", "")
+		users, err := it.All()
+		assert.EqualError(t, err, "Something went wrong")
+		assert.Equal(t, []User{{ID: "a"}}, users)
+		assert.True(t, it.HasNext())
+		assert.Equal(t, 2, it.StartIndex)
+	})
+}
+
+func TestServicePrincipalsListWithoutTotalResults(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/ServicePrincipals?count=100&startIndex=1",
+			Response: UserList{
+				Resources: []User{{ID: "a"}, {ID: "b"}},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		it := NewServicePrincipalsAPI(ctx, client).List("", "", "")
+		sps, err := it.All()
+		require.NoError(t, err)
+		assert.Len(t, sps, 2)
+		assert.False(t, it.HasNext())
+		page, err := it.Next()
+		require.NoError(t, err)
+		assert.Nil(t, page)
+	})
+}
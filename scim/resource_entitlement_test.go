@@ -429,6 +429,26 @@ func TestResourceEntitlementsUserRead(t *testing.T) {
 	})
 }
 
+func TestResourceEntitlementsUserRead_Import(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Users/abc?attributes=entitlements",
+				Response: oldUser,
+			},
+		},
+		Resource: ResourceEntitlements(),
+		New:      true,
+		Read:     true,
+		ID:       "user/abc",
+	}.ApplyAndExpectData(t, map[string]any{
+		"user_id":              "abc",
+		"group_id":             "",
+		"allow_cluster_create": true,
+	})
+}
+
 func TestResourceEntitlementsUserRead_Error(t *testing.T) {
 	qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
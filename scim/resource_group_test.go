@@ -355,7 +355,7 @@ func TestCreateForceOverwriteCannotListGroups(t *testing.T) {
 	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
 		{
 			Method:   "GET",
-			Resource: "/api/2.0/preview/scim/v2/Groups?filter=displayName%20eq%20%22abc%22",
+			Resource: "/api/2.0/preview/scim/v2/Groups?count=100&filter=displayName%20eq%20%22abc%22&startIndex=1",
 			Status:   417,
 			Response: apierr.APIError{
 				Message: "cannot find group",
@@ -377,7 +377,7 @@ func TestCreateForceOverwriteFindsAndSetsGroupID(t *testing.T) {
 	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
 		{
 			Method:   "GET",
-			Resource: "/api/2.0/preview/scim/v2/Groups?filter=displayName%20eq%20%22abc%22",
+			Resource: "/api/2.0/preview/scim/v2/Groups?count=100&filter=displayName%20eq%20%22abc%22&startIndex=1",
 			Response: GroupList{
 				Resources: []Group{
 					{
@@ -45,21 +45,18 @@ func (a ServicePrincipalsAPI) Read(servicePrincipalID string, attributes string)
 }
 
 func (a ServicePrincipalsAPI) Filter(filter string, excludeRoles bool) (u []User, err error) {
-	var sps UserList
-	req := map[string]string{}
-	if filter != "" {
-		req["filter"] = filter
-	}
+	excludedAttributes := ""
 	// We exclude roles to reduce load on the scim service
 	if excludeRoles {
-		req["excludedAttributes"] = "roles"
-	}
-	err = a.client.Scim(a.context, http.MethodGet, "/preview/scim/v2/ServicePrincipals", req, &sps)
-	if err != nil {
-		return
+		excludedAttributes = "roles"
 	}
-	u = sps.Resources
-	return
+	return a.List(filter, "", excludedAttributes).All()
+}
+
+// List returns the iterator over all service principals matching the filter, with only requested attributes
+func (a ServicePrincipalsAPI) List(filter, attributes, excludedAttributes string) *Iterator[User] {
+	return newIterator[User](a.context, a.client, "/preview/scim/v2/ServicePrincipals", filter, attributes,
+		excludedAttributes)
 }
 
 // Patch updates resource-friendly entity
@@ -556,7 +556,7 @@ func TestCreateForceOverwriteCannotListServicePrincipals(t *testing.T) {
 	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
 		{
 			Method:   "GET",
-			Resource: fmt.Sprintf("/api/2.0/preview/scim/v2/ServicePrincipals?excludedAttributes=roles&filter=applicationId%%20eq%%20%%22%s%%22", appID),
+			Resource: fmt.Sprintf("/api/2.0/preview/scim/v2/ServicePrincipals?count=100&excludedAttributes=roles&filter=applicationId%%20eq%%20%%22%s%%22&startIndex=1", appID),
 			Status:   417,
 			Response: apierr.APIError{
 				Message: "cannot find service principal",
@@ -579,7 +579,7 @@ func TestCreateForceOverwriteCannotListAccServicePrincipals(t *testing.T) {
 	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
 		{
 			Method:   "GET",
-			Resource: fmt.Sprintf("/api/2.0/preview/scim/v2/ServicePrincipals?excludedAttributes=roles&filter=applicationId%%20eq%%20%%22%s%%22", appID),
+			Resource: fmt.Sprintf("/api/2.0/preview/scim/v2/ServicePrincipals?count=100&excludedAttributes=roles&filter=applicationId%%20eq%%20%%22%s%%22&startIndex=1", appID),
 			Response: UserList{
 				TotalResults: 0,
 			},
@@ -601,7 +601,7 @@ func TestCreateForceOverwriteFindsAndSetsServicePrincipalID(t *testing.T) {
 	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
 		{
 			Method:   "GET",
-			Resource: fmt.Sprintf("/api/2.0/preview/scim/v2/ServicePrincipals?excludedAttributes=roles&filter=applicationId%%20eq%%20%%22%s%%22", appID),
+			Resource: fmt.Sprintf("/api/2.0/preview/scim/v2/ServicePrincipals?count=100&excludedAttributes=roles&filter=applicationId%%20eq%%20%%22%s%%22&startIndex=1", appID),
 			Response: UserList{
 				Resources: []User{
 					{
@@ -3,11 +3,13 @@ package scim
 import (
 	"context"
 	"fmt"
+	"log"
 	"strings"
 
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/workspace"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func userExistsErrorMessage(userName string, isAccount bool) string {
@@ -22,6 +24,24 @@ const (
 	userAttributes = "userName,displayName,active,externalId,entitlements"
 )
 
+// attributes of the user that could be managed by external SCIM provisioner, like Okta or AAD
+var scimManagedUserAttributes = []string{"display_name", "active", "external_id"}
+
+// suppressScimManagedDiff ignores changes of the attribute made outside of Terraform, if attribute is
+// listed in `ignore_scim_changes`. New users are always created with configured values.
+func suppressScimManagedDiff(field string, next schema.SchemaDiffSuppressFunc) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		if d.Id() != "" && d.Get("ignore_scim_changes").(*schema.Set).Contains(field) {
+			log.Printf("[DEBUG] Ignoring change of %s managed by SCIM provisioner: platform=%#v config=%#v", k, old, new)
+			return true
+		}
+		if next != nil {
+			return next(k, old, new, d)
+		}
+		return false
+	}
+}
+
 // ResourceUser manages users within workspace
 func ResourceUser() common.Resource {
 	type entity struct {
@@ -67,6 +87,17 @@ func ResourceUser() common.Resource {
 				Optional: true,
 				Computed: true,
 			}
+			m["ignore_scim_changes"] = &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(scimManagedUserAttributes, false),
+				},
+			}
+			for _, field := range scimManagedUserAttributes {
+				m[field].DiffSuppressFunc = suppressScimManagedDiff(field, m[field].DiffSuppressFunc)
+			}
 			return m
 		})
 	scimUserFromData := func(d *schema.ResourceData) (user User, err error) {
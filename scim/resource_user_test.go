@@ -336,6 +336,74 @@ func TestResourceUserUpdate(t *testing.T) {
 	assert.Equal(t, true, d.Get("allow_instance_pool_create"))
 }
 
+func TestResourceUserUpdate_IgnoreScimChanges(t *testing.T) {
+	newUser := User{
+		Schemas:     []URN{UserSchema},
+		DisplayName: "Name From IdP",
+		UserName:    "me@example.com",
+		Active:      false,
+		Entitlements: entitlements{
+			{
+				Value: "allow-cluster-create",
+			},
+		},
+	}
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Users/abc?attributes=groups,roles",
+				Response: User{
+					ID: "abc",
+				},
+			},
+			{
+				Method:          "PUT",
+				Resource:        "/api/2.0/preview/scim/v2/Users/abc",
+				ExpectedRequest: newUser,
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Users/abc?attributes=userName,displayName,active,externalId,entitlements",
+				Response: newUser,
+			},
+		},
+		Resource: ResourceUser(),
+		Update:   true,
+		ID:       "abc",
+		InstanceState: map[string]string{
+			"id":                    "abc",
+			"user_name":             "me@example.com",
+			"display_name":          "Name From IdP",
+			"active":                "false",
+			"ignore_scim_changes.#": "2",
+			"ignore_scim_changes.0": "display_name",
+			"ignore_scim_changes.1": "active",
+		},
+		HCL: `
+		user_name    = "me@example.com"
+		display_name = "Configured Name"
+		active = true
+		allow_cluster_create = true
+		ignore_scim_changes = ["display_name", "active"]
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"display_name": "Name From IdP",
+		"active":       false,
+	})
+}
+
+func TestResourceUserCreate_IgnoreScimChangesInvalidAttribute(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceUser(),
+		Create:   true,
+		HCL: `
+		user_name = "me@example.com"
+		ignore_scim_changes = ["user_name"]
+		`,
+	}.ExpectError(t, "invalid config supplied. [ignore_scim_changes] expected ignore_scim_changes.0 to be one of [display_name active external_id], got user_name")
+}
+
 func TestResourceUserUpdate_Error(t *testing.T) {
 	_, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -632,7 +700,7 @@ func TestCreateForceOverwriteCannotListUsers(t *testing.T) {
 	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
 		{
 			Method:   "GET",
-			Resource: "/api/2.0/preview/scim/v2/Users?excludedAttributes=roles&filter=userName%20eq%20%22me%40example.com%22",
+			Resource: "/api/2.0/preview/scim/v2/Users?count=100&excludedAttributes=roles&filter=userName%20eq%20%22me%40example.com%22&startIndex=1",
 			Status:   417,
 			Response: apierr.APIErrorBody{
 				Message: "cannot find user",
@@ -654,7 +722,7 @@ func TestCreateForceOverwriteCannotListAccUsers(t *testing.T) {
 	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
 		{
 			Method:   "GET",
-			Resource: "/api/2.0/preview/scim/v2/Users?excludedAttributes=roles&filter=userName%20eq%20%22me%40example.com%22",
+			Resource: "/api/2.0/preview/scim/v2/Users?count=100&excludedAttributes=roles&filter=userName%20eq%20%22me%40example.com%22&startIndex=1",
 			Response: UserList{
 				TotalResults: 0,
 			},
@@ -675,7 +743,7 @@ func TestCreateForceOverwriteFindsAndSetsID(t *testing.T) {
 	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
 		{
 			Method:   "GET",
-			Resource: "/api/2.0/preview/scim/v2/Users?excludedAttributes=roles&filter=userName%20eq%20%22me%40example.com%22",
+			Resource: "/api/2.0/preview/scim/v2/Users?count=100&excludedAttributes=roles&filter=userName%20eq%20%22me%40example.com%22&startIndex=1",
 			Response: UserList{
 				Resources: []User{
 					{
@@ -717,7 +785,7 @@ func TestCreateForceOverwriteFindsAndSetsAccID(t *testing.T) {
 	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
 		{
 			Method:   "GET",
-			Resource: "/api/2.0/preview/scim/v2/Users?excludedAttributes=roles&filter=userName%20eq%20%22me%40example.com%22",
+			Resource: "/api/2.0/preview/scim/v2/Users?count=100&excludedAttributes=roles&filter=userName%20eq%20%22me%40example.com%22&startIndex=1",
 			Response: UserList{
 				Resources: []User{
 					{
@@ -31,23 +31,19 @@ func (a UsersAPI) Create(ru User) (user User, err error) {
 	return user, err
 }
 
+// List returns the iterator over all users matching the filter, with only requested attributes
+func (a UsersAPI) List(filter, attributes, excludedAttributes string) *Iterator[User] {
+	return newIterator[User](a.context, a.client, "/preview/scim/v2/Users", filter, attributes, excludedAttributes)
+}
+
 // Filter retrieves users by filter
 func (a UsersAPI) Filter(filter string, excludeRoles bool) (u []User, err error) {
-	var users UserList
-	req := map[string]string{}
-	if filter != "" {
-		req["filter"] = filter
-	}
+	excludedAttributes := ""
 	// We exclude roles to reduce load on the scim service
 	if excludeRoles {
-		req["excludedAttributes"] = "roles"
+		excludedAttributes = "roles"
 	}
-	err = a.client.Scim(a.context, http.MethodGet, "/preview/scim/v2/Users", req, &users)
-	if err != nil {
-		return
-	}
-	u = users.Resources
-	return
+	return a.List(filter, "", excludedAttributes).All()
 }
 
 func (a UsersAPI) Read(userID, attributes string) (User, error) {
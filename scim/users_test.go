@@ -14,7 +14,7 @@ func TestUsersFilter(t *testing.T) {
 	client, server, err := qa.HttpFixtureClient(t, []qa.HTTPFixture{
 		{
 			Method:   "GET",
-			Resource: "/api/2.0/preview/scim/v2/Users?excludedAttributes=roles",
+			Resource: "/api/2.0/preview/scim/v2/Users?count=100&excludedAttributes=roles&startIndex=1",
 
 			Response: UserList{
 				Resources: []User{
@@ -24,7 +24,7 @@ func TestUsersFilter(t *testing.T) {
 		},
 		{
 			Method:   "GET",
-			Resource: "/api/2.0/preview/scim/v2/Users?excludedAttributes=roles&filter=userName%20eq%20somebody",
+			Resource: "/api/2.0/preview/scim/v2/Users?count=100&excludedAttributes=roles&filter=userName%20eq%20somebody&startIndex=1",
 			Response: UserList{},
 		},
 	})
@@ -57,26 +57,28 @@ func (a SecretAclsAPI) List(scope string) ([]ACLItem, error) {
 
 // ResourceSecretACL manages access to secret scopes
 func ResourceSecretACL() common.Resource {
-	p := common.NewPairSeparatedID("scope", "principal", "|||")
-	return common.Resource{
-		Schema: map[string]*schema.Schema{
-			"scope": {
-				Type:         schema.TypeString,
-				ValidateFunc: validScope,
-				Required:     true,
-				ForceNew:     true,
-			},
-			"principal": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-			},
-			"permission": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-			},
+	secretACLID := common.NewCompositeID("|||", "scope", "principal")
+	s := map[string]*schema.Schema{
+		"scope": {
+			Type:         schema.TypeString,
+			ValidateFunc: validScope,
+			Required:     true,
+			ForceNew:     true,
+		},
+		"principal": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"permission": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
 		},
+	}
+	return common.Resource{
+		Schema:      s,
+		CompositeID: secretACLID,
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			if err := NewSecretAclsAPI(ctx, c).Create(
 				d.Get("scope").(string), d.Get("principal").(string),
@@ -84,26 +86,26 @@ func ResourceSecretACL() common.Resource {
 				return err
 			}
 			// TODO: check what happens if ID is set before error happens in create
-			p.Pack(d)
+			secretACLID.PackData(d)
 			return nil
 		},
 		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
-			scope, principal, err := p.Unpack(d)
+			parts, err := secretACLID.UnpackData(d, s)
 			if err != nil {
 				return err
 			}
-			secretACL, err := NewSecretAclsAPI(ctx, c).Read(scope, principal)
+			secretACL, err := NewSecretAclsAPI(ctx, c).Read(parts[0], parts[1])
 			if err != nil {
 				return err
 			}
 			return d.Set("permission", secretACL.Permission)
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
-			scope, principal, err := p.Unpack(d)
+			parts, err := secretACLID.Unpack(d.Id())
 			if err != nil {
 				return err
 			}
-			return NewSecretAclsAPI(ctx, c).Delete(scope, principal)
+			return NewSecretAclsAPI(ctx, c).Delete(parts[0], parts[1])
 		},
 	}
 }
@@ -37,6 +37,14 @@ func TestResourceSecretRead(t *testing.T) {
 	assert.Equal(t, "{{secrets/foo/bar}}", d.Get("config_reference"))
 }
 
+func TestResourceSecretRead_InvalidID(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceSecret(),
+		Read:     true,
+		ID:       "foo|bar",
+	}.ExpectError(t, "invalid ID: foo|bar. Expected format: <scope>|||<key>")
+}
+
 func TestResourceSecretRead_NotFound(t *testing.T) {
 	qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -0,0 +1,88 @@
+package serving
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/serving"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+// servedEntityState describes the readiness of the entity served by the endpoint
+type servedEntityState struct {
+	Name                   string `json:"name,omitempty" tf:"computed"`
+	EntityName             string `json:"entity_name,omitempty" tf:"computed"`
+	EntityVersion          string `json:"entity_version,omitempty" tf:"computed"`
+	Deployment             string `json:"deployment,omitempty" tf:"computed"`
+	DeploymentStateMessage string `json:"deployment_state_message,omitempty" tf:"computed"`
+}
+
+func servedEntityStates(config *serving.EndpointCoreConfigOutput) (entities []servedEntityState) {
+	if config == nil {
+		return
+	}
+	for _, se := range config.ServedEntities {
+		entity := servedEntityState{
+			Name:          se.Name,
+			EntityName:    se.EntityName,
+			EntityVersion: se.EntityVersion,
+		}
+		if se.State != nil {
+			entity.Deployment = string(se.State.Deployment)
+			entity.DeploymentStateMessage = se.State.DeploymentStateMessage
+		}
+		entities = append(entities, entity)
+	}
+	// older endpoints report only served models
+	if len(config.ServedEntities) > 0 {
+		return
+	}
+	for _, sm := range config.ServedModels {
+		entity := servedEntityState{
+			Name:          sm.Name,
+			EntityName:    sm.ModelName,
+			EntityVersion: sm.ModelVersion,
+		}
+		if sm.State != nil {
+			entity.Deployment = string(sm.State.Deployment)
+			entity.DeploymentStateMessage = sm.State.DeploymentStateMessage
+		}
+		entities = append(entities, entity)
+	}
+	return
+}
+
+func DataSourceServingEndpoint() common.Resource {
+	return common.WorkspaceData(func(ctx context.Context, data *struct {
+		Name            string              `json:"name"`
+		EndpointId      string              `json:"endpoint_id,omitempty" tf:"computed"`
+		Ready           string              `json:"ready,omitempty" tf:"computed"`
+		ConfigUpdate    string              `json:"config_update,omitempty" tf:"computed"`
+		ConfigVersion   int                 `json:"config_version,omitempty" tf:"computed"`
+		ServedEntities  []servedEntityState `json:"served_entities,omitempty" tf:"computed"`
+		InvocationUrl   string              `json:"invocation_url,omitempty" tf:"computed"`
+		Creator         string              `json:"creator,omitempty" tf:"computed"`
+		PermissionLevel string              `json:"permission_level,omitempty" tf:"computed"`
+	}, w *databricks.WorkspaceClient) error {
+		endpoint, err := w.ServingEndpoints.GetByName(ctx, data.Name)
+		if err != nil {
+			return err
+		}
+		data.EndpointId = endpoint.Id
+		data.Creator = endpoint.Creator
+		data.PermissionLevel = string(endpoint.PermissionLevel)
+		if endpoint.State != nil {
+			data.Ready = string(endpoint.State.Ready)
+			data.ConfigUpdate = string(endpoint.State.ConfigUpdate)
+		}
+		if endpoint.Config != nil {
+			data.ConfigVersion = endpoint.Config.ConfigVersion
+		}
+		data.ServedEntities = servedEntityStates(endpoint.Config)
+		data.InvocationUrl = fmt.Sprintf("%s/serving-endpoints/%s/invocations",
+			strings.TrimSuffix(w.Config.Host, "/"), endpoint.Name)
+		return nil
+	})
+}
@@ -0,0 +1,125 @@
+package serving
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/service/serving"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSourceServingEndpoint(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/serving-endpoints/test-endpoint?",
+				Response: serving.ServingEndpointDetailed{
+					Id:   "abc",
+					Name: "test-endpoint",
+					State: &serving.EndpointState{
+						Ready:        serving.EndpointStateReadyReady,
+						ConfigUpdate: serving.EndpointStateConfigUpdateNotUpdating,
+					},
+					Config: &serving.EndpointCoreConfigOutput{
+						ConfigVersion: 3,
+						ServedEntities: []serving.ServedEntityOutput{
+							{
+								Name:          "prod_model",
+								EntityName:    "main.default.ads",
+								EntityVersion: "2",
+								State: &serving.ServedModelState{
+									Deployment: serving.ServedModelStateDeploymentReady,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceServingEndpoint(),
+		ID:          ".",
+		HCL:         `name = "test-endpoint"`,
+	}.Apply(t)
+	require.NoError(t, err)
+	assert.Equal(t, "abc", d.Get("endpoint_id"))
+	assert.Equal(t, "READY", d.Get("ready"))
+	assert.Equal(t, "NOT_UPDATING", d.Get("config_update"))
+	assert.Equal(t, 3, d.Get("config_version"))
+	assert.Equal(t, "prod_model", d.Get("served_entities.0.name"))
+	assert.Equal(t, "main.default.ads", d.Get("served_entities.0.entity_name"))
+	assert.Equal(t, "DEPLOYMENT_READY", d.Get("served_entities.0.deployment"))
+	assert.True(t, strings.HasSuffix(d.Get("invocation_url").(string),
+		"/serving-endpoints/test-endpoint/invocations"))
+}
+
+func TestDataSourceServingEndpointServedModels(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/serving-endpoints/test-endpoint?",
+				Response: serving.ServingEndpointDetailed{
+					Name: "test-endpoint",
+					State: &serving.EndpointState{
+						Ready:        serving.EndpointStateReadyNotReady,
+						ConfigUpdate: serving.EndpointStateConfigUpdateInProgress,
+					},
+					Config: &serving.EndpointCoreConfigOutput{
+						ConfigVersion: 1,
+						ServedModels: []serving.ServedModelOutput{
+							{
+								Name:         "prod_model",
+								ModelName:    "ads",
+								ModelVersion: "1",
+								State: &serving.ServedModelState{
+									Deployment:             serving.ServedModelStateDeploymentCreating,
+									DeploymentStateMessage: "Creating",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceServingEndpoint(),
+		ID:          ".",
+		HCL:         `name = "test-endpoint"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"ready":                                      "NOT_READY",
+		"config_update":                              "IN_PROGRESS",
+		"served_entities.0.entity_name":              "ads",
+		"served_entities.0.entity_version":           "1",
+		"served_entities.0.deployment":               "DEPLOYMENT_CREATING",
+		"served_entities.0.deployment_state_message": "Creating",
+	})
+}
+
+func TestDataSourceServingEndpointNotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/serving-endpoints/test-endpoint?",
+				Status:   404,
+				Response: apierr.APIErrorBody{
+					ErrorCode: "RESOURCE_DOES_NOT_EXIST",
+					Message:   "Endpoint test-endpoint does not exist",
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceServingEndpoint(),
+		ID:          ".",
+		HCL:         `name = "test-endpoint"`,
+	}.ExpectError(t, "Endpoint test-endpoint does not exist")
+}
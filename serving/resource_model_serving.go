@@ -2,9 +2,10 @@ package serving
 
 import (
 	"context"
+	"fmt"
 	"time"
 
-	"github.com/databricks/databricks-sdk-go/retries"
+	"github.com/databricks/databricks-sdk-go"
 	"github.com/databricks/databricks-sdk-go/service/serving"
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -12,6 +13,66 @@ import (
 
 const DefaultProvisionTimeout = 45 * time.Minute
 
+// waitForServingEndpoint waits until the configuration update of the serving endpoint is finished
+func waitForServingEndpoint(ctx context.Context, w *databricks.WorkspaceClient, name string, timeout time.Duration) error {
+	return common.WaitConfig{
+		Name:    fmt.Sprintf("serving endpoint %s to be updated", name),
+		Timeout: timeout,
+	}.Poll(ctx, func() (bool, string, error) {
+		endpoint, err := w.ServingEndpoints.GetByName(ctx, name)
+		if err != nil {
+			return false, "", err
+		}
+		if endpoint.State == nil {
+			return false, "state is not yet known", nil
+		}
+		switch endpoint.State.ConfigUpdate {
+		case serving.EndpointStateConfigUpdateNotUpdating:
+			return true, "", nil
+		case serving.EndpointStateConfigUpdateUpdateFailed:
+			return false, "", fmt.Errorf("failed to reach %s, got %s", serving.EndpointStateConfigUpdateNotUpdating,
+				endpoint.State.ConfigUpdate)
+		}
+		return false, fmt.Sprintf("current status: %s", endpoint.State.ConfigUpdate), nil
+	})
+}
+
+// createServingEndpoint adds the budget policy, that isn't yet supported by the Go SDK, to the create request
+type createServingEndpoint struct {
+	serving.CreateServingEndpoint
+	BudgetPolicyId string `json:"budget_policy_id,omitempty"`
+}
+
+// tagsPatch returns the request to change endpoint tags from old to new values in place
+func tagsPatch(name string, old, new []serving.EndpointTag) serving.PatchServingEndpointTags {
+	patch := serving.PatchServingEndpointTags{Name: name}
+	newTags := map[string]string{}
+	for _, tag := range new {
+		newTags[tag.Key] = tag.Value
+	}
+	oldTags := map[string]string{}
+	for _, tag := range old {
+		oldTags[tag.Key] = tag.Value
+		if _, ok := newTags[tag.Key]; !ok {
+			patch.DeleteTags = append(patch.DeleteTags, tag.Key)
+		}
+	}
+	for _, tag := range new {
+		if value, ok := oldTags[tag.Key]; !ok || value != tag.Value {
+			patch.AddTags = append(patch.AddTags, tag)
+		}
+	}
+	return patch
+}
+
+func getTags(v any) (tags []serving.EndpointTag) {
+	for _, raw := range v.([]any) {
+		m := raw.(map[string]any)
+		tags = append(tags, serving.EndpointTag{Key: m["key"].(string), Value: m["value"].(string)})
+	}
+	return
+}
+
 func ResourceModelServing() common.Resource {
 	s := common.StructToSchema(
 		serving.CreateServingEndpoint{},
@@ -34,11 +95,24 @@ func ResourceModelServing() common.Resource {
 				Computed: true,
 				Type:     schema.TypeString,
 			}
+			// the budget policy can only be set on creation of the endpoint, and it isn't returned by the API,
+			// so changes of it for existing endpoints are rejected during plan
+			m["budget_policy_id"] = &schema.Schema{
+				Optional: true,
+				Type:     schema.TypeString,
+			}
 
 			return m
 		})
 
 	return common.Resource{
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff) error {
+			if d.Id() != "" && d.HasChange("budget_policy_id") {
+				return fmt.Errorf("budget_policy_id of the existing serving endpoint can't be changed, " +
+					"change it in the workspace UI instead")
+			}
+			return nil
+		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
 			if err != nil {
@@ -46,12 +120,19 @@ func ResourceModelServing() common.Resource {
 			}
 			var e serving.CreateServingEndpoint
 			common.DataToStructPointer(d, s, &e)
-			endpoint, err := w.ServingEndpoints.CreateAndWait(ctx, e, retries.Timeout[serving.ServingEndpointDetailed](d.Timeout(schema.TimeoutCreate)))
+			if budgetPolicyId := d.Get("budget_policy_id").(string); budgetPolicyId != "" {
+				err = c.Post(ctx, "/serving-endpoints", createServingEndpoint{
+					CreateServingEndpoint: e,
+					BudgetPolicyId:        budgetPolicyId,
+				}, nil)
+			} else {
+				_, err = w.ServingEndpoints.Create(ctx, e)
+			}
 			if err != nil {
 				return err
 			}
-			d.SetId(endpoint.Name)
-			return nil
+			d.SetId(e.Name)
+			return waitForServingEndpoint(ctx, w, e.Name, d.Timeout(schema.TimeoutCreate))
 		},
 		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
@@ -76,9 +157,22 @@ func ResourceModelServing() common.Resource {
 			}
 			var e serving.CreateServingEndpoint
 			common.DataToStructPointer(d, s, &e)
+			if d.HasChange("tags") {
+				old, new := d.GetChange("tags")
+				_, err = w.ServingEndpoints.Patch(ctx, tagsPatch(e.Name, getTags(old), getTags(new)))
+				if err != nil {
+					return err
+				}
+			}
+			if !d.HasChange("config") {
+				return nil
+			}
 			e.Config.Name = e.Name
-			_, err = w.ServingEndpoints.UpdateConfigAndWait(ctx, e.Config, retries.Timeout[serving.ServingEndpointDetailed](d.Timeout(schema.TimeoutUpdate)))
-			return err
+			_, err = w.ServingEndpoints.UpdateConfig(ctx, e.Config)
+			if err != nil {
+				return err
+			}
+			return waitForServingEndpoint(ctx, w, e.Name, d.Timeout(schema.TimeoutUpdate))
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClient()
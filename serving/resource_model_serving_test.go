@@ -1,12 +1,15 @@
 package serving
 
 import (
+	"context"
 	"net/http"
 	"testing"
 
 	"github.com/databricks/databricks-sdk-go/apierr"
 	"github.com/databricks/databricks-sdk-go/service/serving"
 	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestModelServingCornerCases(t *testing.T) {
@@ -140,6 +143,73 @@ func TestModelServingCreate(t *testing.T) {
 	}.ApplyNoError(t)
 }
 
+func TestModelServingCreateWithBudgetPolicy(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/serving-endpoints",
+				ExpectedRequest: createServingEndpoint{
+					CreateServingEndpoint: serving.CreateServingEndpoint{
+						Name: "test-endpoint",
+						Config: serving.EndpointCoreConfigInput{
+							ServedModels: []serving.ServedModelInput{
+								{
+									Name:               "prod_model",
+									ModelName:          "ads1",
+									ModelVersion:       "2",
+									WorkloadSize:       "Small",
+									ScaleToZeroEnabled: true,
+								},
+							},
+						},
+						Tags: []serving.EndpointTag{{Key: "team", Value: "ads"}},
+					},
+					BudgetPolicyId: "policy-1",
+				},
+				Response: serving.ServingEndpointDetailed{
+					Name: "test-endpoint",
+				},
+			},
+			{
+				Method:       http.MethodGet,
+				ReuseRequest: true,
+				Resource:     "/api/2.0/serving-endpoints/test-endpoint?",
+				Response: serving.ServingEndpointDetailed{
+					Id:   "test-endpoint",
+					Name: "test-endpoint",
+					State: &serving.EndpointState{
+						ConfigUpdate: serving.EndpointStateConfigUpdateNotUpdating,
+					},
+					Tags: []serving.EndpointTag{{Key: "team", Value: "ads"}},
+				},
+			},
+		},
+		Resource: ResourceModelServing(),
+		HCL: `
+			name = "test-endpoint"
+			budget_policy_id = "policy-1"
+			config {
+				served_models {
+					name = "prod_model"
+					model_name = "ads1"
+					model_version = "2"
+					workload_size = "Small"
+					scale_to_zero_enabled = true
+				}
+			}
+			tags {
+				key = "team"
+				value = "ads"
+			}
+			`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"budget_policy_id": "policy-1",
+		"tags.0.key":       "team",
+	})
+}
+
 func TestModelServingCreateGPU(t *testing.T) {
 	qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -454,6 +524,123 @@ func TestModelServingUpdate(t *testing.T) {
 	}.ApplyNoError(t)
 }
 
+func TestModelServingUpdateTags(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPatch,
+				Resource: "/api/2.0/serving-endpoints/test-endpoint/tags",
+				ExpectedRequest: serving.PatchServingEndpointTags{
+					AddTags:    []serving.EndpointTag{{Key: "team", Value: "ml"}},
+					DeleteTags: []string{"env"},
+				},
+				Response: []serving.EndpointTag{{Key: "team", Value: "ml"}},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/serving-endpoints/test-endpoint?",
+				Response: serving.ServingEndpointDetailed{
+					Id:   "test-endpoint",
+					Name: "test-endpoint",
+					Tags: []serving.EndpointTag{{Key: "team", Value: "ml"}},
+				},
+			},
+		},
+		Resource: ResourceModelServing(),
+		Update:   true,
+		ID:       "test-endpoint",
+		InstanceState: map[string]string{
+			"name":                                           "test-endpoint",
+			"config.#":                                       "1",
+			"config.0.served_models.#":                       "1",
+			"config.0.served_models.0.name":                  "prod_model",
+			"config.0.served_models.0.model_name":            "ads1",
+			"config.0.served_models.0.model_version":         "2",
+			"config.0.served_models.0.workload_size":         "Small",
+			"config.0.served_models.0.workload_type":         "CPU",
+			"config.0.served_models.0.scale_to_zero_enabled": "true",
+			"tags.#":       "2",
+			"tags.0.key":   "team",
+			"tags.0.value": "ads",
+			"tags.1.key":   "env",
+			"tags.1.value": "prod",
+		},
+		HCL: `
+			name = "test-endpoint"
+			config {
+				served_models {
+					name = "prod_model"
+					model_name = "ads1"
+					model_version = "2"
+					workload_size = "Small"
+				}
+			}
+			tags {
+				key = "team"
+				value = "ml"
+			}
+			`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"tags.0.value": "ml",
+	})
+}
+
+func TestModelServingUpdateBudgetPolicy(t *testing.T) {
+	// qa.ResourceFixture plans without the resource ID, so the diff of an existing endpoint is computed here
+	state := &terraform.InstanceState{
+		ID: "test-endpoint",
+		Attributes: map[string]string{
+			"name":                                           "test-endpoint",
+			"budget_policy_id":                               "policy-1",
+			"config.#":                                       "1",
+			"config.0.served_models.#":                       "1",
+			"config.0.served_models.0.name":                  "prod_model",
+			"config.0.served_models.0.model_name":            "ads1",
+			"config.0.served_models.0.model_version":         "2",
+			"config.0.served_models.0.workload_size":         "Small",
+			"config.0.served_models.0.workload_type":         "CPU",
+			"config.0.served_models.0.scale_to_zero_enabled": "true",
+		},
+	}
+	config := func(budgetPolicyID string) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]any{
+			"name":             "test-endpoint",
+			"budget_policy_id": budgetPolicyID,
+			"config": []any{map[string]any{
+				"served_models": []any{map[string]any{
+					"name":          "prod_model",
+					"model_name":    "ads1",
+					"model_version": "2",
+					"workload_size": "Small",
+				}},
+			}},
+		})
+	}
+	r := ResourceModelServing().ToResource()
+	_, err := r.Diff(context.Background(), state, config("policy-1"), nil)
+	assert.NoError(t, err)
+	_, err = r.Diff(context.Background(), state, config("policy-2"), nil)
+	assert.EqualError(t, err, "budget_policy_id of the existing serving endpoint can't be changed, "+
+		"change it in the workspace UI instead")
+}
+
+func TestTagsPatch(t *testing.T) {
+	patch := tagsPatch("a", []serving.EndpointTag{
+		{Key: "same", Value: "1"},
+		{Key: "changed", Value: "1"},
+		{Key: "removed", Value: "1"},
+	}, []serving.EndpointTag{
+		{Key: "same", Value: "1"},
+		{Key: "changed", Value: "2"},
+		{Key: "added", Value: "1"},
+	})
+	assert.Equal(t, serving.PatchServingEndpointTags{
+		Name:       "a",
+		AddTags:    []serving.EndpointTag{{Key: "changed", Value: "2"}, {Key: "added", Value: "1"}},
+		DeleteTags: []string{"removed"},
+	}, patch)
+}
+
 func TestModelServingUpdate_Error(t *testing.T) {
 	qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
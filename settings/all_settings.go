@@ -2,7 +2,6 @@ package settings
 
 import (
 	"github.com/databricks/databricks-sdk-go"
-	"github.com/databricks/databricks-sdk-go/service/settings"
 	"github.com/databricks/terraform-provider-databricks/common"
 )
 
@@ -13,8 +12,20 @@ import (
 //     If the setting name is user-settable, it will be provided in the third argument to the updateFunc method. If not, you must set the
 //     SettingName field appropriately. You must also set AllowMissing: true and the field mask to the field to update.
 //  3. Add a new entry to the AllSettingsResources map below. The final resource name will be "databricks_<SETTING_NAME>_setting".
+//  4. Add the same entry to the AllSettingsLevels map below.
 func AllSettingsResources() map[string]common.Resource {
 	return map[string]common.Resource{
-		"default_namespace": makeSettingResource[settings.DefaultNamespaceSetting, *databricks.WorkspaceClient](defaultNamespaceSetting),
+		"default_namespace": makeSettingResource[defaultNamespaceSetting, *databricks.WorkspaceClient](defaultNamespace),
+		// escape hatch for settings without a dedicated resource
+		"generic": ResourceGenericSetting(),
+	}
+}
+
+// AllSettingsLevels returns levels of the provider configuration (`workspace` or `account`), where
+// every setting from AllSettingsResources could be used
+func AllSettingsLevels() map[string][]string {
+	return map[string][]string{
+		"default_namespace": {"workspace"},
+		"generic":           {"workspace", "account"},
 	}
 }
@@ -0,0 +1,15 @@
+package settings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllSettingsHaveLevels(t *testing.T) {
+	levels := AllSettingsLevels()
+	for name := range AllSettingsResources() {
+		assert.NotEmpty(t, levels[name], "level of %s setting isn't specified", name)
+	}
+	assert.Len(t, levels, len(AllSettingsResources()))
+}
@@ -221,46 +221,73 @@ func makeSettingResource[T, U any](defn genericSettingDefinition[T, U]) common.R
 		return nil
 	}
 
+	// Settings return 404 until they are written for the first time. In that case the error
+	// carries the current etag, so the setting is read as its zero value instead of being
+	// removed from the state.
+	readOrDefault := func(res *T, err error) (*T, error) {
+		if !errors.Is(err, apierr.ErrNotFound) {
+			return res, err
+		}
+		etag, etagErr := getEtagFromError(err)
+		if etagErr != nil {
+			return nil, err
+		}
+		var setting T
+		defn.SetETag(&setting, etag)
+		return &setting, nil
+	}
+	read := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+		var res *T
+		switch defn := defn.(type) {
+		case workspaceSettingDefinition[T]:
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			res, err = readOrDefault(defn.Read(ctx, w, d.Id()))
+			if err != nil {
+				return err
+			}
+		case accountSettingDefinition[T]:
+			a, err := c.AccountClient()
+			if err != nil {
+				return err
+			}
+			res, err = readOrDefault(defn.Read(ctx, a, d.Id()))
+			if err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unexpected setting type: %T", defn)
+		}
+		err := common.StructToData(res, resourceSchema, d)
+		if err != nil {
+			return err
+		}
+		// Update the etag. The server will accept any etag and respond
+		// with a response which is at least as recent as the etag.
+		// Updating, while not always necessary, ensures that the
+		// server responds with an updated response.
+		d.SetId(defn.GetETag(res))
+		return nil
+	}
+
 	return common.Resource{
 		Schema: resourceSchema,
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			var setting T
 			return createOrUpdate(ctx, d, c, setting)
 		},
-		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
-			var res *T
-			switch defn := defn.(type) {
-			case workspaceSettingDefinition[T]:
-				w, err := c.WorkspaceClient()
-				if err != nil {
-					return err
-				}
-				res, err = defn.Read(ctx, w, d.Id())
-				if err != nil {
-					return err
-				}
-			case accountSettingDefinition[T]:
-				a, err := c.AccountClient()
-				if err != nil {
-					return err
-				}
-				res, err = defn.Read(ctx, a, d.Id())
-				if err != nil {
-					return err
-				}
-			default:
-				return fmt.Errorf("unexpected setting type: %T", defn)
-			}
-			err := common.StructToData(res, resourceSchema, d)
-			if err != nil {
-				return err
-			}
-			// Update the etag. The server will accept any etag and respond
-			// with a response which is at least as recent as the etag.
-			// Updating, while not always necessary, ensures that the
-			// server responds with an updated response.
-			d.SetId(defn.GetETag(res))
-			return nil
+		Read: read,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m any) ([]*schema.ResourceData, error) {
+				// There's only one instance of the setting, so the import ID is ignored and the
+				// etag is bootstrapped from the latest version of the setting.
+				d.SetId("")
+				d.MarkNewResource()
+				err := read(ctx, d, m.(*common.DatabricksClient))
+				return []*schema.ResourceData{d}, err
+			},
 		},
 		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			var setting T
@@ -1,14 +1,17 @@
 package settings
 
 import (
+	"context"
 	"testing"
 
 	"github.com/databricks/databricks-sdk-go/apierr"
 	"github.com/databricks/databricks-sdk-go/experimental/mocks"
 	"github.com/databricks/databricks-sdk-go/service/settings"
+	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/qa"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 // Choose an arbitrary setting to test.
@@ -109,6 +112,61 @@ func TestQueryReadDefaultNameSetting(t *testing.T) {
 	assert.Equal(t, "etag2", d.Id())
 	res := d.Get("namespace").([]interface{})[0].(map[string]interface{})
 	assert.Equal(t, "namespace_value", res["value"])
+	assert.Equal(t, "namespace_value", d.Get("effective_namespace"))
+}
+
+func TestQueryReadDefaultNameSettingNeverWritten(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockSettingsAPI().EXPECT().ReadDefaultWorkspaceNamespace(mock.Anything, settings.ReadDefaultWorkspaceNamespaceRequest{
+				Etag: "etag1",
+			}).Return(nil, &apierr.APIError{
+				ErrorCode:  "NOT_FOUND",
+				StatusCode: 404,
+				Message:    "SomeMessage",
+				Details: []apierr.ErrorDetail{{
+					Type: "type.googleapis.com/google.rpc.ErrorInfo",
+					Metadata: map[string]string{
+						"etag": "etag2",
+					},
+				}},
+			})
+		},
+		Resource: testSetting,
+		Read:     true,
+		New:      true,
+		ID:       "etag1",
+	}.Apply(t)
+
+	assert.NoError(t, err)
+
+	assert.Equal(t, "etag2", d.Id())
+	assert.Equal(t, "", d.Get("namespace.0.value"))
+	assert.Equal(t, "", d.Get("effective_namespace"))
+}
+
+func TestQueryImportDefaultNameSetting(t *testing.T) {
+	qa.MockWorkspaceApply(t, func(w *mocks.MockWorkspaceClient) {
+		w.GetMockSettingsAPI().EXPECT().ReadDefaultWorkspaceNamespace(mock.Anything, settings.ReadDefaultWorkspaceNamespaceRequest{
+			Etag: "",
+		}).Return(&settings.DefaultNamespaceSetting{
+			Etag: "etag1",
+			Namespace: settings.StringMessage{
+				Value: "namespace_value",
+			},
+			SettingName: "default",
+		}, nil)
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		r := testSetting.ToResource()
+		d := r.Data(nil)
+		d.SetId("global")
+		datas, err := r.Importer.StateContext(ctx, d, client)
+		require.NoError(t, err)
+		require.Len(t, datas, 1)
+		assert.Equal(t, "etag1", datas[0].Id())
+		assert.Equal(t, "namespace_value", datas[0].Get("namespace.0.value"))
+		assert.Equal(t, "namespace_value", datas[0].Get("effective_namespace"))
+	})
 }
 
 func TestQueryUpdateDefaultNameSetting(t *testing.T) {
@@ -7,19 +7,35 @@ import (
 	"github.com/databricks/databricks-sdk-go/service/settings"
 )
 
+// defaultNamespaceSetting extends the API struct with the read-only namespace in effect
+type defaultNamespaceSetting struct {
+	settings.DefaultNamespaceSetting
+
+	// The namespace that is currently in effect in the workspace. Empty if the setting was never written.
+	EffectiveNamespace string `json:"effective_namespace,omitempty" tf:"computed"`
+}
+
 // Default Namespace Setting
-var defaultNamespaceSetting = workspaceSetting[settings.DefaultNamespaceSetting]{
-	settingStruct: settings.DefaultNamespaceSetting{},
-	readFunc: func(ctx context.Context, w *databricks.WorkspaceClient, etag string) (*settings.DefaultNamespaceSetting, error) {
-		return w.Settings.ReadDefaultWorkspaceNamespace(ctx, settings.ReadDefaultWorkspaceNamespaceRequest{
+var defaultNamespace = workspaceSetting[defaultNamespaceSetting]{
+	settingStruct: defaultNamespaceSetting{},
+	readFunc: func(ctx context.Context, w *databricks.WorkspaceClient, etag string) (*defaultNamespaceSetting, error) {
+		res, err := w.Settings.ReadDefaultWorkspaceNamespace(ctx, settings.ReadDefaultWorkspaceNamespaceRequest{
 			Etag: etag,
 		})
+		if err != nil {
+			return nil, err
+		}
+		return &defaultNamespaceSetting{
+			DefaultNamespaceSetting: *res,
+			EffectiveNamespace:      res.Namespace.Value,
+		}, nil
 	},
-	updateFunc: func(ctx context.Context, w *databricks.WorkspaceClient, t settings.DefaultNamespaceSetting) (string, error) {
-		t.SettingName = "default"
+	updateFunc: func(ctx context.Context, w *databricks.WorkspaceClient, t defaultNamespaceSetting) (string, error) {
+		setting := t.DefaultNamespaceSetting
+		setting.SettingName = "default"
 		res, err := w.Settings.UpdateDefaultWorkspaceNamespace(ctx, settings.UpdateDefaultWorkspaceNamespaceRequest{
 			AllowMissing: true,
-			Setting:      &t,
+			Setting:      &setting,
 			FieldMask:    "namespace.value",
 		})
 		if err != nil {
@@ -0,0 +1,189 @@
+package settings
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// genericSetting is an escape hatch for settings that don't have a dedicated resource yet
+type genericSetting struct {
+	SettingType string `json:"setting_type" tf:"force_new"`
+	Value       string `json:"value"`
+	FieldMask   string `json:"field_mask,omitempty"`
+	Etag        string `json:"etag,omitempty" tf:"computed"`
+}
+
+// server-side fields of the setting, that aren't part of its value
+var genericSettingMetadataFields = []string{"etag", "setting_name"}
+
+func genericSettingPath(settingType string) string {
+	return fmt.Sprintf("/settings/types/%s/names/default", settingType)
+}
+
+func accountSettingPath(accountID, settingType string) string {
+	return fmt.Sprintf("/accounts/%s/settings/types/%s/names/default", accountID, settingType)
+}
+
+// settingFieldMask returns comma-separated paths of all leaf fields in the value
+func settingFieldMask(value map[string]any) string {
+	var paths []string
+	var walk func(prefix string, v map[string]any)
+	walk = func(prefix string, v map[string]any) {
+		for k, field := range v {
+			nested, ok := field.(map[string]any)
+			if ok && len(nested) > 0 {
+				walk(prefix+k+".", nested)
+				continue
+			}
+			paths = append(paths, prefix+k)
+		}
+	}
+	walk("", value)
+	sort.Strings(paths)
+	return strings.Join(paths, ",")
+}
+
+func parseSettingValue(value string) (map[string]any, error) {
+	var v map[string]any
+	if err := json.Unmarshal([]byte(value), &v); err != nil {
+		return nil, fmt.Errorf("value must be a JSON object: %w", err)
+	}
+	return v, nil
+}
+
+// genericSettingRequest identifies the version of the setting for GET and DELETE requests
+type genericSettingRequest struct {
+	Etag string `json:"-" url:"etag,omitempty"`
+}
+
+type genericSettingAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+// path returns the path of account-level setting when used with account-level provider
+func (a genericSettingAPI) path(settingType string) string {
+	if a.client.Config.IsAccountClient() && a.client.Config.AccountID != "" {
+		return accountSettingPath(a.client.Config.AccountID, settingType)
+	}
+	return genericSettingPath(settingType)
+}
+
+func (a genericSettingAPI) read(settingType, etag string) (map[string]any, error) {
+	var res map[string]any
+	err := a.client.Get(a.context, a.path(settingType), genericSettingRequest{etag}, &res)
+	if errors.Is(err, apierr.ErrNotFound) {
+		// settings return 404 until they are written for the first time
+		etag, etagErr := getEtagFromError(err)
+		if etagErr != nil {
+			return nil, err
+		}
+		return map[string]any{"etag": etag}, nil
+	}
+	return res, err
+}
+
+func (a genericSettingAPI) update(settingType, fieldMask string, setting map[string]any) (string, error) {
+	var res map[string]any
+	return retryOnEtagError(func(setting map[string]any) (string, error) {
+		err := a.client.PatchWithResponse(a.context, a.path(settingType), map[string]any{
+			"allow_missing": true,
+			"field_mask":    fieldMask,
+			"setting":       setting,
+		}, &res)
+		if err != nil {
+			return "", err
+		}
+		etag, _ := res["etag"].(string)
+		return etag, nil
+	}, setting, func(setting *map[string]any, newEtag string) {
+		(*setting)["etag"] = newEtag
+	}, []error{apierr.ErrNotFound, apierr.ErrResourceConflict})
+}
+
+func (a genericSettingAPI) delete(settingType, etag string) error {
+	_, err := retryOnEtagError(func(etag string) (string, error) {
+		return "", a.client.Delete(a.context, a.path(settingType), genericSettingRequest{etag})
+	}, etag, func(etag *string, newEtag string) {
+		*etag = newEtag
+	}, []error{apierr.ErrResourceConflict})
+	return err
+}
+
+// ResourceGenericSetting manages any workspace or account setting by its type through the generic settings API
+func ResourceGenericSetting() common.Resource {
+	s := common.StructToSchema(genericSetting{}, func(m map[string]*schema.Schema) map[string]*schema.Schema {
+		m["value"].ValidateFunc = validation.StringIsJSON
+		m["value"].DiffSuppressFunc = func(k, old, new string, d *schema.ResourceData) bool {
+			if old == "" || new == "" {
+				return false
+			}
+			oldValue, err := parseSettingValue(old)
+			if err != nil {
+				return false
+			}
+			newValue, err := parseSettingValue(new)
+			if err != nil {
+				return false
+			}
+			return reflect.DeepEqual(oldValue, newValue)
+		}
+		return m
+	})
+	createOrUpdate := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+		settingType := d.Get("setting_type").(string)
+		setting, err := parseSettingValue(d.Get("value").(string))
+		if err != nil {
+			return err
+		}
+		fieldMask := d.Get("field_mask").(string)
+		if fieldMask == "" {
+			fieldMask = settingFieldMask(setting)
+		}
+		setting["setting_name"] = "default"
+		setting["etag"] = d.Get("etag").(string)
+		etag, err := genericSettingAPI{c, ctx}.update(settingType, fieldMask, setting)
+		if err != nil {
+			return err
+		}
+		d.SetId(settingType)
+		d.Set("etag", etag)
+		return nil
+	}
+	return common.Resource{
+		Schema: s,
+		Create: createOrUpdate,
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			res, err := genericSettingAPI{c, ctx}.read(d.Id(), d.Get("etag").(string))
+			if err != nil {
+				return err
+			}
+			etag, _ := res["etag"].(string)
+			for _, field := range genericSettingMetadataFields {
+				delete(res, field)
+			}
+			value, err := json.Marshal(res)
+			if err != nil {
+				return err
+			}
+			d.Set("setting_type", d.Id())
+			d.Set("value", string(value))
+			d.Set("etag", etag)
+			return nil
+		},
+		Update: createOrUpdate,
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return genericSettingAPI{c, ctx}.delete(d.Id(), d.Get("etag").(string))
+		},
+	}
+}
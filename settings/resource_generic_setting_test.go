@@ -0,0 +1,169 @@
+package settings
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSettingFieldMask(t *testing.T) {
+	assert.Equal(t, "enabled,namespace.value", settingFieldMask(map[string]any{
+		"namespace": map[string]any{
+			"value": "abc",
+		},
+		"enabled": true,
+	}))
+}
+
+func TestResourceGenericSettingCreate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/settings/types/default_namespace_ws/names/default",
+				ExpectedRequest: map[string]any{
+					"allow_missing": true,
+					"field_mask":    "namespace.value",
+					"setting": map[string]any{
+						"etag":         "",
+						"setting_name": "default",
+						"namespace": map[string]any{
+							"value": "main",
+						},
+					},
+				},
+				Status: 404,
+				Response: apierr.APIErrorBody{
+					ErrorCode: "NOT_FOUND",
+					Message:   "Setting is not found",
+					Details: []apierr.ErrorDetail{{
+						Type: "type.googleapis.com/google.rpc.ErrorInfo",
+						Metadata: map[string]string{
+							"etag": "etag1",
+						},
+					}},
+				},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/settings/types/default_namespace_ws/names/default",
+				ExpectedRequest: map[string]any{
+					"allow_missing": true,
+					"field_mask":    "namespace.value",
+					"setting": map[string]any{
+						"etag":         "etag1",
+						"setting_name": "default",
+						"namespace": map[string]any{
+							"value": "main",
+						},
+					},
+				},
+				Response: map[string]any{
+					"etag":         "etag2",
+					"setting_name": "default",
+					"namespace": map[string]any{
+						"value": "main",
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/settings/types/default_namespace_ws/names/default?etag=etag2",
+				Response: map[string]any{
+					"etag":         "etag2",
+					"setting_name": "default",
+					"namespace": map[string]any{
+						"value": "main",
+					},
+				},
+			},
+		},
+		Resource: ResourceGenericSetting(),
+		Create:   true,
+		HCL: `
+		setting_type = "default_namespace_ws"
+		value = "{\"namespace\": {\"value\": \"main\"}}"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":    "default_namespace_ws",
+		"etag":  "etag2",
+		"value": `{"namespace":{"value":"main"}}`,
+	})
+}
+
+func TestResourceGenericSettingReadNeverWritten(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/settings/types/default_namespace_ws/names/default?",
+				Status:   404,
+				Response: apierr.APIErrorBody{
+					ErrorCode: "NOT_FOUND",
+					Message:   "Setting is not found",
+					Details: []apierr.ErrorDetail{{
+						Type: "type.googleapis.com/google.rpc.ErrorInfo",
+						Metadata: map[string]string{
+							"etag": "etag1",
+						},
+					}},
+				},
+			},
+		},
+		Resource: ResourceGenericSetting(),
+		Read:     true,
+		New:      true,
+		ID:       "default_namespace_ws",
+	}.ApplyAndExpectData(t, map[string]any{
+		"setting_type": "default_namespace_ws",
+		"etag":         "etag1",
+		"value":        "{}",
+	})
+}
+
+func TestResourceGenericSettingDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "DELETE",
+				Resource: "/api/2.0/settings/types/default_namespace_ws/names/default?etag=etag1",
+			},
+		},
+		Resource: ResourceGenericSetting(),
+		Delete:   true,
+		ID:       "default_namespace_ws",
+		State: map[string]any{
+			"setting_type": "default_namespace_ws",
+			"value":        "{}",
+			"etag":         "etag1",
+		},
+	}.ApplyNoError(t)
+}
+
+func TestResourceGenericSettingReadAccountLevel(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/accounts/abc/settings/types/shield_esm_enablement_ac/names/default?",
+				Response: map[string]any{
+					"etag":         "etag1",
+					"setting_name": "default",
+					"esm_enablement_account": map[string]any{
+						"is_enforced": true,
+					},
+				},
+			},
+		},
+		Resource:  ResourceGenericSetting(),
+		AccountID: "abc",
+		Read:      true,
+		New:       true,
+		ID:        "shield_esm_enablement_ac",
+	}.ApplyAndExpectData(t, map[string]any{
+		"setting_type": "shield_esm_enablement_ac",
+		"etag":         "etag1",
+		"value":        `{"esm_enablement_account":{"is_enforced":true}}`,
+	})
+}
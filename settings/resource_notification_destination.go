@@ -0,0 +1,177 @@
+package settings
+
+import (
+	"context"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type EmailConfig struct {
+	Addresses []string `json:"addresses"`
+}
+
+type SlackConfig struct {
+	URL    string `json:"url,omitempty" tf:"sensitive"`
+	URLSet bool   `json:"url_set,omitempty" tf:"computed"`
+}
+
+type GenericWebhookConfig struct {
+	URL         string `json:"url,omitempty" tf:"sensitive"`
+	URLSet      bool   `json:"url_set,omitempty" tf:"computed"`
+	Username    string `json:"username,omitempty" tf:"sensitive"`
+	UsernameSet bool   `json:"username_set,omitempty" tf:"computed"`
+	Password    string `json:"password,omitempty" tf:"sensitive"`
+	PasswordSet bool   `json:"password_set,omitempty" tf:"computed"`
+}
+
+type PagerdutyConfig struct {
+	IntegrationKey    string `json:"integration_key,omitempty" tf:"sensitive"`
+	IntegrationKeySet bool   `json:"integration_key_set,omitempty" tf:"computed"`
+}
+
+type MicrosoftTeamsConfig struct {
+	URL    string `json:"url,omitempty" tf:"sensitive"`
+	URLSet bool   `json:"url_set,omitempty" tf:"computed"`
+}
+
+// NotificationDestinationConfig has exactly one of the fields set, depending on the type of destination
+type NotificationDestinationConfig struct {
+	Email          *EmailConfig          `json:"email,omitempty"`
+	Slack          *SlackConfig          `json:"slack,omitempty"`
+	GenericWebhook *GenericWebhookConfig `json:"generic_webhook,omitempty"`
+	Pagerduty      *PagerdutyConfig      `json:"pagerduty,omitempty"`
+	MicrosoftTeams *MicrosoftTeamsConfig `json:"microsoft_teams,omitempty"`
+}
+
+type NotificationDestination struct {
+	DisplayName     string                         `json:"display_name"`
+	DestinationType string                         `json:"destination_type,omitempty" tf:"computed"`
+	Config          *NotificationDestinationConfig `json:"config"`
+}
+
+// NotificationDestinationInfo is returned by the API, together with the ID of destination
+type NotificationDestinationInfo struct {
+	NotificationDestination
+	ID string `json:"id"`
+}
+
+type NotificationDestinationsList struct {
+	Results       []NotificationDestinationInfo `json:"results,omitempty"`
+	NextPageToken string                        `json:"next_page_token,omitempty"`
+}
+
+type NotificationDestinationsAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+func NewNotificationDestinationsAPI(ctx context.Context, m any) NotificationDestinationsAPI {
+	return NotificationDestinationsAPI{m.(*common.DatabricksClient), ctx}
+}
+
+func (a NotificationDestinationsAPI) Create(nd NotificationDestination) (info NotificationDestinationInfo, err error) {
+	err = a.client.Post(a.context, "/notification-destinations", nd, &info)
+	return
+}
+
+func (a NotificationDestinationsAPI) Read(id string) (nd NotificationDestinationInfo, err error) {
+	err = a.client.Get(a.context, "/notification-destinations/"+id, nil, &nd)
+	return
+}
+
+func (a NotificationDestinationsAPI) Update(id string, nd NotificationDestination) error {
+	// type of destination is derived from the config and can't be changed
+	nd.DestinationType = ""
+	return a.client.Patch(a.context, "/notification-destinations/"+id, nd)
+}
+
+func (a NotificationDestinationsAPI) Delete(id string) error {
+	return a.client.Delete(a.context, "/notification-destinations/"+id, nil)
+}
+
+// List returns all notification destinations, going through all pages
+func (a NotificationDestinationsAPI) List() ([]NotificationDestinationInfo, error) {
+	var destinations []NotificationDestinationInfo
+	request := map[string]string{}
+	for {
+		var page NotificationDestinationsList
+		err := a.client.Get(a.context, "/notification-destinations", request, &page)
+		if err != nil {
+			return nil, err
+		}
+		destinations = append(destinations, page.Results...)
+		if page.NextPageToken == "" {
+			return destinations, nil
+		}
+		request["page_token"] = page.NextPageToken
+	}
+}
+
+// keepSecrets copies secrets from the configuration, as the API doesn't return them
+func (nd *NotificationDestination) keepSecrets(old *NotificationDestinationConfig) {
+	if nd.Config == nil || old == nil {
+		return
+	}
+	if nd.Config.Slack != nil && old.Slack != nil {
+		nd.Config.Slack.URL = old.Slack.URL
+	}
+	if nd.Config.GenericWebhook != nil && old.GenericWebhook != nil {
+		nd.Config.GenericWebhook.URL = old.GenericWebhook.URL
+		nd.Config.GenericWebhook.Username = old.GenericWebhook.Username
+		nd.Config.GenericWebhook.Password = old.GenericWebhook.Password
+	}
+	if nd.Config.Pagerduty != nil && old.Pagerduty != nil {
+		nd.Config.Pagerduty.IntegrationKey = old.Pagerduty.IntegrationKey
+	}
+	if nd.Config.MicrosoftTeams != nil && old.MicrosoftTeams != nil {
+		nd.Config.MicrosoftTeams.URL = old.MicrosoftTeams.URL
+	}
+}
+
+// ResourceNotificationDestination manages notification destinations of the workspace
+func ResourceNotificationDestination() common.Resource {
+	s := common.StructToSchema(NotificationDestination{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			configTypes := []string{"email", "slack", "generic_webhook", "pagerduty", "microsoft_teams"}
+			for _, configType := range configTypes {
+				exactlyOneOf := []string{}
+				for _, other := range configTypes {
+					exactlyOneOf = append(exactlyOneOf, "config.0."+other)
+				}
+				common.CustomizeSchemaPath(m, "config", configType).SetExactlyOneOf(exactlyOneOf)
+			}
+			return m
+		})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var nd NotificationDestination
+			common.DataToStructPointer(d, s, &nd)
+			info, err := NewNotificationDestinationsAPI(ctx, c).Create(nd)
+			if err != nil {
+				return err
+			}
+			d.SetId(info.ID)
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			nd, err := NewNotificationDestinationsAPI(ctx, c).Read(d.Id())
+			if err != nil {
+				return err
+			}
+			var old NotificationDestination
+			common.DataToStructPointer(d, s, &old)
+			nd.keepSecrets(old.Config)
+			return common.StructToData(nd.NotificationDestination, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var nd NotificationDestination
+			common.DataToStructPointer(d, s, &nd)
+			return NewNotificationDestinationsAPI(ctx, c).Update(d.Id(), nd)
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewNotificationDestinationsAPI(ctx, c).Delete(d.Id())
+		},
+	}
+}
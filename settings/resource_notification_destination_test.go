@@ -0,0 +1,196 @@
+package settings
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationDestinationCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceNotificationDestination())
+}
+
+var slackDestination = NotificationDestinationInfo{
+	ID: "abc",
+	NotificationDestination: NotificationDestination{
+		DisplayName:     "Alerts",
+		DestinationType: "SLACK",
+		Config: &NotificationDestinationConfig{
+			Slack: &SlackConfig{URLSet: true},
+		},
+	},
+}
+
+func TestNotificationDestinationCreate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/notification-destinations",
+				ExpectedRequest: NotificationDestination{
+					DisplayName: "Alerts",
+					Config: &NotificationDestinationConfig{
+						Slack: &SlackConfig{URL: "https://hooks.slack.com/services/abc"},
+					},
+				},
+				Response: slackDestination,
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/notification-destinations/abc",
+				Response: slackDestination,
+			},
+		},
+		Resource: ResourceNotificationDestination(),
+		HCL: `
+		display_name = "Alerts"
+		config {
+			slack {
+				url = "https://hooks.slack.com/services/abc"
+			}
+		}
+		`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                       "abc",
+		"destination_type":         "SLACK",
+		"config.0.slack.0.url":     "https://hooks.slack.com/services/abc",
+		"config.0.slack.0.url_set": true,
+	})
+}
+
+func TestNotificationDestinationCreate_MultipleConfigs(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceNotificationDestination(),
+		HCL: `
+		display_name = "Alerts"
+		config {
+			slack {
+				url = "https://hooks.slack.com/services/abc"
+			}
+			email {
+				addresses = ["user@domain.com"]
+			}
+		}
+		`,
+		Create: true,
+	}.ExpectError(t, "invalid config supplied. "+
+		"[config.#.email] Invalid combination of arguments. "+
+		"[config.#.generic_webhook] Invalid combination of arguments. "+
+		"[config.#.microsoft_teams] Invalid combination of arguments. "+
+		"[config.#.pagerduty] Invalid combination of arguments. "+
+		"[config.#.slack] Invalid combination of arguments")
+}
+
+func TestNotificationDestinationRead_Email(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/notification-destinations/abc",
+				Response: NotificationDestinationInfo{
+					ID: "abc",
+					NotificationDestination: NotificationDestination{
+						DisplayName:     "Team",
+						DestinationType: "EMAIL",
+						Config: &NotificationDestinationConfig{
+							Email: &EmailConfig{Addresses: []string{"user@domain.com"}},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceNotificationDestination(),
+		Read:     true,
+		New:      true,
+		ID:       "abc",
+	}.ApplyAndExpectData(t, map[string]any{
+		"display_name":                 "Team",
+		"destination_type":             "EMAIL",
+		"config.0.email.0.addresses.0": "user@domain.com",
+	})
+}
+
+func TestNotificationDestinationUpdate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPatch,
+				Resource: "/api/2.0/notification-destinations/abc",
+				ExpectedRequest: NotificationDestination{
+					DisplayName: "Renamed",
+					Config: &NotificationDestinationConfig{
+						Slack: &SlackConfig{URL: "https://hooks.slack.com/services/abc", URLSet: true},
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/notification-destinations/abc",
+				Response: slackDestination,
+			},
+		},
+		Resource: ResourceNotificationDestination(),
+		Update:   true,
+		ID:       "abc",
+		InstanceState: map[string]string{
+			"display_name":             "Alerts",
+			"destination_type":         "SLACK",
+			"config.#":                 "1",
+			"config.0.slack.#":         "1",
+			"config.0.slack.0.url":     "https://hooks.slack.com/services/abc",
+			"config.0.slack.0.url_set": "true",
+		},
+		HCL: `
+		display_name = "Renamed"
+		config {
+			slack {
+				url = "https://hooks.slack.com/services/abc"
+			}
+		}
+		`,
+	}.ApplyNoError(t)
+}
+
+func TestNotificationDestinationDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodDelete,
+				Resource: "/api/2.0/notification-destinations/abc",
+			},
+		},
+		Resource: ResourceNotificationDestination(),
+		Delete:   true,
+		ID:       "abc",
+	}.ApplyNoError(t)
+}
+
+func TestNotificationDestinationsList(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   http.MethodGet,
+			Resource: "/api/2.0/notification-destinations?",
+			Response: NotificationDestinationsList{
+				Results:       []NotificationDestinationInfo{slackDestination},
+				NextPageToken: "next",
+			},
+		},
+		{
+			Method:   http.MethodGet,
+			Resource: "/api/2.0/notification-destinations?page_token=next",
+			Response: NotificationDestinationsList{
+				Results: []NotificationDestinationInfo{{ID: "def"}},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		destinations, err := NewNotificationDestinationsAPI(ctx, client).List()
+		assert.NoError(t, err)
+		assert.Len(t, destinations, 2)
+		assert.Equal(t, "def", destinations[1].ID)
+	})
+}
@@ -0,0 +1,24 @@
+package sharing
+
+import (
+	"context"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+func DataSourceProviderShares() common.Resource {
+	return common.WorkspaceData(func(ctx context.Context, data *struct {
+		ProviderName string   `json:"provider_name"`
+		Shares       []string `json:"shares,omitempty" tf:"computed,slice_set"`
+	}, w *databricks.WorkspaceClient) error {
+		shares, err := w.Providers.ListSharesByName(ctx, data.ProviderName)
+		if err != nil {
+			return err
+		}
+		for _, share := range shares.Shares {
+			data.Shares = append(data.Shares, share.Name)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,56 @@
+package sharing
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/service/sharing"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceProviderShares(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/providers/partner/shares?",
+				Response: sharing.ListProviderSharesResponse{
+					Shares: []sharing.ProviderShare{
+						{Name: "sales"},
+						{Name: "marketing"},
+					},
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceProviderShares(),
+		ID:          ".",
+		HCL:         `provider_name = "partner"`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []any{"marketing", "sales"}, d.Get("shares").(*schema.Set).List())
+}
+
+func TestDataSourceProviderShares_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/providers/partner/shares?",
+				Status:   404,
+				Response: apierr.APIErrorBody{
+					ErrorCode: "PROVIDER_DOES_NOT_EXIST",
+					Message:   "Provider 'partner' does not exist.",
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceProviderShares(),
+		ID:          ".",
+		HCL:         `provider_name = "partner"`,
+	}.ExpectError(t, "Provider 'partner' does not exist.")
+}
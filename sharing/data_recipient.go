@@ -0,0 +1,49 @@
+package sharing
+
+import (
+	"context"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/sharing"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+func DataSourceRecipient() common.Resource {
+	return common.WorkspaceData(func(ctx context.Context, data *struct {
+		Name                           string                       `json:"name"`
+		Comment                        string                       `json:"comment,omitempty" tf:"computed"`
+		Owner                          string                       `json:"owner,omitempty" tf:"computed"`
+		AuthenticationType             string                       `json:"authentication_type,omitempty" tf:"computed"`
+		Activated                      bool                         `json:"activated,omitempty" tf:"computed"`
+		ActivationUrl                  string                       `json:"activation_url,omitempty" tf:"computed"`
+		Tokens                         []sharing.RecipientTokenInfo `json:"tokens,omitempty" tf:"computed"`
+		DataRecipientGlobalMetastoreId string                       `json:"data_recipient_global_metastore_id,omitempty" tf:"computed"`
+		MetastoreId                    string                       `json:"metastore_id,omitempty" tf:"computed"`
+		Cloud                          string                       `json:"cloud,omitempty" tf:"computed"`
+		Region                         string                       `json:"region,omitempty" tf:"computed"`
+		CreatedAt                      int64                        `json:"created_at,omitempty" tf:"computed"`
+		CreatedBy                      string                       `json:"created_by,omitempty" tf:"computed"`
+		UpdatedAt                      int64                        `json:"updated_at,omitempty" tf:"computed"`
+		UpdatedBy                      string                       `json:"updated_by,omitempty" tf:"computed"`
+	}, w *databricks.WorkspaceClient) error {
+		recipient, err := w.Recipients.GetByName(ctx, data.Name)
+		if err != nil {
+			return err
+		}
+		data.Comment = recipient.Comment
+		data.Owner = recipient.Owner
+		data.AuthenticationType = recipient.AuthenticationType.String()
+		data.Activated = recipient.Activated
+		data.ActivationUrl = recipient.ActivationUrl
+		data.Tokens = recipient.Tokens
+		data.DataRecipientGlobalMetastoreId = recipient.DataRecipientGlobalMetastoreId
+		data.MetastoreId = recipient.MetastoreId
+		data.Cloud = recipient.Cloud
+		data.Region = recipient.Region
+		data.CreatedAt = recipient.CreatedAt
+		data.CreatedBy = recipient.CreatedBy
+		data.UpdatedAt = recipient.UpdatedAt
+		data.UpdatedBy = recipient.UpdatedBy
+		return nil
+	})
+}
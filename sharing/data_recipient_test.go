@@ -0,0 +1,74 @@
+package sharing
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/service/sharing"
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestDataSourceRecipient(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/recipients/partner?",
+				Response: sharing.RecipientInfo{
+					Name:               "partner",
+					AuthenticationType: "TOKEN",
+					Activated:          true,
+					ActivationUrl:      "https://example.com/activate",
+					Cloud:              "aws",
+					Region:             "us-west-2",
+					MetastoreId:        "abc",
+					Owner:              "admins",
+					Tokens: []sharing.RecipientTokenInfo{
+						{
+							Id:             "t1",
+							ActivationUrl:  "https://example.com/activate",
+							ExpirationTime: 1700000000000,
+						},
+					},
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceRecipient(),
+		ID:          ".",
+		HCL:         `name = "partner"`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"authentication_type":      "TOKEN",
+		"activated":                true,
+		"activation_url":           "https://example.com/activate",
+		"cloud":                    "aws",
+		"region":                   "us-west-2",
+		"metastore_id":             "abc",
+		"owner":                    "admins",
+		"tokens.#":                 1,
+		"tokens.0.id":              "t1",
+		"tokens.0.expiration_time": 1700000000000,
+	})
+}
+
+func TestDataSourceRecipient_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/recipients/partner?",
+				Response: apierr.APIErrorBody{
+					ErrorCode: "RECIPIENT_DOES_NOT_EXIST",
+					Message:   "Recipient 'partner' does not exist",
+				},
+				Status: 404,
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceRecipient(),
+		ID:          ".",
+		HCL:         `name = "partner"`,
+	}.ExpectError(t, "Recipient 'partner' does not exist")
+}
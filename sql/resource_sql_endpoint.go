@@ -51,6 +51,30 @@ func resolveDataSourceID(ctx context.Context, w *databricks.WorkspaceClient, war
 	return "", fmt.Errorf("no data source found for endpoint %s", warehouseId)
 }
 
+// waitForWarehouseRunning waits until the warehouse is started
+func waitForWarehouseRunning(ctx context.Context, w *databricks.WorkspaceClient, id string, timeout time.Duration) error {
+	return common.WaitConfig{
+		Name:    fmt.Sprintf("SQL warehouse %s to start", id),
+		Timeout: timeout,
+	}.Poll(ctx, func() (bool, string, error) {
+		warehouse, err := w.Warehouses.GetById(ctx, id)
+		if err != nil {
+			return false, "", err
+		}
+		status := fmt.Sprintf("current status: %s", warehouse.State)
+		if warehouse.Health != nil {
+			status = warehouse.Health.Summary
+		}
+		switch warehouse.State {
+		case sql.StateRunning:
+			return true, "", nil
+		case sql.StateStopped, sql.StateDeleted:
+			return false, "", fmt.Errorf("failed to reach %s, got %s: %s", sql.StateRunning, warehouse.State, status)
+		}
+		return false, status, nil
+	})
+}
+
 func ResourceSqlEndpoint() common.Resource {
 	s := common.StructToSchema(SqlWarehouse{}, func(
 		m map[string]*schema.Schema) map[string]*schema.Schema {
@@ -100,11 +124,11 @@ func ResourceSqlEndpoint() common.Resource {
 			if err != nil {
 				return fmt.Errorf("failed creating warehouse: %w", err)
 			}
-			resp, err := wait.Get()
+			err = waitForWarehouseRunning(ctx, w, wait.Id, d.Timeout(schema.TimeoutCreate))
 			if err != nil {
 				return fmt.Errorf("failed waiting for warehouse to start: %w", err)
 			}
-			d.SetId(resp.Id)
+			d.SetId(wait.Id)
 			return nil
 		},
 		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
@@ -55,7 +55,7 @@ func TestResourceSQLEndpointCreate(t *testing.T) {
 		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
 			api := w.GetMockWarehousesAPI()
 			api.EXPECT().Create(mock.Anything, createRequest).Return(&sql.WaitGetWarehouseRunning[sql.CreateWarehouseResponse]{
-				Poll: poll.Simple(getResponse),
+				Id: "abc",
 			}, nil)
 			api.EXPECT().GetById(mock.Anything, "abc").Return(&getResponse, nil)
 			addDataSourceListHttpFixture(w)
@@ -112,6 +112,7 @@ func TestResourceSQLEndpointCreate_ForceSendFields(t *testing.T) {
 						EnablePhoton:            c.expectedPhoton,
 						EnableServerlessCompute: c.expectedEnableServerlessCompute,
 						ForceSendFields:         c.expectedForceSendFields,
+						State:                   sql.StateRunning,
 					}
 					api.EXPECT().Create(mock.Anything, sql.CreateWarehouseRequest{
 						Name:                    "foo",
@@ -123,7 +124,7 @@ func TestResourceSQLEndpointCreate_ForceSendFields(t *testing.T) {
 						SpotInstancePolicy:      "COST_OPTIMIZED",
 						ForceSendFields:         c.expectedForceSendFields,
 					}).Return(&sql.WaitGetWarehouseRunning[sql.CreateWarehouseResponse]{
-						Poll: poll.Simple(response),
+						Id: "abc",
 					}, nil)
 					api.EXPECT().GetById(mock.Anything, "abc").Return(&response, nil)
 					addDataSourceListHttpFixture(w)
@@ -155,7 +156,7 @@ func TestResourceSQLEndpointCreateNoAutoTermination(t *testing.T) {
 				EnablePhoton:       true,
 				SpotInstancePolicy: "COST_OPTIMIZED",
 			}).Return(&sql.WaitGetWarehouseRunning[sql.CreateWarehouseResponse]{
-				Poll: poll.Simple(getResponse),
+				Id: "abc",
 			}, nil)
 			e.GetById(mock.Anything, "abc").Return(&getResponse, nil)
 			addDataSourceListHttpFixture(w)
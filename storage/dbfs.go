@@ -147,6 +147,9 @@ func (a DbfsAPI) Delete(path string, recursive bool) error {
 	}, nil)
 }
 
+// readChunkSize is the maximum amount of data returned by a single call to the read API
+var readChunkSize = int64(1e6)
+
 type dbfsRequest struct {
 	Path      string `json:"path,omitempty" url:"path,omitempty"`
 	Offset    int64  `json:"offset,omitempty" url:"offset,omitempty"`
@@ -158,17 +161,17 @@ type dbfsRequest struct {
 func (a DbfsAPI) Read(path string) (content []byte, err error) {
 	fetchLoop := true
 	offSet := int64(0)
-	length := int64(1e6)
 	for fetchLoop {
-		bytesRead, bytes, err := a.read(path, offSet, length)
+		bytesRead, bytes, err := a.read(path, offSet, readChunkSize)
 		if err != nil {
 			return content, fmt.Errorf("cannot read %s: %w", path, err)
 		}
-		if bytesRead == 0 || bytesRead < length {
+		if bytesRead == 0 || bytesRead < readChunkSize {
 			fetchLoop = false
 		}
 		content = append(content, bytes...)
-		offSet += length
+		// the next chunk starts right after the data that was actually returned
+		offSet += bytesRead
 	}
 	return content, err
 }
@@ -139,3 +139,29 @@ func TestDbfsReadFails(t *testing.T) {
 		assert.EqualError(t, err, "cannot read abc: fails")
 	})
 }
+
+func TestDbfsReadChunks(t *testing.T) {
+	defer func(size int64) { readChunkSize = size }(readChunkSize)
+	readChunkSize = 3
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/dbfs/read?length=3&path=abc",
+			Response: ReadResponse{Data: "YWJj", BytesRead: 3},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/dbfs/read?length=3&offset=3&path=abc",
+			Response: ReadResponse{Data: "ZGVm", BytesRead: 3},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/dbfs/read?length=3&offset=6&path=abc",
+			Response: ReadResponse{Data: "Zw==", BytesRead: 1},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		content, err := NewDbfsAPI(ctx, client).Read("abc")
+		assert.NoError(t, err)
+		assert.Equal(t, "abcdefg", string(content))
+	})
+}
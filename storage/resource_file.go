@@ -0,0 +1,119 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go/service/files"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/workspace"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DirectoryEntry is a file or a directory in UC volume
+type DirectoryEntry struct {
+	Path         string `json:"path"`
+	Name         string `json:"name,omitempty"`
+	IsDirectory  bool   `json:"is_directory,omitempty"`
+	FileSize     int64  `json:"file_size,omitempty"`
+	LastModified int64  `json:"last_modified,omitempty"`
+}
+
+type listDirectoryRequest struct {
+	PageToken string `json:"-" url:"page_token,omitempty"`
+}
+
+type listDirectoryResponse struct {
+	Contents      []DirectoryEntry `json:"contents,omitempty"`
+	NextPageToken string           `json:"next_page_token,omitempty"`
+}
+
+// ListVolumeDirectory returns all entries of the given directory in UC volume, without recursion
+func ListVolumeDirectory(ctx context.Context, c *common.DatabricksClient, path string) ([]DirectoryEntry, error) {
+	var entries []DirectoryEntry
+	request := listDirectoryRequest{}
+	for {
+		var resp listDirectoryResponse
+		err := c.Get(ctx, "/fs/directories"+path, request, &resp)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, resp.Contents...)
+		if resp.NextPageToken == "" {
+			return entries, nil
+		}
+		request.PageToken = resp.NextPageToken
+	}
+}
+
+func uploadVolumeFile(ctx context.Context, c *common.DatabricksClient, path string, content []byte) error {
+	return c.Do(ctx, http.MethodPut, "/api/2.0/fs/files"+path+"?overwrite=true",
+		map[string]string{"Content-Type": "application/octet-stream"}, bytes.NewReader(content), nil)
+}
+
+// ResourceFile manages files in UC volumes
+func ResourceFile() common.Resource {
+	s := workspace.FileContentSchema(map[string]*schema.Schema{
+		"file_size": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"modification_time": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+	})
+	validatePath := s["path"].ValidateDiagFunc
+	s["path"].ValidateDiagFunc = func(i any, p cty.Path) diag.Diagnostics {
+		if !strings.HasPrefix(i.(string), "/Volumes/") {
+			return diag.Errorf("path must start with /Volumes/, got: %s", i)
+		}
+		return validatePath(i, p)
+	}
+	upload := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient, path string) error {
+		content, err := workspace.ReadContent(d)
+		if err != nil {
+			return err
+		}
+		return uploadVolumeFile(ctx, c, path, content)
+	}
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			path := d.Get("path").(string)
+			if err := upload(ctx, d, c, path); err != nil {
+				return err
+			}
+			d.SetId(path)
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			fileInfo, err := w.Files.GetStatus(ctx, files.GetStatusRequest{Path: d.Id()})
+			if err != nil {
+				return err
+			}
+			d.Set("path", d.Id())
+			d.Set("file_size", fileInfo.FileSize)
+			d.Set("modification_time", fileInfo.ModificationTime)
+			return nil
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return upload(ctx, d, c, d.Id())
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			return w.Files.Delete(ctx, files.DeleteFileRequest{FilePath: d.Id()})
+		},
+	}
+}
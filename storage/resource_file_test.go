@@ -0,0 +1,148 @@
+package storage
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/service/files"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceFileCreate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/fs/files/Volumes/main/default/vol/a.txt?overwrite=true",
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/fs/get-status?path=%2FVolumes%2Fmain%2Fdefault%2Fvol%2Fa.txt",
+				Response: files.FileInfo{
+					Path:             "/Volumes/main/default/vol/a.txt",
+					FileSize:         3,
+					ModificationTime: 1700000000000,
+				},
+			},
+		},
+		Resource: ResourceFile(),
+		Create:   true,
+		HCL: `
+		path = "/Volumes/main/default/vol/a.txt"
+		content_base64 = "YWJj"`,
+	}.Apply(t)
+	require.NoError(t, err)
+	assert.Equal(t, "/Volumes/main/default/vol/a.txt", d.Id())
+	assert.Equal(t, 3, d.Get("file_size"))
+	assert.Equal(t, 1700000000000, d.Get("modification_time"))
+	assert.Equal(t, "900150983cd24fb0d6963f7d28e17f72", d.Get("md5"))
+}
+
+func TestResourceFileCreateOutsideOfVolumes(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceFile(),
+		Create:   true,
+		HCL: `
+		path = "/FileStore/a.txt"
+		content_base64 = "YWJj"`,
+	}.ExpectError(t, "invalid config supplied. [path] path must start with /Volumes/, got: /FileStore/a.txt")
+}
+
+func TestResourceFileUpdate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPut,
+				Resource: "/api/2.0/fs/files/Volumes/main/default/vol/a.txt?overwrite=true",
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/fs/get-status?path=%2FVolumes%2Fmain%2Fdefault%2Fvol%2Fa.txt",
+				Response: files.FileInfo{
+					Path:     "/Volumes/main/default/vol/a.txt",
+					FileSize: 4,
+				},
+			},
+		},
+		Resource: ResourceFile(),
+		Update:   true,
+		ID:       "/Volumes/main/default/vol/a.txt",
+		InstanceState: map[string]string{
+			"path":           "/Volumes/main/default/vol/a.txt",
+			"content_base64": "YWJj",
+			"md5":            "900150983cd24fb0d6963f7d28e17f72",
+		},
+		HCL: `
+		path = "/Volumes/main/default/vol/a.txt"
+		content_base64 = "YWJjZA=="`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"file_size": 4,
+		"md5":       "e2fc714c4727ee9395f324cd2e7f331f",
+	})
+}
+
+func TestResourceFileRead_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/fs/get-status?path=%2FVolumes%2Fmain%2Fdefault%2Fvol%2Fa.txt",
+				Status:   http.StatusNotFound,
+				Response: apierr.NotFound("nope"),
+			},
+		},
+		Resource: ResourceFile(),
+		Read:     true,
+		Removed:  true,
+		ID:       "/Volumes/main/default/vol/a.txt",
+	}.ApplyNoError(t)
+}
+
+func TestResourceFileDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodDelete,
+				Resource: "/api/2.0/fs/files/Volumes/main/default/vol/a.txt?",
+			},
+		},
+		Resource: ResourceFile(),
+		Delete:   true,
+		ID:       "/Volumes/main/default/vol/a.txt",
+	}.ApplyNoError(t)
+}
+
+func TestListVolumeDirectory(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   http.MethodGet,
+			Resource: "/api/2.0/fs/directories/Volumes/main/default/vol?",
+			Response: listDirectoryResponse{
+				Contents: []DirectoryEntry{
+					{Path: "/Volumes/main/default/vol/a.txt", Name: "a.txt", FileSize: 3},
+				},
+				NextPageToken: "next",
+			},
+		},
+		{
+			Method:   http.MethodGet,
+			Resource: "/api/2.0/fs/directories/Volumes/main/default/vol?page_token=next",
+			Response: listDirectoryResponse{
+				Contents: []DirectoryEntry{
+					{Path: "/Volumes/main/default/vol/dir", Name: "dir", IsDirectory: true},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		entries, err := ListVolumeDirectory(ctx, client, "/Volumes/main/default/vol")
+		require.NoError(t, err)
+		assert.Len(t, entries, 2)
+		assert.Equal(t, "a.txt", entries[0].Name)
+		assert.True(t, entries[1].IsDirectory)
+	})
+}
@@ -43,6 +43,12 @@ func (a ServicePrincipalSecretAPI) listServicePrincipalSecrets(spnID string) (se
 	return
 }
 
+// ListAll returns all secrets of the service principal, without their values
+func (a ServicePrincipalSecretAPI) ListAll(spnID string) ([]ServicePrincipalSecret, error) {
+	secrets, err := a.listServicePrincipalSecrets(spnID)
+	return secrets.Secrets, err
+}
+
 func (a ServicePrincipalSecretAPI) deleteServicePrincipalSecret(spnID, secretID string) error { // FIXME
 	path := fmt.Sprintf("/accounts/%s/servicePrincipals/%s/credentials/secrets/%s", a.client.Config.AccountID, spnID, secretID)
 	return a.client.Delete(a.context, path, nil)
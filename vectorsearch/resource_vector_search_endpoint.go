@@ -0,0 +1,86 @@
+package vectorsearch
+
+import (
+	"context"
+	"time"
+
+	"github.com/databricks/databricks-sdk-go/service/vectorsearch"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const defaultEndpointProvisionTimeout = 75 * time.Minute
+
+// VectorSearchEndpoint is a Terraform representation of vectorsearch.EndpointInfo
+type VectorSearchEndpoint struct {
+	Name                 string                       `json:"name" tf:"force_new"`
+	EndpointType         vectorsearch.EndpointType    `json:"endpoint_type" tf:"force_new"`
+	EndpointID           string                       `json:"endpoint_id,omitempty" tf:"computed"`
+	Creator              string                       `json:"creator,omitempty" tf:"computed"`
+	CreationTimestamp    int64                        `json:"creation_timestamp,omitempty" tf:"computed"`
+	LastUpdatedTimestamp int64                        `json:"last_updated_timestamp,omitempty" tf:"computed"`
+	LastUpdatedUser      string                       `json:"last_updated_user,omitempty" tf:"computed"`
+	EndpointStatus       *vectorsearch.EndpointStatus `json:"endpoint_status,omitempty" tf:"computed"`
+	NumIndexes           int                          `json:"num_indexes,omitempty" tf:"computed"`
+}
+
+func vectorSearchEndpointFromInfo(info *vectorsearch.EndpointInfo) VectorSearchEndpoint {
+	return VectorSearchEndpoint{
+		Name:                 info.Name,
+		EndpointType:         info.EndpointType,
+		EndpointID:           info.Id,
+		Creator:              info.Creator,
+		CreationTimestamp:    info.CreationTimestamp,
+		LastUpdatedTimestamp: info.LastUpdatedTimestamp,
+		LastUpdatedUser:      info.LastUpdatedUser,
+		EndpointStatus:       info.EndpointStatus,
+		NumIndexes:           info.NumIndexes,
+	}
+}
+
+// ResourceVectorSearchEndpoint manages endpoints of Vector Search
+func ResourceVectorSearchEndpoint() common.Resource {
+	s := common.StructToSchema(VectorSearchEndpoint{}, nil)
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			var e VectorSearchEndpoint
+			common.DataToStructPointer(d, s, &e)
+			wait, err := w.VectorSearchEndpoints.CreateEndpoint(ctx, vectorsearch.CreateEndpoint{
+				Name:         e.Name,
+				EndpointType: e.EndpointType,
+			})
+			if err != nil {
+				return err
+			}
+			d.SetId(e.Name)
+			_, err = wait.GetWithTimeout(d.Timeout(schema.TimeoutCreate))
+			return err
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			endpoint, err := w.VectorSearchEndpoints.GetEndpointByEndpointName(ctx, d.Id())
+			if err != nil {
+				return err
+			}
+			return common.StructToData(vectorSearchEndpointFromInfo(endpoint), s, d)
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			return w.VectorSearchEndpoints.DeleteEndpointByEndpointName(ctx, d.Id())
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultEndpointProvisionTimeout),
+		},
+	}
+}
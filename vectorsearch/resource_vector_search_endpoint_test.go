@@ -0,0 +1,118 @@
+package vectorsearch
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/service/vectorsearch"
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestVectorSearchEndpointCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceVectorSearchEndpoint())
+}
+
+var onlineEndpoint = vectorsearch.EndpointInfo{
+	Name:         "abc",
+	EndpointType: vectorsearch.EndpointTypeStandard,
+	Id:           "1234-5678",
+	Creator:      "user@example.com",
+	EndpointStatus: &vectorsearch.EndpointStatus{
+		State: vectorsearch.EndpointStatusStateOnline,
+	},
+	NumIndexes: 2,
+}
+
+func TestVectorSearchEndpointCreate(t *testing.T) {
+	provisioning := onlineEndpoint
+	provisioning.EndpointStatus = &vectorsearch.EndpointStatus{
+		State: vectorsearch.EndpointStatusStateProvisioning,
+	}
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/vector-search/endpoints",
+				ExpectedRequest: vectorsearch.CreateEndpoint{
+					Name:         "abc",
+					EndpointType: vectorsearch.EndpointTypeStandard,
+				},
+				Response: provisioning,
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/vector-search/endpoints/abc?",
+				Response: provisioning,
+			},
+			{
+				Method:       http.MethodGet,
+				Resource:     "/api/2.0/vector-search/endpoints/abc?",
+				Response:     onlineEndpoint,
+				ReuseRequest: true,
+			},
+		},
+		Resource: ResourceVectorSearchEndpoint(),
+		HCL: `
+		name          = "abc"
+		endpoint_type = "STANDARD"
+		`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                      "abc",
+		"endpoint_id":             "1234-5678",
+		"endpoint_status.0.state": "ONLINE",
+		"num_indexes":             2,
+	})
+}
+
+func TestVectorSearchEndpointRead(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/vector-search/endpoints/abc?",
+				Response: onlineEndpoint,
+			},
+		},
+		Resource: ResourceVectorSearchEndpoint(),
+		Read:     true,
+		New:      true,
+		ID:       "abc",
+	}.ApplyAndExpectData(t, map[string]any{
+		"name":          "abc",
+		"endpoint_type": "STANDARD",
+		"creator":       "user@example.com",
+	})
+}
+
+func TestVectorSearchEndpointRead_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/vector-search/endpoints/abc?",
+				Status:   http.StatusNotFound,
+				Response: apierr.NotFound("nope"),
+			},
+		},
+		Resource: ResourceVectorSearchEndpoint(),
+		Read:     true,
+		Removed:  true,
+		ID:       "abc",
+	}.ApplyNoError(t)
+}
+
+func TestVectorSearchEndpointDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodDelete,
+				Resource: "/api/2.0/vector-search/endpoints/abc?Name=",
+			},
+		},
+		Resource: ResourceVectorSearchEndpoint(),
+		Delete:   true,
+		ID:       "abc",
+	}.ApplyNoError(t)
+}
@@ -0,0 +1,143 @@
+package vectorsearch
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/vectorsearch"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const defaultIndexProvisionTimeout = 15 * time.Minute
+
+// VectorSearchIndex is a Terraform representation of vectorsearch.VectorIndex. Indexes can't be updated,
+// so all configurable fields force creation of a new index
+type VectorSearchIndex struct {
+	Name                  string                                         `json:"name"`
+	EndpointName          string                                         `json:"endpoint_name"`
+	PrimaryKey            string                                         `json:"primary_key"`
+	IndexType             vectorsearch.VectorIndexType                   `json:"index_type"`
+	DeltaSyncIndexSpec    *vectorsearch.DeltaSyncVectorIndexSpecResponse `json:"delta_sync_index_spec,omitempty"`
+	DirectAccessIndexSpec *vectorsearch.DirectAccessVectorIndexSpec      `json:"direct_access_index_spec,omitempty"`
+	Creator               string                                         `json:"creator,omitempty" tf:"computed"`
+	Status                *vectorsearch.VectorIndexStatus                `json:"status,omitempty" tf:"computed"`
+}
+
+func (vsi VectorSearchIndex) toCreateRequest() vectorsearch.CreateVectorIndexRequest {
+	request := vectorsearch.CreateVectorIndexRequest{
+		Name:                  vsi.Name,
+		EndpointName:          vsi.EndpointName,
+		PrimaryKey:            vsi.PrimaryKey,
+		IndexType:             vsi.IndexType,
+		DirectAccessIndexSpec: vsi.DirectAccessIndexSpec,
+	}
+	if vsi.DeltaSyncIndexSpec != nil {
+		request.DeltaSyncVectorIndexSpec = &vectorsearch.DeltaSyncVectorIndexSpecRequest{
+			EmbeddingSourceColumns: vsi.DeltaSyncIndexSpec.EmbeddingSourceColumns,
+			EmbeddingVectorColumns: vsi.DeltaSyncIndexSpec.EmbeddingVectorColumns,
+			PipelineType:           vsi.DeltaSyncIndexSpec.PipelineType,
+			SourceTable:            vsi.DeltaSyncIndexSpec.SourceTable,
+		}
+	}
+	return request
+}
+
+func vectorSearchIndexFromInfo(info *vectorsearch.VectorIndex) VectorSearchIndex {
+	return VectorSearchIndex{
+		Name:                  info.Name,
+		EndpointName:          info.EndpointName,
+		PrimaryKey:            info.PrimaryKey,
+		IndexType:             info.IndexType,
+		DeltaSyncIndexSpec:    info.DeltaSyncVectorIndexSpec,
+		DirectAccessIndexSpec: info.DirectAccessVectorIndexSpec,
+		Creator:               info.Creator,
+		Status:                info.Status,
+	}
+}
+
+// setForceNew marks all configurable fields as forcing creation of a new resource, including nested ones
+func setForceNew(m map[string]*schema.Schema) {
+	for _, v := range m {
+		if v.Computed {
+			continue
+		}
+		v.ForceNew = true
+		if nested, ok := v.Elem.(*schema.Resource); ok {
+			setForceNew(nested.Schema)
+		}
+	}
+}
+
+// WaitForIndex waits until the index becomes ready to serve queries
+func WaitForIndex(ctx context.Context, w *databricks.WorkspaceClient, indexName string, timeout time.Duration) error {
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		index, err := w.VectorSearchIndexes.GetIndexByIndexName(ctx, indexName)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		if index.Status != nil && index.Status.Ready {
+			return nil
+		}
+		message := ""
+		if index.Status != nil {
+			message = index.Status.Message
+		}
+		log.Printf("[DEBUG] Vector search index %s isn't ready: %s", indexName, message)
+		return retry.RetryableError(fmt.Errorf("vector search index %s is not ready: %s", indexName, message))
+	})
+}
+
+// ResourceVectorSearchIndex manages indexes of Vector Search
+func ResourceVectorSearchIndex() common.Resource {
+	s := common.StructToSchema(VectorSearchIndex{},
+		func(m map[string]*schema.Schema) map[string]*schema.Schema {
+			common.CustomizeSchemaPath(m, "delta_sync_index_spec", "pipeline_id").SetComputed()
+			common.CustomizeSchemaPath(m, "delta_sync_index_spec").SetConflictsWith([]string{"direct_access_index_spec"})
+			common.CustomizeSchemaPath(m, "direct_access_index_spec").SetConflictsWith([]string{"delta_sync_index_spec"})
+			setForceNew(m)
+			return m
+		})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			var vsi VectorSearchIndex
+			common.DataToStructPointer(d, s, &vsi)
+			_, err = w.VectorSearchIndexes.CreateIndex(ctx, vsi.toCreateRequest())
+			if err != nil {
+				return err
+			}
+			d.SetId(vsi.Name)
+			return WaitForIndex(ctx, w, vsi.Name, d.Timeout(schema.TimeoutCreate))
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			index, err := w.VectorSearchIndexes.GetIndexByIndexName(ctx, d.Id())
+			if err != nil {
+				return err
+			}
+			return common.StructToData(vectorSearchIndexFromInfo(index), s, d)
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClient()
+			if err != nil {
+				return err
+			}
+			return w.VectorSearchIndexes.DeleteIndexByIndexName(ctx, d.Id())
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultIndexProvisionTimeout),
+		},
+	}
+}
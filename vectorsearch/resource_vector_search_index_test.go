@@ -0,0 +1,144 @@
+package vectorsearch
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/service/vectorsearch"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVectorSearchIndexCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceVectorSearchIndex())
+}
+
+func TestVectorSearchIndexSchemaForceNew(t *testing.T) {
+	s := ResourceVectorSearchIndex().Schema
+	assert.True(t, s["name"].ForceNew)
+	assert.False(t, s["status"].ForceNew)
+	spec := s["delta_sync_index_spec"].Elem.(*schema.Resource).Schema
+	assert.True(t, spec["source_table"].ForceNew)
+	assert.False(t, spec["pipeline_id"].ForceNew)
+}
+
+var readyIndex = vectorsearch.VectorIndex{
+	Name:         "main.default.docs_index",
+	EndpointName: "abc",
+	PrimaryKey:   "id",
+	IndexType:    vectorsearch.VectorIndexTypeDeltaSync,
+	Creator:      "user@example.com",
+	DeltaSyncVectorIndexSpec: &vectorsearch.DeltaSyncVectorIndexSpecResponse{
+		SourceTable:  "main.default.docs",
+		PipelineType: vectorsearch.PipelineTypeTriggered,
+		PipelineId:   "pipeline",
+		EmbeddingSourceColumns: []vectorsearch.EmbeddingSourceColumn{
+			{
+				Name: "text",
+				EmbeddingConfig: &vectorsearch.EmbeddingConfig{
+					EmbeddingModelEndpointName: "e5-small-v2",
+				},
+			},
+		},
+	},
+	Status: &vectorsearch.VectorIndexStatus{
+		Ready:           true,
+		IndexedRowCount: 10,
+	},
+}
+
+func TestVectorSearchIndexCreate(t *testing.T) {
+	notReady := readyIndex
+	notReady.Status = &vectorsearch.VectorIndexStatus{Message: "provisioning"}
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/vector-search/indexes",
+				ExpectedRequest: vectorsearch.CreateVectorIndexRequest{
+					Name:         "main.default.docs_index",
+					EndpointName: "abc",
+					PrimaryKey:   "id",
+					IndexType:    vectorsearch.VectorIndexTypeDeltaSync,
+					DeltaSyncVectorIndexSpec: &vectorsearch.DeltaSyncVectorIndexSpecRequest{
+						SourceTable:            "main.default.docs",
+						PipelineType:           vectorsearch.PipelineTypeTriggered,
+						EmbeddingSourceColumns: readyIndex.DeltaSyncVectorIndexSpec.EmbeddingSourceColumns,
+					},
+				},
+				Response: vectorsearch.CreateVectorIndexResponse{},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/vector-search/indexes/main.default.docs_index?",
+				Response: notReady,
+			},
+			{
+				Method:       http.MethodGet,
+				Resource:     "/api/2.0/vector-search/indexes/main.default.docs_index?",
+				Response:     readyIndex,
+				ReuseRequest: true,
+			},
+		},
+		Resource: ResourceVectorSearchIndex(),
+		HCL: `
+		name          = "main.default.docs_index"
+		endpoint_name = "abc"
+		primary_key   = "id"
+		index_type    = "DELTA_SYNC"
+		delta_sync_index_spec {
+			source_table  = "main.default.docs"
+			pipeline_type = "TRIGGERED"
+			embedding_source_columns {
+				name = "text"
+				embedding_config {
+					embedding_model_endpoint_name = "e5-small-v2"
+				}
+			}
+		}
+		`,
+		Create: true,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                                  "main.default.docs_index",
+		"delta_sync_index_spec.0.pipeline_id": "pipeline",
+		"status.0.ready":                      true,
+	})
+}
+
+func TestVectorSearchIndexRead(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/vector-search/indexes/main.default.docs_index?",
+				Response: readyIndex,
+			},
+		},
+		Resource: ResourceVectorSearchIndex(),
+		Read:     true,
+		New:      true,
+		ID:       "main.default.docs_index",
+	}.ApplyAndExpectData(t, map[string]any{
+		"name":                                 "main.default.docs_index",
+		"endpoint_name":                        "abc",
+		"index_type":                           "DELTA_SYNC",
+		"delta_sync_index_spec.0.source_table": "main.default.docs",
+		"delta_sync_index_spec.0.embedding_source_columns.0.embedding_config.0.embedding_model_endpoint_name": "e5-small-v2",
+		"status.0.indexed_row_count": 10,
+	})
+}
+
+func TestVectorSearchIndexDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodDelete,
+				Resource: "/api/2.0/vector-search/indexes/main.default.docs_index?",
+			},
+		},
+		Resource: ResourceVectorSearchIndex(),
+		Delete:   true,
+		ID:       "main.default.docs_index",
+	}.ApplyNoError(t)
+}
@@ -0,0 +1,34 @@
+package workspace
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/settings"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+// DataSourceWorkspaceSettings returns effective values of the requested workspace-conf keys
+func DataSourceWorkspaceSettings() common.Resource {
+	return common.WorkspaceData(func(ctx context.Context, data *struct {
+		Keys   []string          `json:"keys" tf:"slice_set"`
+		Values map[string]string `json:"values,omitempty" tf:"computed"`
+	}, w *databricks.WorkspaceClient) error {
+		keys := append([]string{}, data.Keys...)
+		sort.Strings(keys)
+		conf, err := w.WorkspaceConf.GetStatus(ctx, settings.GetStatusRequest{
+			Keys: strings.Join(keys, ","),
+		})
+		if err != nil {
+			return err
+		}
+		data.Values = map[string]string{}
+		for _, k := range keys {
+			// keys that were never set on the workspace are returned as empty values
+			data.Values[k] = (*conf)[k]
+		}
+		return nil
+	})
+}
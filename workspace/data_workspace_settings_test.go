@@ -0,0 +1,44 @@
+package workspace
+
+import (
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+)
+
+func TestDataSourceWorkspaceSettings(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/workspace-conf?keys=enableIpAccessLists%2CenableTokensConfig%2CmaxTokenLifetimeDays",
+				Response: map[string]any{
+					"enableIpAccessLists":  "true",
+					"enableTokensConfig":   "false",
+					"maxTokenLifetimeDays": nil,
+				},
+			},
+		},
+		Resource:    DataSourceWorkspaceSettings(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL:         `keys = ["maxTokenLifetimeDays", "enableIpAccessLists", "enableTokensConfig"]`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"values.%":                    "3",
+		"values.enableIpAccessLists":  "true",
+		"values.enableTokensConfig":   "false",
+		"values.maxTokenLifetimeDays": "",
+	})
+}
+
+func TestDataSourceWorkspaceSettings_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    qa.HTTPFailures,
+		Resource:    DataSourceWorkspaceSettings(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL:         `keys = ["enableIpAccessLists"]`,
+	}.ExpectError(t, "i'm a teapot")
+}
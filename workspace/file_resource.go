@@ -4,11 +4,13 @@ import (
 	"bufio"
 	"context"
 	"crypto/md5"
+	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
 	"hash/fnv"
 	"io/ioutil"
 	"log"
+	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
@@ -32,10 +34,38 @@ func readFileContent(s any) (content []byte, err error) {
 	return ioutil.ReadAll(reader)
 }
 
-// ReadContent to work with `content_base64` and `source` properties accordingly and set MD5 checksum
+// client used to download content from `source_url`
+var urlContentClient = http.DefaultClient
+
+// readURLContent downloads content and verifies that it matches expected SHA-256 checksum
+func readURLContent(url, expectedSha256 string) ([]byte, error) {
+	log.Printf("[INFO] Downloading %s", url)
+	resp, err := urlContentClient.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("cannot download %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("cannot download %s: %s", url, resp.Status)
+	}
+	content, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("cannot download %s: %w", url, err)
+	}
+	actual := fmt.Sprintf("%x", sha256.Sum256(content))
+	if !strings.EqualFold(actual, expectedSha256) {
+		return nil, fmt.Errorf("checksum mismatch for %s: expected sha256 %s, got %s",
+			url, expectedSha256, actual)
+	}
+	return content, nil
+}
+
+// ReadContent to work with `content_base64`, `source` and `source_url` properties accordingly and set MD5 checksum
 func ReadContent(d *schema.ResourceData) (content []byte, err error) {
 	b64 := d.Get("content_base64").(string)
-	if b64 == "" {
+	if url, ok := d.GetOk("source_url"); ok {
+		content, err = readURLContent(url.(string), d.Get("source_sha256").(string))
+	} else if b64 == "" {
 		content, err = readFileContent(d.Get("source"))
 	} else {
 		log.Printf("[INFO] Reading `content_base64` of %d bytes", len(b64))
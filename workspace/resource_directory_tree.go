@@ -0,0 +1,218 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/terraform-provider-databricks/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func normalizeDirectoryPaths(paths []string) []string {
+	seen := map[string]bool{}
+	result := []string{}
+	for _, p := range paths {
+		if p == "" {
+			continue
+		}
+		p = path.Clean(p)
+		if seen[p] {
+			continue
+		}
+		seen[p] = true
+		result = append(result, p)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func isParentDirectory(parent, child string) bool {
+	return parent == "/" || strings.HasPrefix(child, parent+"/")
+}
+
+// leafDirectories returns only the paths, that are not parents of other paths.
+// Mkdirs creates all intermediate directories, so calling it on leaves is enough.
+func leafDirectories(paths []string) (leaves []string) {
+	for _, p := range paths {
+		leaf := true
+		for _, other := range paths {
+			if other != p && isParentDirectory(p, other) {
+				leaf = false
+				break
+			}
+		}
+		if leaf {
+			leaves = append(leaves, p)
+		}
+	}
+	return
+}
+
+// rootDirectories returns only the paths, that don't have any of their parents in the list.
+func rootDirectories(paths []string) (roots []string) {
+	for _, p := range paths {
+		root := true
+		for _, other := range paths {
+			if other != p && isParentDirectory(other, p) {
+				root = false
+				break
+			}
+		}
+		if root {
+			roots = append(roots, p)
+		}
+	}
+	return
+}
+
+// commonParentDirectory returns the longest directory, that contains all given paths
+func commonParentDirectory(paths []string) string {
+	if len(paths) == 0 {
+		return "/"
+	}
+	parent := paths[0]
+	for _, p := range paths[1:] {
+		for parent != "/" && p != parent && !isParentDirectory(parent, p) {
+			parent = path.Dir(parent)
+		}
+	}
+	return parent
+}
+
+func setToStrings(v any) (result []string) {
+	for _, p := range v.(*schema.Set).List() {
+		result = append(result, p.(string))
+	}
+	return
+}
+
+func deleteDirectories(a NotebooksAPI, paths []string, recursive bool) error {
+	if recursive {
+		paths = rootDirectories(paths)
+	}
+	// delete the deepest directories first
+	sort.Slice(paths, func(i, j int) bool {
+		return strings.Count(paths[i], "/") > strings.Count(paths[j], "/")
+	})
+	for _, p := range paths {
+		err := a.Delete(p, recursive)
+		if apierr.IsMissing(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("cannot delete %s: %w", p, err)
+		}
+	}
+	return nil
+}
+
+// ResourceDirectoryTree manages a tree of directories with a single resource
+func ResourceDirectoryTree() common.Resource {
+	s := map[string]*schema.Schema{
+		"paths": {
+			Type:     schema.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"delete_recursive": {
+			Type:     schema.TypeBool,
+			Default:  false,
+			Optional: true,
+		},
+		"object_ids": {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeInt,
+			},
+		},
+	}
+	mkdirs := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+		notebooksAPI := NewNotebooksAPI(ctx, c)
+		paths := normalizeDirectoryPaths(setToStrings(d.Get("paths")))
+		leaves := leafDirectories(paths)
+		log.Printf("[DEBUG] Creating %d directories with %d mkdirs calls", len(paths), len(leaves))
+		for _, p := range leaves {
+			if err := notebooksAPI.Mkdirs(p); err != nil {
+				return fmt.Errorf("cannot create %s: %w", p, err)
+			}
+		}
+		return nil
+	}
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			if err := mkdirs(ctx, d, c); err != nil {
+				return err
+			}
+			d.SetId(commonParentDirectory(normalizeDirectoryPaths(setToStrings(d.Get("paths")))))
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			notebooksAPI := NewNotebooksAPI(ctx, c)
+			existing := []any{}
+			objectIDs := map[string]any{}
+			for _, p := range setToStrings(d.Get("paths")) {
+				if p == "" {
+					continue
+				}
+				objectStatus, err := notebooksAPI.Read(p)
+				if apierr.IsMissing(err) {
+					// removing the path from state, so that it's re-created on the next apply
+					log.Printf("[INFO] Directory %s is removed on backend", p)
+					continue
+				}
+				if err != nil {
+					return err
+				}
+				if objectStatus.ObjectType != Directory {
+					return fmt.Errorf("different object type, %s, on %s other than a directory",
+						objectStatus.ObjectType, p)
+				}
+				existing = append(existing, p)
+				objectIDs[p] = int(objectStatus.ObjectID)
+			}
+			if len(existing) == 0 {
+				return apierr.NotFound(fmt.Sprintf("none of directories under %s exist", d.Id()))
+			}
+			d.Set("paths", existing)
+			return d.Set("object_ids", objectIDs)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			if !d.HasChange("paths") {
+				return nil
+			}
+			o, n := d.GetChange("paths")
+			remaining := normalizeDirectoryPaths(setToStrings(n))
+			removed := []string{}
+			for _, p := range normalizeDirectoryPaths(setToStrings(o)) {
+				keep := false
+				for _, r := range remaining {
+					if r == p || isParentDirectory(p, r) {
+						keep = true
+						break
+					}
+				}
+				if !keep {
+					removed = append(removed, p)
+				}
+			}
+			err := deleteDirectories(NewNotebooksAPI(ctx, c), removed, d.Get("delete_recursive").(bool))
+			if err != nil {
+				return err
+			}
+			return mkdirs(ctx, d, c)
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			paths := normalizeDirectoryPaths(setToStrings(d.Get("paths")))
+			return deleteDirectories(NewNotebooksAPI(ctx, c), paths, d.Get("delete_recursive").(bool))
+		},
+	}
+}
@@ -0,0 +1,193 @@
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDirectoryTreeHelpers(t *testing.T) {
+	paths := normalizeDirectoryPaths([]string{"/a/b/c/", "/a/b", "/a/d", "/a/b/c", "/x/y"})
+	assert.Equal(t, []string{"/a/b", "/a/b/c", "/a/d", "/x/y"}, paths)
+	assert.Equal(t, []string{"/a/b/c", "/a/d", "/x/y"}, leafDirectories(paths))
+	assert.Equal(t, []string{"/a/b", "/a/d", "/x/y"}, rootDirectories(paths))
+	assert.Equal(t, "/", commonParentDirectory(paths))
+	assert.Equal(t, "/a", commonParentDirectory([]string{"/a/b/c", "/a/bc"}))
+	assert.Equal(t, "/a/b", commonParentDirectory([]string{"/a/b/c", "/a/b"}))
+}
+
+func TestResourceDirectoryTreeCreate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:          http.MethodPost,
+				Resource:        "/api/2.0/workspace/mkdirs",
+				ExpectedRequest: map[string]string{"path": "/Shared/team/bronze/raw"},
+			},
+			{
+				Method:          http.MethodPost,
+				Resource:        "/api/2.0/workspace/mkdirs",
+				ExpectedRequest: map[string]string{"path": "/Shared/team/silver"},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/get-status?path=%2FShared%2Fteam%2Fbronze",
+				Response: ObjectStatus{ObjectID: 1, ObjectType: Directory, Path: "/Shared/team/bronze"},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/get-status?path=%2FShared%2Fteam%2Fbronze%2Fraw",
+				Response: ObjectStatus{ObjectID: 2, ObjectType: Directory, Path: "/Shared/team/bronze/raw"},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/get-status?path=%2FShared%2Fteam%2Fsilver",
+				Response: ObjectStatus{ObjectID: 3, ObjectType: Directory, Path: "/Shared/team/silver"},
+			},
+		},
+		Resource: ResourceDirectoryTree(),
+		Create:   true,
+		HCL: `paths = [
+			"/Shared/team/bronze",
+			"/Shared/team/bronze/raw",
+			"/Shared/team/silver",
+		]`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                                 "/Shared/team",
+		"object_ids.%":                       3,
+		"object_ids./Shared/team/bronze/raw": 2,
+	})
+}
+
+func TestResourceDirectoryTreeRead_PartiallyMissing(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/get-status?path=%2Fa%2Fb",
+				Response: ObjectStatus{ObjectID: 1, ObjectType: Directory, Path: "/a/b"},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/get-status?path=%2Fa%2Fc",
+				Status:   404,
+				Response: map[string]string{
+					"error_code": "RESOURCE_DOES_NOT_EXIST",
+					"message":    "Path (/a/c) doesn't exist.",
+				},
+			},
+		},
+		Resource: ResourceDirectoryTree(),
+		Read:     true,
+		ID:       "/a",
+		HCL:      `paths = ["/a/b", "/a/c"]`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"paths.#": 1,
+	})
+}
+
+func TestResourceDirectoryTreeRead_NotDirectory(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/get-status?path=%2Fa%2Fb",
+				Response: ObjectStatus{ObjectID: 1, ObjectType: Notebook, Path: "/a/b"},
+			},
+		},
+		Resource: ResourceDirectoryTree(),
+		Read:     true,
+		ID:       "/a/b",
+		HCL:      `paths = ["/a/b"]`,
+	}.ExpectError(t, "different object type, NOTEBOOK, on /a/b other than a directory")
+}
+
+func TestResourceDirectoryTreeUpdate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:          http.MethodPost,
+				Resource:        "/api/2.0/workspace/delete",
+				ExpectedRequest: DeletePath{Path: "/a/c/d"},
+			},
+			{
+				Method:          http.MethodPost,
+				Resource:        "/api/2.0/workspace/delete",
+				ExpectedRequest: DeletePath{Path: "/a/c"},
+			},
+			{
+				Method:          http.MethodPost,
+				Resource:        "/api/2.0/workspace/mkdirs",
+				ExpectedRequest: map[string]string{"path": "/a/b/e"},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/get-status?path=%2Fa%2Fb",
+				Response: ObjectStatus{ObjectID: 1, ObjectType: Directory, Path: "/a/b"},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/get-status?path=%2Fa%2Fb%2Fe",
+				Response: ObjectStatus{ObjectID: 4, ObjectType: Directory, Path: "/a/b/e"},
+			},
+		},
+		Resource: ResourceDirectoryTree(),
+		Update:   true,
+		ID:       "/a",
+		InstanceState: map[string]string{
+			"paths.#": "3",
+			fmt.Sprintf("paths.%d", schema.HashString("/a/b")):   "/a/b",
+			fmt.Sprintf("paths.%d", schema.HashString("/a/c")):   "/a/c",
+			fmt.Sprintf("paths.%d", schema.HashString("/a/c/d")): "/a/c/d",
+		},
+		HCL: `paths = ["/a/b", "/a/b/e"]`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"paths.#": 2,
+	})
+}
+
+func TestResourceDirectoryTreeDelete_Recursive(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:          http.MethodPost,
+				Resource:        "/api/2.0/workspace/delete",
+				ExpectedRequest: DeletePath{Path: "/a/b", Recursive: true},
+			},
+			{
+				Method:          http.MethodPost,
+				Resource:        "/api/2.0/workspace/delete",
+				ExpectedRequest: DeletePath{Path: "/x", Recursive: true},
+			},
+		},
+		Resource: ResourceDirectoryTree(),
+		Delete:   true,
+		ID:       "/",
+		HCL: `paths = ["/a/b", "/a/b/c", "/x"]
+		delete_recursive = true`,
+	}.ApplyNoError(t)
+}
+
+func TestResourceDirectoryTreeDelete_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/workspace/delete",
+				Status:   400,
+				Response: map[string]string{
+					"error_code": "DIRECTORY_NOT_EMPTY",
+					"message":    "Folder (/a/b) is not empty",
+				},
+			},
+		},
+		Resource: ResourceDirectoryTree(),
+		Delete:   true,
+		ID:       "/a/b",
+		HCL:      `paths = ["/a/b"]`,
+	}.ExpectError(t, "cannot delete /a/b: Folder (/a/b) is not empty")
+}
@@ -2,8 +2,11 @@ package workspace
 
 import (
 	"context"
+	"crypto/md5"
+	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
+	"log"
 	"regexp"
 
 	"github.com/databricks/terraform-provider-databricks/common"
@@ -17,9 +20,32 @@ const (
 	maxScriptSize   = 64 * 1024
 )
 
+// detectContentDrift compares the checksum of the script in the workspace with the one stored in the state.
+// When the script was modified outside of Terraform, `md5` is set to the checksum of the modified script,
+// so the next plan shows the difference with the configured content
+func detectContentDrift(d *schema.ResourceData, contentBase64 string) error {
+	if contentBase64 == "" {
+		return nil
+	}
+	content, err := base64.StdEncoding.DecodeString(contentBase64)
+	if err != nil {
+		return err
+	}
+	checksum := fmt.Sprintf("%x", sha256.Sum256(content))
+	if stored := d.Get("content_sha256").(string); stored != "" && stored != checksum {
+		log.Printf("[WARN] Global init script %s was modified outside of Terraform", d.Id())
+		d.Set("md5", fmt.Sprintf("%x", md5.Sum(content)))
+	}
+	return d.Set("content_sha256", checksum)
+}
+
 // ResourceGlobalInitScript manages notebooks
 func ResourceGlobalInitScript() common.Resource {
 	extra := map[string]*schema.Schema{
+		"content_sha256": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
 		"enabled": {
 			Type:     schema.TypeBool,
 			Optional: true,
@@ -64,7 +90,7 @@ func ResourceGlobalInitScript() common.Resource {
 				return err
 			}
 			d.SetId(scriptID)
-			return nil
+			return d.Set("content_sha256", fmt.Sprintf("%x", sha256.Sum256(content)))
 		},
 		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			globalInitScriptsAPI := NewGlobalInitScriptsAPI(ctx, c)
@@ -72,20 +98,37 @@ func ResourceGlobalInitScript() common.Resource {
 			if err != nil {
 				return err
 			}
-			return common.StructToData(scriptStatus, s, d)
-		},
-		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
-			content, err := ReadContent(d)
+			err = detectContentDrift(d, scriptStatus.ContentBase64)
 			if err != nil {
 				return err
 			}
-			if contentLen := len(content); contentLen > maxScriptSize {
-				return fmt.Errorf("size of the global init script (%d bytes) exceeds maximal allowed (%d bytes)",
-					contentLen, maxScriptSize)
-			}
+			return common.StructToData(scriptStatus, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			globalInitScriptsAPI := NewGlobalInitScriptsAPI(ctx, c)
+			var contentBase64 string
+			if d.HasChanges("md5", "content_base64", "source") {
+				content, err := ReadContent(d)
+				if err != nil {
+					return err
+				}
+				if contentLen := len(content); contentLen > maxScriptSize {
+					return fmt.Errorf("size of the global init script (%d bytes) exceeds maximal allowed (%d bytes)",
+						contentLen, maxScriptSize)
+				}
+				contentBase64 = base64.StdEncoding.EncodeToString(content)
+				d.Set("content_sha256", fmt.Sprintf("%x", sha256.Sum256(content)))
+			} else {
+				// only `enabled`, `name` or `position` are changed, so the script in the workspace is sent back
+				// as is, without reading the configured content
+				scriptStatus, err := globalInitScriptsAPI.Get(d.Id())
+				if err != nil {
+					return err
+				}
+				contentBase64 = scriptStatus.ContentBase64
+			}
 			return globalInitScriptsAPI.Update(d.Id(), GlobalInitScriptPayload{
-				ContentBase64: base64.StdEncoding.EncodeToString(content),
+				ContentBase64: contentBase64,
 				Enabled:       d.Get("enabled").(bool),
 				Position:      int32(d.Get("position").(int)),
 				Name:          d.Get("name").(string),
@@ -190,3 +190,101 @@ func TestResourceGlobalInitScriptUpdate(t *testing.T) {
 	assert.Equal(t, "1234", d.Id())
 	assert.Equal(t, 0, d.Get("position"))
 }
+
+func TestResourceGlobalInitScriptRead_StoresChecksum(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/global-init-scripts/1234",
+				Response: GlobalInitScriptInfo{
+					ScriptID:      "1234",
+					Name:          "Test",
+					ContentBase64: "ZWNobyBoZWxsbw==",
+				},
+			},
+		},
+		Resource: ResourceGlobalInitScript(),
+		Read:     true,
+		New:      true,
+		ID:       "1234",
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "584a331fd6b02dcb1ecbe2eba731f609a2e1e3dac0bb73ae998dfad14c309a77", d.Get("content_sha256"))
+	assert.Equal(t, "different", d.Get("md5"))
+}
+
+func TestResourceGlobalInitScriptRead_ContentDrift(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/global-init-scripts/1234",
+				Response: GlobalInitScriptInfo{
+					ScriptID:      "1234",
+					Name:          "Test",
+					ContentBase64: "ZWNobyBjaGFuZ2Vk",
+				},
+			},
+		},
+		Resource: ResourceGlobalInitScript(),
+		Read:     true,
+		ID:       "1234",
+		InstanceState: map[string]string{
+			"name":           "Test",
+			"content_base64": "ZWNobyBoZWxsbw==",
+			"md5":            "cd18203adcdc4404664fea34541d8717",
+			"content_sha256": "584a331fd6b02dcb1ecbe2eba731f609a2e1e3dac0bb73ae998dfad14c309a77",
+		},
+	}.Apply(t)
+	assert.NoError(t, err)
+	// checksum of the script modified outside of Terraform makes the difference with the configured content
+	assert.Equal(t, "6cb972e3ea54c6a9725a82cf63d050fa", d.Get("md5"))
+	assert.Equal(t, "4ebeff2e060a062f04a4d18934f27a4458a6ee3d5d1a84eccdae0bde8af684ce", d.Get("content_sha256"))
+}
+
+func TestResourceGlobalInitScriptUpdate_OnlyEnabled(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/global-init-scripts/1234",
+				ReuseRequest: true,
+				Response: GlobalInitScriptInfo{
+					ScriptID:      "1234",
+					ContentBase64: "ZWNobyBoZWxsbw==",
+					Name:          "test",
+					Enabled:       true,
+				},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/global-init-scripts/1234",
+				ExpectedRequest: GlobalInitScriptPayload{
+					Name:          "test",
+					Enabled:       true,
+					ContentBase64: "ZWNobyBoZWxsbw==",
+				},
+			},
+		},
+		Update:   true,
+		ID:       "1234",
+		Resource: ResourceGlobalInitScript(),
+		InstanceState: map[string]string{
+			"name":           "test",
+			"content_base64": "ZWNobyBoZWxsbw==",
+			"md5":            "cd18203adcdc4404664fea34541d8717",
+			"content_sha256": "584a331fd6b02dcb1ecbe2eba731f609a2e1e3dac0bb73ae998dfad14c309a77",
+			"enabled":        "false",
+			"position":       "0",
+		},
+		State: map[string]any{
+			"name":           "test",
+			"content_base64": "ZWNobyBoZWxsbw==",
+			"enabled":        true,
+			"position":       0,
+		},
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, true, d.Get("enabled"))
+}
@@ -40,6 +40,7 @@ var extMap = map[string]notebookLanguageFormat{
 	".r":     {"R", "SOURCE", true},
 	".ipynb": {"", "JUPYTER", true},
 	".dbc":   {"", "DBC", false},
+	".html":  {"", "HTML", true},
 }
 
 type ModifiedAtInteractive struct {
@@ -228,6 +229,7 @@ func ResourceNotebook() common.Resource {
 				"SOURCE",
 				"DBC",
 				"JUPYTER",
+				"HTML",
 			}, false),
 		},
 		"url": {
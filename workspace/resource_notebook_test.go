@@ -1,7 +1,9 @@
 package workspace
 
 import (
+	"encoding/base64"
 	"net/http"
+	"os"
 	"testing"
 
 	"github.com/databricks/databricks-sdk-go/apierr"
@@ -313,6 +315,43 @@ func TestResourceNotebookCreateSource_Jupyter(t *testing.T) {
 	assert.Equal(t, "/Mars", d.Id())
 }
 
+func TestResourceNotebookCreateSource_HTML(t *testing.T) {
+	content, err := os.ReadFile("acceptance/testdata/tf-test-html.html")
+	assert.NoError(t, err)
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/workspace/import",
+				ExpectedRequest: ImportPath{
+					Content:   base64.StdEncoding.EncodeToString(content),
+					Path:      "/Mars",
+					Language:  "",
+					Overwrite: true,
+					Format:    "HTML",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/get-status?path=%2FMars",
+				Response: ObjectStatus{
+					ObjectID:   4567,
+					ObjectType: "NOTEBOOK",
+					Path:       "/Mars",
+				},
+			},
+		},
+		Resource: ResourceNotebook(),
+		State: map[string]any{
+			"source": "acceptance/testdata/tf-test-html.html",
+			"path":   "/Mars",
+		},
+		Create: true,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "HTML", d.Get("format"))
+}
+
 func TestResourceNotebookCreateSource(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -16,6 +16,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// workspaceConfMutex serializes changes of workspace configuration, when multiple
+// databricks_workspace_conf resources manage the same workspace
+var workspaceConfMutex common.NamedMutex
+
 // This function applies configuration defined in the resource data to the workspace.
 func applyWorkspaceConf(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 	o, n := d.GetChange("custom_config")
@@ -58,6 +62,7 @@ func applyWorkspaceConf(ctx context.Context, d *schema.ResourceData, c *common.D
 	if err != nil {
 		return err
 	}
+	defer workspaceConfMutex.Lock(w.Config.Host)()
 	err = w.WorkspaceConf.SetStatus(ctx, patch)
 	if err != nil {
 		return err
@@ -138,6 +143,7 @@ func ResourceWorkspaceConf() common.Resource {
 			if err != nil {
 				return err
 			}
+			defer workspaceConfMutex.Lock(w.Config.Host)()
 			return w.WorkspaceConf.SetStatus(ctx, patch)
 		},
 		Schema: map[string]*schema.Schema{
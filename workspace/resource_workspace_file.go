@@ -5,11 +5,13 @@ import (
 	"encoding/base64"
 	"log"
 	"path/filepath"
+	"regexp"
 
 	ws_api "github.com/databricks/databricks-sdk-go/service/workspace"
 	"github.com/databricks/terraform-provider-databricks/common"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // ResourceWorkspaceFile manages files in workspace
@@ -28,7 +30,32 @@ func ResourceWorkspaceFile() common.Resource {
 			Type:     schema.TypeString,
 			Computed: true,
 		},
+		"source_url": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"source", "content_base64"},
+			RequiredWith:  []string{"source_sha256"},
+			ValidateFunc:  validation.IsURLWithHTTPS,
+		},
+		"source_sha256": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			RequiredWith: []string{"source_url"},
+			ValidateFunc: validation.StringMatch(regexp.MustCompile("^[0-9a-fA-F]{64}$"),
+				"must be a hex-encoded SHA-256 checksum"),
+		},
 	})
+	s["source"].ConflictsWith = append(s["source"].ConflictsWith, "source_url")
+	s["content_base64"].ConflictsWith = append(s["content_base64"].ConflictsWith, "source_url")
+	suppressContentDiff := s["md5"].DiffSuppressFunc
+	s["md5"].DiffSuppressFunc = func(k, old, new string, d *schema.ResourceData) bool {
+		if _, ok := d.GetOk("source_url"); ok {
+			// content can't change without changing its checksum, so
+			// `source_url` and `source_sha256` are enough to detect changes
+			return old != ""
+		}
+		return suppressContentDiff(k, old, new, d)
+	}
 	return common.Resource{
 		Schema:        s,
 		SchemaVersion: 1,
@@ -2,6 +2,7 @@ package workspace
 
 import (
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/databricks/databricks-sdk-go/apierr"
@@ -410,3 +411,82 @@ func TestResourceWorkspaceFileUpdate(t *testing.T) {
 		Update:      true,
 	}.ApplyNoError(t)
 }
+
+func TestResourceWorkspaceFileCreateFromURL(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/init.sh" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte("abc\n"))
+	}))
+	defer server.Close()
+	defaultClient := urlContentClient
+	urlContentClient = server.Client()
+	defer func() {
+		urlContentClient = defaultClient
+	}()
+
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/workspace/import",
+				ExpectedRequest: ws_api.Import{
+					Content:   dummyWorkspaceFilePayload,
+					Path:      "/init.sh",
+					Overwrite: true,
+					Format:    "AUTO",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/get-status?path=%2Finit.sh",
+				Response: ObjectStatus{
+					ObjectID:   4567,
+					ObjectType: File,
+					Path:       "/init.sh",
+				},
+			},
+		},
+		Resource: ResourceWorkspaceFile(),
+		State: map[string]any{
+			"source_url":    server.URL + "/init.sh",
+			"source_sha256": "edeaaff3f1774ad2888673770c6d64097e391bc362d7d6fb34982ddf0efd18cb",
+			"path":          "/init.sh",
+		},
+		Create: true,
+	}.ApplyNoError(t)
+
+	qa.ResourceFixture{
+		Resource: ResourceWorkspaceFile(),
+		State: map[string]any{
+			"source_url":    server.URL + "/init.sh",
+			"source_sha256": "0000000000000000000000000000000000000000000000000000000000000000",
+			"path":          "/init.sh",
+		},
+		Create: true,
+	}.ExpectError(t, "checksum mismatch for "+server.URL+"/init.sh: expected sha256 "+
+		"0000000000000000000000000000000000000000000000000000000000000000, got "+
+		"edeaaff3f1774ad2888673770c6d64097e391bc362d7d6fb34982ddf0efd18cb")
+
+	qa.ResourceFixture{
+		Resource: ResourceWorkspaceFile(),
+		State: map[string]any{
+			"source_url":    server.URL + "/missing.sh",
+			"source_sha256": "edeaaff3f1774ad2888673770c6d64097e391bc362d7d6fb34982ddf0efd18cb",
+			"path":          "/init.sh",
+		},
+		Create: true,
+	}.ExpectError(t, "cannot download "+server.URL+"/missing.sh: 404 Not Found")
+}
+
+func TestResourceWorkspaceFileSourceURLSuppressesMd5Diff(t *testing.T) {
+	r := ResourceWorkspaceFile().ToResource()
+	d := r.TestResourceData()
+	d.Set("source_url", "https://example.com/init.sh")
+	d.Set("source_sha256", "edeaaff3f1774ad2888673770c6d64097e391bc362d7d6fb34982ddf0efd18cb")
+	suppress := r.Schema["md5"].DiffSuppressFunc
+	assert.True(t, suppress("md5", "abc", "different", d))
+	assert.False(t, suppress("md5", "", "different", d))
+}